@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -11,6 +12,8 @@ import (
 type Config struct {
 	Server struct {
 		Port string `mapstructure:"port"`
+		// addresses or CIDRs of the proxies whose X-Forwarded-For is believed, the client is the peer otherwise
+		TrustedProxies []string `mapstructure:"trusted_proxies"`
 	} `mapstructure:"server"`
 	Postgres struct {
 		Host     string `mapstructure:"host"`
@@ -29,19 +32,146 @@ type Config struct {
 		Prefix   string `mapstructure:"prefix"`
 	} `mapstructure:"redis"`
 	Booking struct {
-		MaxBookingPerUser int `mapstructure:"max_booking_per_user"`
+		MaxBookingPerUser int             `mapstructure:"max_booking_per_user"`
+		ReminderSchedule  []time.Duration `mapstructure:"reminder_schedule"`
 	} `mapstructure:"booking"`
+	Outbox struct {
+		RelayInterval   time.Duration `mapstructure:"relay_interval"`
+		BatchSize       int           `mapstructure:"batch_size"`
+		MaxAttempts     int           `mapstructure:"max_attempts"`
+		RetryBackoff    time.Duration `mapstructure:"retry_backoff"`
+		MaxRetryBackoff time.Duration `mapstructure:"max_retry_backoff"`
+		LeaseDuration   time.Duration `mapstructure:"lease_duration"`
+		TaskRetention   time.Duration `mapstructure:"task_retention"`
+	} `mapstructure:"outbox"`
 	Token struct {
 		LockedDuration time.Duration `mapstructure:"locked_duration"`
 	} `mapstructure:"token"`
 	JWT struct {
-		SecretKey       string        `mapstructure:"secret_key"`
 		AccessTokenExp  time.Duration `mapstructure:"access_token_exp"`
 		RefreshTokenExp time.Duration `mapstructure:"refresh_token_exp"`
+		// only set on central-auth, every <kid>.pem of the directory is published and tokens are signed with ActiveKeyID
+		PrivateKeysDir         string        `mapstructure:"private_keys_dir"`
+		ActiveKeyID            string        `mapstructure:"active_key_id"`
+		JWKSURL                string        `mapstructure:"jwks_url"`
+		JWKSCacheTTL           time.Duration `mapstructure:"jwks_cache_ttl"`
+		JWKSMinRefreshInterval time.Duration `mapstructure:"jwks_min_refresh_interval"`
+		JWKSTimeout            time.Duration `mapstructure:"jwks_timeout"`
 	} `mapstructure:"jwt"`
+	Revocation struct {
+		CacheTTL  time.Duration `mapstructure:"cache_ttl"`
+		CacheSize int           `mapstructure:"cache_size"`
+	} `mapstructure:"revocation"`
+	Introspection struct {
+		// set on the main server to check the tokens with central-auth instead of verifying them locally
+		URL              string        `mapstructure:"url"`
+		ClientID         string        `mapstructure:"client_id"`
+		ClientSecret     string        `mapstructure:"client_secret"`
+		Timeout          time.Duration `mapstructure:"timeout"`
+		CacheTTL         time.Duration `mapstructure:"cache_ttl"`
+		CacheSize        int           `mapstructure:"cache_size"`
+		FailureThreshold int           `mapstructure:"failure_threshold"`
+		OpenDuration     time.Duration `mapstructure:"open_duration"`
+		// set on central-auth, client id to secret of the servers allowed to introspect
+		Clients map[string]string `mapstructure:"clients"`
+	} `mapstructure:"introspection"`
+	APIKeys struct {
+		RotationGrace    time.Duration `mapstructure:"rotation_grace"`
+		LastUsedInterval time.Duration `mapstructure:"last_used_interval"`
+	} `mapstructure:"api_keys"`
+	Registration struct {
+		VerificationURL      string        `mapstructure:"verification_url"`
+		VerificationTokenExp time.Duration `mapstructure:"verification_token_exp"`
+	} `mapstructure:"registration"`
+	PasswordReset struct {
+		URL      string        `mapstructure:"url"`
+		TokenExp time.Duration `mapstructure:"token_exp"`
+	} `mapstructure:"password_reset"`
+	Profile struct {
+		EmailChangeURL      string        `mapstructure:"email_change_url"`
+		EmailChangeTokenExp time.Duration `mapstructure:"email_change_token_exp"`
+	} `mapstructure:"profile"`
+	LoginProtection struct {
+		IPWindow           time.Duration `mapstructure:"ip_window"`
+		IPMaxAttempts      int           `mapstructure:"ip_max_attempts"`
+		AccountWindow      time.Duration `mapstructure:"account_window"`
+		AccountMaxFailures int           `mapstructure:"account_max_failures"`
+		LockoutBase        time.Duration `mapstructure:"lockout_base"`
+		LockoutMax         time.Duration `mapstructure:"lockout_max"`
+		UnlockURL          string        `mapstructure:"unlock_url"`
+	} `mapstructure:"login_protection"`
+	MFA struct {
+		Issuer        string        `mapstructure:"issuer"`
+		RequiredRoles []string      `mapstructure:"required_roles"`
+		ChallengeExp  time.Duration `mapstructure:"challenge_exp"`
+		RecoveryCodes int           `mapstructure:"recovery_codes"`
+	} `mapstructure:"mfa"`
+	OIDC struct {
+		StateTTL time.Duration `mapstructure:"state_ttl"`
+		Timeout  time.Duration `mapstructure:"timeout"`
+		// keyed by the name used in the login routes, providers without client id are disabled
+		Providers map[string]struct {
+			Issuer       string   `mapstructure:"issuer"`
+			ClientID     string   `mapstructure:"client_id"`
+			ClientSecret string   `mapstructure:"client_secret"`
+			RedirectURL  string   `mapstructure:"redirect_url"`
+			Scopes       []string `mapstructure:"scopes"`
+		} `mapstructure:"providers"`
+	} `mapstructure:"oidc"`
+	Email struct {
+		Provider string `mapstructure:"provider"`
+		SMTP     struct {
+			Host        string        `mapstructure:"host"`
+			Port        int           `mapstructure:"port"`
+			Username    string        `mapstructure:"username"`
+			Password    string        `mapstructure:"password"`
+			Security    string        `mapstructure:"security"`
+			LocalName   string        `mapstructure:"local_name"`
+			PoolSize    int           `mapstructure:"pool_size"`
+			IdleTimeout time.Duration `mapstructure:"idle_timeout"`
+			Timeout     time.Duration `mapstructure:"timeout"`
+		} `mapstructure:"smtp"`
+		From          string `mapstructure:"from"`
+		TemplatesDir  string `mapstructure:"templates_dir"`
+		DefaultLocale string `mapstructure:"default_locale"`
+		Branding      struct {
+			ProductName  string `mapstructure:"product_name"`
+			WebsiteURL   string `mapstructure:"website_url"`
+			LogoURL      string `mapstructure:"logo_url"`
+			SupportEmail string `mapstructure:"support_email"`
+			PrimaryColor string `mapstructure:"primary_color"`
+		} `mapstructure:"branding"`
+	} `mapstructure:"email"`
+	Calendar struct {
+		FeedURL         string        `mapstructure:"feed_url"`
+		EventDuration   time.Duration `mapstructure:"event_duration"`
+		RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+	} `mapstructure:"calendar"`
+	Webhook struct {
+		Timeout             time.Duration `mapstructure:"timeout"`
+		MaxAttempts         int           `mapstructure:"max_attempts"`
+		RetryBackoff        time.Duration `mapstructure:"retry_backoff"`
+		MaxRetryBackoff     time.Duration `mapstructure:"max_retry_backoff"`
+		DisableAfter        int           `mapstructure:"disable_after"`
+		AllowInsecureURL    bool          `mapstructure:"allow_insecure_url"`
+		AllowPrivateNetwork bool          `mapstructure:"allow_private_network"`
+	} `mapstructure:"webhook"`
 	SupportingMoney struct {
 		Currency string `mapstructure:"currency"`
 	} `mapstructure:"supporting_money"`
+	BlobStorage struct {
+		Provider      string `mapstructure:"provider"`
+		MaxUploadSize int64  `mapstructure:"max_upload_size"`
+		Local         struct {
+			Dir     string `mapstructure:"dir"`
+			BaseURL string `mapstructure:"base_url"`
+		} `mapstructure:"local"`
+	} `mapstructure:"blob_storage"`
+	Dashboard struct {
+		RefreshInterval    time.Duration `mapstructure:"refresh_interval"`
+		RefreshLookback    time.Duration `mapstructure:"refresh_lookback"`
+		MaxTimeSeriesRange time.Duration `mapstructure:"max_time_series_range"`
+	} `mapstructure:"dashboard"`
 	GracefulShutdown time.Duration `mapstructure:"graceful_shutdown"`
 	Asynq            struct {
 		Concurrency int            `mapstructure:"concurrency"`
@@ -60,6 +190,7 @@ func NewConfig() (*Config, error) {
 		return nil, fmt.Errorf("error reading config file: %w", err)
 	}
 
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
 	var c Config
 	if err := v.Unmarshal(&c); err != nil {
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -10,6 +11,7 @@ import (
 
 	"booking-event/config"
 	"booking-event/internal/common/appcontext"
+	"booking-event/internal/middleware"
 	authhttphandler "booking-event/internal/modules/auth/transport/http"
 	"booking-event/migrations"
 )
@@ -37,15 +39,69 @@ func (s *Server) HealthCheck(c *gin.Context) {
 func (s *Server) RegisterRoutes() {
 	s.router.GET("/health", s.HealthCheck)
 
+	jwksHttpHandler := authhttphandler.NewJWKSHandler(s.appContext.InfraRegistry().SigningKeySet())
+	jwksHttpHandler.RegisterRoutes(&s.router.RouterGroup)
+
+	// gin.BasicAuth refuses an empty account list, the endpoint only exists once a client is configured
+	if len(s.config.Introspection.Clients) > 0 {
+		introspectionRoutes := s.router.Group("/", gin.BasicAuth(s.config.Introspection.Clients))
+		introspectionHttpHandler := authhttphandler.NewIntrospectionHandler(s.appContext.ServiceRegistry().IntrospectionService())
+		introspectionHttpHandler.RegisterRoutes(introspectionRoutes)
+	}
+
 	userRoutes := s.router.Group("/api/v1")
 	authHttpHandler := authhttphandler.NewAuthHandler(s.appContext.ServiceRegistry().AuthService())
 	authHttpHandler.RegisterRoutes(userRoutes)
+
+	registrationHttpHandler := authhttphandler.NewRegistrationHandler(s.appContext.ServiceRegistry().RegistrationService())
+	registrationHttpHandler.RegisterRoutes(userRoutes)
+
+	passwordHttpHandler := authhttphandler.NewPasswordHandler(s.appContext.ServiceRegistry().PasswordService())
+	passwordHttpHandler.RegisterRoutes(userRoutes)
+
+	oidcHttpHandler := authhttphandler.NewOIDCHandler(s.appContext.ServiceRegistry().OIDCService())
+	oidcHttpHandler.RegisterRoutes(userRoutes)
+
+	emailChangeHttpHandler := authhttphandler.NewEmailChangeHandler(s.appContext.ServiceRegistry().ProfileService())
+	emailChangeHttpHandler.RegisterRoutes(userRoutes)
+
+	sessionRoutes := s.router.Group("/api/v1")
+	sessionRoutes.Use(middleware.AuthMiddleware(s.appContext.ServiceRegistry().TokenVerifier(), s.appContext.ServiceRegistry().RevocationChecker(), nil))
+	sessionHttpHandler := authhttphandler.NewSessionHandler(s.appContext.ServiceRegistry().AuthService())
+	sessionHttpHandler.RegisterRoutes(sessionRoutes)
+
+	accountPasswordHttpHandler := authhttphandler.NewAccountPasswordHandler(s.appContext.ServiceRegistry().PasswordService())
+	accountPasswordHttpHandler.RegisterRoutes(sessionRoutes)
+
+	mfaHttpHandler := authhttphandler.NewMFAHandler(s.appContext.ServiceRegistry().MFAService())
+	mfaHttpHandler.RegisterRoutes(sessionRoutes)
+
+	profileHttpHandler := authhttphandler.NewProfileHandler(s.appContext.ServiceRegistry().ProfileService())
+	profileHttpHandler.RegisterRoutes(sessionRoutes)
+
+	adminRoutes := s.router.Group("/admin")
+	adminRoutes.Use(middleware.AdminAuthMiddleware(s.appContext.ServiceRegistry().TokenVerifier(), s.appContext.ServiceRegistry().RevocationChecker()))
+	adminRoleHttpHandler := authhttphandler.NewAdminRoleHandler(s.appContext.ServiceRegistry().RoleService())
+	adminRoleHttpHandler.RegisterRoutes(adminRoutes)
+
+	adminServiceAccountHttpHandler := authhttphandler.NewAdminServiceAccountHandler(s.appContext.ServiceRegistry().ServiceAccountService())
+	adminServiceAccountHttpHandler.RegisterRoutes(adminRoutes)
+
+	adminUserHttpHandler := authhttphandler.NewAdminUserHandler(s.appContext.ServiceRegistry().AdminUserService())
+	adminUserHttpHandler.RegisterRoutes(adminRoutes)
 }
 
 func (s *Server) Run() error {
+	if !s.appContext.InfraRegistry().SigningKeySet().CanSign() {
+		return errors.New("no signing key configured, set jwt.private_keys_dir and jwt.active_key_id")
+	}
 	if err := migrations.RunMigrations(s.appContext.InfraRegistry().DBUrl()); err != nil && err != _migrations.ErrNoChange {
 		fmt.Println("Failed to run migrations:", err)
 	}
+	// the client IP keys the login limits, gin would otherwise take it from the X-Forwarded-For of any caller
+	if err := s.router.SetTrustedProxies(s.config.Server.TrustedProxies); err != nil {
+		return err
+	}
 	s.RegisterMiddlewares()
 	s.RegisterRoutes()
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	_migrations "github.com/golang-migrate/migrate/v4"
@@ -29,7 +30,6 @@ func NewServer(config config.Config) *Server {
 
 func (s *Server) RegisterMiddlewares() {
 	s.router.Use(gin.Recovery())
-	s.router.Use(middleware.AuthMiddleware(s.appContext.ServiceRegistry().AuthService()))
 }
 
 func (s *Server) HealthCheck(c *gin.Context) {
@@ -38,23 +38,80 @@ func (s *Server) HealthCheck(c *gin.Context) {
 
 func (s *Server) RegisterRoutes() {
 	s.router.GET("/health", s.HealthCheck)
+	if s.config.BlobStorage.Provider == "" || s.config.BlobStorage.Provider == "local" {
+		s.router.Static("/media", s.config.BlobStorage.Local.Dir)
+	}
 
+	validator, revocationChecker := s.authValidator()
 	adminRoutes := s.router.Group("/admin")
-	adminRoutes.Use(middleware.AdminAuthMiddleware(s.appContext.ServiceRegistry().AuthService()))
+	adminRoutes.Use(middleware.AdminAuthMiddleware(validator, revocationChecker))
+	adminCategoryHttpHandler := bookinghttphandler.NewAdminCategoryHandler(s.appContext.ServiceRegistry().CategoryService())
+	adminCategoryHttpHandler.RegisterRoutes(adminRoutes)
+	adminEventReviewHttpHandler := bookinghttphandler.NewAdminEventReviewHandler(s.appContext.ServiceRegistry().EventReviewService())
+	adminEventReviewHttpHandler.RegisterRoutes(adminRoutes)
 
 	userRoutes := s.router.Group("/api/v1")
-	userRoutes.Use(middleware.AuthMiddleware(s.appContext.ServiceRegistry().AuthService()))
+	userRoutes.Use(middleware.AuthMiddleware(validator, revocationChecker, s.appContext.ServiceRegistry().ServiceAccountService()))
 	bookingHttpHandler := bookinghttphandler.NewBookingHandler(s.appContext.ServiceRegistry().BookingService())
 	bookingHttpHandler.RegisterRoutes(userRoutes)
 
 	eventHttpHandler := bookinghttphandler.NewEventHandler(s.appContext.ServiceRegistry().EventService())
 	eventHttpHandler.RegisterRoutes(userRoutes)
+
+	categoryHttpHandler := bookinghttphandler.NewCategoryHandler(s.appContext.ServiceRegistry().CategoryService())
+	categoryHttpHandler.RegisterRoutes(userRoutes)
+
+	eventReviewHttpHandler := bookinghttphandler.NewEventReviewHandler(s.appContext.ServiceRegistry().EventReviewService())
+	eventReviewHttpHandler.RegisterRoutes(userRoutes)
+
+	dashboardHttpHandler := bookinghttphandler.NewDashboardHandler(s.appContext.ServiceRegistry().DashboardService())
+	dashboardHttpHandler.RegisterRoutes(userRoutes)
+
+	attendeeHttpHandler := bookinghttphandler.NewAttendeeHandler(s.appContext.ServiceRegistry().AttendeeService())
+	attendeeHttpHandler.RegisterRoutes(userRoutes)
+
+	eventTemplateHttpHandler := bookinghttphandler.NewEventTemplateHandler(s.appContext.ServiceRegistry().EventTemplateService())
+	eventTemplateHttpHandler.RegisterRoutes(userRoutes)
+
+	calendarHttpHandler := bookinghttphandler.NewCalendarHandler(s.appContext.ServiceRegistry().CalendarService())
+	calendarHttpHandler.RegisterRoutes(userRoutes)
+
+	webhookHttpHandler := bookinghttphandler.NewWebhookHandler(s.appContext.ServiceRegistry().WebhookService())
+	webhookHttpHandler.RegisterRoutes(userRoutes)
+
+	// the calendar apps fetch the feeds without credentials, the token of the URL authenticates them
+	publicRoutes := s.router.Group("/api/v1")
+	calendarFeedHttpHandler := bookinghttphandler.NewCalendarFeedHandler(s.appContext.ServiceRegistry().CalendarService())
+	calendarFeedHttpHandler.RegisterRoutes(publicRoutes)
+}
+
+// authValidator checks the tokens with central-auth when introspection is configured, central-auth then answers
+// for the revocations too. Otherwise the tokens are verified with the JWKS and the revocations read locally.
+func (s *Server) authValidator() (middleware.AuthValidator, middleware.RevocationChecker) {
+	cfg := s.config.Introspection
+	if cfg.URL == "" {
+		return s.appContext.ServiceRegistry().TokenVerifier(), s.appContext.ServiceRegistry().RevocationChecker()
+	}
+	return middleware.NewIntrospectionValidator(middleware.IntrospectionConfig{
+		URL:              cfg.URL,
+		ClientID:         cfg.ClientID,
+		ClientSecret:     cfg.ClientSecret,
+		Timeout:          cfg.Timeout,
+		CacheTTL:         cfg.CacheTTL,
+		CacheSize:        cfg.CacheSize,
+		FailureThreshold: cfg.FailureThreshold,
+		OpenDuration:     cfg.OpenDuration,
+	}, time.Now), nil
 }
 
 func (s *Server) Run() error {
 	if err := migrations.RunMigrations(s.appContext.InfraRegistry().DBUrl()); err != nil && err != _migrations.ErrNoChange {
 		fmt.Println("Failed to run migrations:", err)
 	}
+	// the client IP keys the login limits, gin would otherwise take it from the X-Forwarded-For of any caller
+	if err := s.router.SetTrustedProxies(s.config.Server.TrustedProxies); err != nil {
+		return err
+	}
 	s.RegisterMiddlewares()
 	s.RegisterRoutes()
 
@@ -2,11 +2,17 @@ package worker
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	hibikenasynq "github.com/hibiken/asynq"
 
 	"booking-event/config"
 	"booking-event/internal/common/appcontext"
 	"booking-event/internal/infra/asynq"
 	"booking-event/internal/infra/redis"
+	authasyntask "booking-event/internal/modules/auth/transport/asyntask"
+	"booking-event/internal/modules/booking/model"
 	"booking-event/internal/modules/booking/transport/asyntask"
 )
 
@@ -14,6 +20,7 @@ type Server struct {
 	appContext    appcontext.AppContext
 	config        config.Config
 	asynqServer   *asynq.AsynqServer
+	scheduler     *asynq.Scheduler
 	asynqHandlers *asyntask.EmailTaskHandler
 }
 
@@ -25,21 +32,70 @@ func NewServer(config config.Config) *Server {
 		Password: config.Redis.Password,
 		Prefix:   config.Redis.Prefix,
 	}
-	asynqServer := asynq.NewAsynqServer(asynq.Config{
+	asynqConfig := asynq.Config{
 		Addr:        redisConfig.Addr(),
 		Concurrency: config.Asynq.Concurrency,
 		Queues:      config.Asynq.Queues,
-	})
-	return &Server{config: config, appContext: appContext, asynqServer: asynqServer}
+	}
+	return &Server{
+		config:      config,
+		appContext:  appContext,
+		asynqServer: asynq.NewAsynqServer(asynqConfig),
+		scheduler:   asynq.NewScheduler(asynqConfig),
+	}
 }
 
 func (s *Server) RegisterHandlers() {
 	handlers := asyntask.NewEmailTaskHandler(s.appContext.ServiceRegistry().EmailService())
 	handlers.Register(s.asynqServer.ServeMux())
 	s.asynqHandlers = handlers
+
+	statsHandlers := asyntask.NewStatsTaskHandler(s.appContext.ServiceRegistry().DashboardService())
+	statsHandlers.Register(s.asynqServer.ServeMux())
+
+	outboxHandlers := asyntask.NewOutboxTaskHandler(s.appContext.ServiceRegistry().OutboxService())
+	outboxHandlers.Register(s.asynqServer.ServeMux())
+
+	reminderHandlers := asyntask.NewReminderTaskHandler(s.appContext.ServiceRegistry().ReminderService())
+	reminderHandlers.Register(s.asynqServer.ServeMux())
+
+	webhookHandlers := asyntask.NewWebhookTaskHandler(s.appContext.ServiceRegistry().WebhookService())
+	webhookHandlers.Register(s.asynqServer.ServeMux())
+
+	authEmailHandlers := authasyntask.NewEmailTaskHandler(s.appContext.RepositoryRegistry().AuthEmailRepository())
+	authEmailHandlers.Register(s.asynqServer.ServeMux())
+}
+
+func (s *Server) RegisterPeriodicTasks() error {
+	periodicTasks := map[model.TaskType]time.Duration{
+		model.TaskTypeRefreshSalesStats: s.config.Dashboard.RefreshInterval,
+		model.TaskTypeRelayOutbox:       s.config.Outbox.RelayInterval,
+	}
+	for taskType, interval := range periodicTasks {
+		if interval <= 0 {
+			continue
+		}
+		// every worker runs a scheduler, the unique option drops the duplicates enqueued by the other workers
+		err := s.scheduler.Register(
+			fmt.Sprintf("@every %s", interval),
+			hibikenasynq.NewTask(string(taskType), nil),
+			hibikenasynq.Unique(interval),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *Server) Run() error {
 	s.RegisterHandlers()
+	if err := s.RegisterPeriodicTasks(); err != nil {
+		return err
+	}
+	if err := s.scheduler.Start(); err != nil {
+		return err
+	}
+	defer s.scheduler.Shutdown()
 	return s.asynqServer.Start(context.Background())
 }
@@ -3,15 +3,21 @@ package appcontext
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 
 	"booking-event/config"
 	"booking-event/internal/infra/asynq"
+	"booking-event/internal/infra/blobstorage"
 	"booking-event/internal/infra/emailsender"
+	"booking-event/internal/infra/emailtemplate"
+	"booking-event/internal/infra/jwks"
+	"booking-event/internal/infra/oidc"
 	"booking-event/internal/infra/paymentgateway"
 	postgresql "booking-event/internal/infra/posgresql"
 	"booking-event/internal/infra/redis"
+	"booking-event/internal/infra/webhook"
 )
 
 type InfraRegistry interface {
@@ -20,16 +26,28 @@ type InfraRegistry interface {
 	Redis() redis.Redis
 
 	EmailService() emailsender.EmailService
+	EmailRenderer() *emailtemplate.Renderer
 	PaymentService() paymentgateway.PaymentGateway
 	AsyncTaskEnqueueClient() asynq.AsyncTaskEnqueueClient
+	BlobStorage() blobstorage.BlobStorage
+	SigningKeySet() *jwks.KeySet
+	JWKSClient() *jwks.Client
+	OIDCProviders() map[string]*oidc.Provider
+	WebhookSender() *webhook.Sender
 }
 
 type infraRegistry struct {
 	db                     *sqlx.DB
 	redis                  redis.Redis
 	emailService           emailsender.EmailService
+	emailRenderer          *emailtemplate.Renderer
 	paymentGateway         paymentgateway.PaymentGateway
 	asyncTaskEnqueueClient asynq.AsyncTaskEnqueueClient
+	blobStorage            blobstorage.BlobStorage
+	signingKeySet          *jwks.KeySet
+	jwksClient             *jwks.Client
+	oidcProviders          map[string]*oidc.Provider
+	webhookSender          *webhook.Sender
 	dbUrl                  string
 }
 
@@ -64,17 +82,103 @@ func NewInfraRegistry(config config.Config) InfraRegistry {
 		log.Fatalf("Failed to connect to redis: %v", err)
 	}
 
-	emailService := emailsender.NewNoopEmailService()
+	var emailService emailsender.EmailService
+	switch config.Email.Provider {
+	case "", "noop":
+		emailService = emailsender.NewNoopEmailService()
+	case "smtp":
+		emailService = emailsender.NewSMTPEmailService(emailsender.SMTPConfig{
+			Host:        config.Email.SMTP.Host,
+			Port:        config.Email.SMTP.Port,
+			Username:    config.Email.SMTP.Username,
+			Password:    config.Email.SMTP.Password,
+			Security:    config.Email.SMTP.Security,
+			LocalName:   config.Email.SMTP.LocalName,
+			PoolSize:    config.Email.SMTP.PoolSize,
+			IdleTimeout: config.Email.SMTP.IdleTimeout,
+			Timeout:     config.Email.SMTP.Timeout,
+		})
+	default:
+		log.Fatalf("Unsupported email provider: %s", config.Email.Provider)
+	}
+
+	emailRenderer, err := emailtemplate.NewRenderer(emailtemplate.Config{
+		Dir:           config.Email.TemplatesDir,
+		DefaultLocale: config.Email.DefaultLocale,
+		Branding: emailtemplate.Branding{
+			ProductName:  config.Email.Branding.ProductName,
+			WebsiteURL:   config.Email.Branding.WebsiteURL,
+			LogoURL:      config.Email.Branding.LogoURL,
+			SupportEmail: config.Email.Branding.SupportEmail,
+			PrimaryColor: config.Email.Branding.PrimaryColor,
+		},
+	})
+	if err != nil {
+		log.Fatalf("Failed to load email templates: %v", err)
+	}
 
 	paymentGateway := paymentgateway.NewNoopPaymentGateway()
 
+	var blobStorage blobstorage.BlobStorage
+	switch config.BlobStorage.Provider {
+	case "", "local":
+		blobStorage = blobstorage.NewLocalBlobStorage(blobstorage.LocalConfig{
+			Dir:     config.BlobStorage.Local.Dir,
+			BaseURL: config.BlobStorage.Local.BaseURL,
+		})
+	default:
+		log.Fatalf("Unsupported blob storage provider: %s", config.BlobStorage.Provider)
+	}
+
+	// only central-auth is configured with private keys, the other servers get an empty set and verify with the JWKS
+	signingKeySet, err := jwks.NewKeySet("", nil)
+	if config.JWT.PrivateKeysDir != "" {
+		signingKeySet, err = jwks.LoadKeySet(config.JWT.PrivateKeysDir, config.JWT.ActiveKeyID)
+	}
+	if err != nil {
+		log.Fatalf("Failed to load signing keys: %v", err)
+	}
+
+	jwksClient := jwks.NewClient(jwks.ClientConfig{
+		URL:                config.JWT.JWKSURL,
+		CacheTTL:           config.JWT.JWKSCacheTTL,
+		MinRefreshInterval: config.JWT.JWKSMinRefreshInterval,
+		Timeout:            config.JWT.JWKSTimeout,
+	}, time.Now)
+
+	oidcProviders := make(map[string]*oidc.Provider)
+	for name, provider := range config.OIDC.Providers {
+		if provider.ClientID == "" {
+			continue
+		}
+		oidcProviders[name] = oidc.NewProvider(oidc.Config{
+			Issuer:                 provider.Issuer,
+			ClientID:               provider.ClientID,
+			ClientSecret:           provider.ClientSecret,
+			RedirectURL:            provider.RedirectURL,
+			Scopes:                 provider.Scopes,
+			Timeout:                config.OIDC.Timeout,
+			JWKSCacheTTL:           config.JWT.JWKSCacheTTL,
+			JWKSMinRefreshInterval: config.JWT.JWKSMinRefreshInterval,
+		}, time.Now)
+	}
+
 	return &infraRegistry{
 		db:                     db,
 		redis:                  redis,
 		emailService:           emailService,
+		emailRenderer:          emailRenderer,
 		paymentGateway:         paymentGateway,
 		asyncTaskEnqueueClient: asynq.NewEnqueueClient(asynq.Config{Addr: redisConfig.Addr()}),
-		dbUrl:                  dbConfig.URL(),
+		blobStorage:            blobStorage,
+		signingKeySet:          signingKeySet,
+		jwksClient:             jwksClient,
+		oidcProviders:          oidcProviders,
+		webhookSender: webhook.NewSender(webhook.Config{
+			Timeout:             config.Webhook.Timeout,
+			AllowPrivateNetwork: config.Webhook.AllowPrivateNetwork,
+		}, time.Now),
+		dbUrl: dbConfig.URL(),
 	}
 }
 
@@ -89,6 +193,10 @@ func (r *infraRegistry) EmailService() emailsender.EmailService {
 	return r.emailService
 }
 
+func (r *infraRegistry) EmailRenderer() *emailtemplate.Renderer {
+	return r.emailRenderer
+}
+
 func (r *infraRegistry) PaymentService() paymentgateway.PaymentGateway {
 	return r.paymentGateway
 }
@@ -100,3 +208,23 @@ func (r *infraRegistry) DBUrl() string {
 func (r *infraRegistry) AsyncTaskEnqueueClient() asynq.AsyncTaskEnqueueClient {
 	return r.asyncTaskEnqueueClient
 }
+
+func (r *infraRegistry) BlobStorage() blobstorage.BlobStorage {
+	return r.blobStorage
+}
+
+func (r *infraRegistry) SigningKeySet() *jwks.KeySet {
+	return r.signingKeySet
+}
+
+func (r *infraRegistry) JWKSClient() *jwks.Client {
+	return r.jwksClient
+}
+
+func (r *infraRegistry) OIDCProviders() map[string]*oidc.Provider {
+	return r.oidcProviders
+}
+
+func (r *infraRegistry) WebhookSender() *webhook.Sender {
+	return r.webhookSender
+}
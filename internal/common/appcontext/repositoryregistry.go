@@ -2,8 +2,11 @@ package appcontext
 
 import (
 	"booking-event/config"
+	authEmailRepo "booking-event/internal/modules/auth/repository/client/email"
+	authTaskRepo "booking-event/internal/modules/auth/repository/client/task"
 	authRepo "booking-event/internal/modules/auth/repository/store"
 	emailRepo "booking-event/internal/modules/booking/repository/client/email"
+	taskRepo "booking-event/internal/modules/booking/repository/client/task"
 	bookingRepo "booking-event/internal/modules/booking/repository/store"
 )
 
@@ -14,6 +17,29 @@ type RepositoryRegistry interface {
 	BookingEventTokenRepository() *bookingRepo.TokenRepository
 	BookingItemRepository() *bookingRepo.BookingItemRepository
 	BookingEmailRepository() *emailRepo.EmailClient
+	CategoryRepository() *bookingRepo.CategoryRepository
+	EventImageRepository() *bookingRepo.EventImageRepository
+	BookingUserRepository() *bookingRepo.UserRepository
+	EventReviewRepository() *bookingRepo.EventReviewRepository
+	BookingTaskRepository() *taskRepo.TaskClient
+	SalesStatsRepository() *bookingRepo.SalesStatsRepository
+	AttendeeRepository() *bookingRepo.AttendeeRepository
+	EventTemplateRepository() *bookingRepo.EventTemplateRepository
+	CalendarFeedRepository() *bookingRepo.CalendarFeedRepository
+	AuthTaskRepository() *authTaskRepo.TaskClient
+	AuthEmailRepository() *authEmailRepo.EmailClient
+	RefreshTokenRepository() *authRepo.RefreshTokenRepository
+	RevocationRepository() *authRepo.RevocationRepository
+	PasswordResetRepository() *authRepo.PasswordResetRepository
+	LoginAttemptRepository() *authRepo.LoginAttemptRepository
+	LoginAuditRepository() *authRepo.LoginAuditRepository
+	MFARepository() *authRepo.MFARepository
+	OIDCStateRepository() *authRepo.OIDCStateRepository
+	IdentityRepository() *authRepo.IdentityRepository
+	ServiceAccountRepository() *authRepo.ServiceAccountRepository
+	OutboxRepository() *bookingRepo.OutboxRepository
+	ReminderRepository() *bookingRepo.ReminderRepository
+	WebhookRepository() *bookingRepo.WebhookRepository
 }
 
 type repositoryRegistry struct {
@@ -23,6 +49,29 @@ type repositoryRegistry struct {
 	bookingEventTokenRepository *bookingRepo.TokenRepository
 	bookingItemRepository       *bookingRepo.BookingItemRepository
 	bookingEmailRepository      *emailRepo.EmailClient
+	categoryRepository          *bookingRepo.CategoryRepository
+	eventImageRepository        *bookingRepo.EventImageRepository
+	bookingUserRepository       *bookingRepo.UserRepository
+	eventReviewRepository       *bookingRepo.EventReviewRepository
+	bookingTaskRepository       *taskRepo.TaskClient
+	salesStatsRepository        *bookingRepo.SalesStatsRepository
+	attendeeRepository          *bookingRepo.AttendeeRepository
+	eventTemplateRepository     *bookingRepo.EventTemplateRepository
+	calendarFeedRepository      *bookingRepo.CalendarFeedRepository
+	authTaskRepository          *authTaskRepo.TaskClient
+	authEmailRepository         *authEmailRepo.EmailClient
+	refreshTokenRepository      *authRepo.RefreshTokenRepository
+	revocationRepository        *authRepo.RevocationRepository
+	passwordResetRepository     *authRepo.PasswordResetRepository
+	loginAttemptRepository      *authRepo.LoginAttemptRepository
+	loginAuditRepository        *authRepo.LoginAuditRepository
+	mfaRepository               *authRepo.MFARepository
+	oidcStateRepository         *authRepo.OIDCStateRepository
+	identityRepository          *authRepo.IdentityRepository
+	serviceAccountRepository    *authRepo.ServiceAccountRepository
+	outboxRepository            *bookingRepo.OutboxRepository
+	reminderRepository          *bookingRepo.ReminderRepository
+	webhookRepository           *bookingRepo.WebhookRepository
 }
 
 func NewRepositoryRegistry(
@@ -30,10 +79,13 @@ func NewRepositoryRegistry(
 	infraRegistry InfraRegistry,
 ) RepositoryRegistry {
 	bookingTokenRepo := bookingRepo.NewTokenRepository(infraRegistry.DB(), bookingRepo.TokenConfig{LockedDuration: config.Token.LockedDuration})
+	outboxRepo := bookingRepo.NewOutboxRepository(infraRegistry.DB())
+	reminderRepo := bookingRepo.NewReminderRepository(infraRegistry.DB(), outboxRepo)
 	return &repositoryRegistry{
 		eventRepository: bookingRepo.NewEventRepository(
 			infraRegistry.DB(),
 			bookingTokenRepo,
+			outboxRepo,
 		),
 		userRepository: authRepo.NewUserRepository(infraRegistry.DB()),
 		bookingRepository: bookingRepo.NewBookingRepository(
@@ -41,11 +93,39 @@ func NewRepositoryRegistry(
 			infraRegistry.PaymentService(),
 			bookingRepo.NewBookingItemRepository(infraRegistry.DB()),
 			bookingTokenRepo,
-			infraRegistry.AsyncTaskEnqueueClient(),
+			outboxRepo,
+			reminderRepo,
 		),
 		bookingEventTokenRepository: bookingTokenRepo,
 		bookingItemRepository:       bookingRepo.NewBookingItemRepository(infraRegistry.DB()),
-		bookingEmailRepository:      emailRepo.NewEmailClient(infraRegistry.EmailService()),
+		bookingEmailRepository: emailRepo.NewEmailClient(
+			infraRegistry.EmailService(),
+			infraRegistry.EmailRenderer(),
+			emailRepo.Config{From: config.Email.From, EventDuration: config.Calendar.EventDuration},
+		),
+		categoryRepository:       bookingRepo.NewCategoryRepository(infraRegistry.DB()),
+		eventImageRepository:     bookingRepo.NewEventImageRepository(infraRegistry.DB()),
+		bookingUserRepository:    bookingRepo.NewUserRepository(infraRegistry.DB()),
+		eventReviewRepository:    bookingRepo.NewEventReviewRepository(infraRegistry.DB()),
+		bookingTaskRepository:    taskRepo.NewTaskClient(infraRegistry.AsyncTaskEnqueueClient(), taskRepo.Config{Retention: config.Outbox.TaskRetention}),
+		salesStatsRepository:     bookingRepo.NewSalesStatsRepository(infraRegistry.DB()),
+		attendeeRepository:       bookingRepo.NewAttendeeRepository(infraRegistry.DB(), outboxRepo),
+		eventTemplateRepository:  bookingRepo.NewEventTemplateRepository(infraRegistry.DB()),
+		calendarFeedRepository:   bookingRepo.NewCalendarFeedRepository(infraRegistry.DB()),
+		authTaskRepository:       authTaskRepo.NewTaskClient(infraRegistry.AsyncTaskEnqueueClient()),
+		authEmailRepository:      authEmailRepo.NewEmailClient(infraRegistry.EmailService(), infraRegistry.EmailRenderer(), config.Email.From),
+		refreshTokenRepository:   authRepo.NewRefreshTokenRepository(infraRegistry.DB()),
+		revocationRepository:     authRepo.NewRevocationRepository(infraRegistry.Redis()),
+		passwordResetRepository:  authRepo.NewPasswordResetRepository(infraRegistry.DB()),
+		loginAttemptRepository:   authRepo.NewLoginAttemptRepository(infraRegistry.Redis()),
+		loginAuditRepository:     authRepo.NewLoginAuditRepository(infraRegistry.DB()),
+		mfaRepository:            authRepo.NewMFARepository(infraRegistry.DB()),
+		oidcStateRepository:      authRepo.NewOIDCStateRepository(infraRegistry.Redis()),
+		identityRepository:       authRepo.NewIdentityRepository(infraRegistry.DB()),
+		serviceAccountRepository: authRepo.NewServiceAccountRepository(infraRegistry.DB()),
+		outboxRepository:         outboxRepo,
+		reminderRepository:       reminderRepo,
+		webhookRepository:        bookingRepo.NewWebhookRepository(infraRegistry.DB(), outboxRepo),
 	}
 }
 
@@ -71,3 +151,95 @@ func (r *repositoryRegistry) BookingItemRepository() *bookingRepo.BookingItemRep
 func (r *repositoryRegistry) BookingEmailRepository() *emailRepo.EmailClient {
 	return r.bookingEmailRepository
 }
+
+func (r *repositoryRegistry) CategoryRepository() *bookingRepo.CategoryRepository {
+	return r.categoryRepository
+}
+
+func (r *repositoryRegistry) EventImageRepository() *bookingRepo.EventImageRepository {
+	return r.eventImageRepository
+}
+
+func (r *repositoryRegistry) BookingUserRepository() *bookingRepo.UserRepository {
+	return r.bookingUserRepository
+}
+
+func (r *repositoryRegistry) EventReviewRepository() *bookingRepo.EventReviewRepository {
+	return r.eventReviewRepository
+}
+
+func (r *repositoryRegistry) BookingTaskRepository() *taskRepo.TaskClient {
+	return r.bookingTaskRepository
+}
+
+func (r *repositoryRegistry) SalesStatsRepository() *bookingRepo.SalesStatsRepository {
+	return r.salesStatsRepository
+}
+
+func (r *repositoryRegistry) AttendeeRepository() *bookingRepo.AttendeeRepository {
+	return r.attendeeRepository
+}
+
+func (r *repositoryRegistry) EventTemplateRepository() *bookingRepo.EventTemplateRepository {
+	return r.eventTemplateRepository
+}
+
+func (r *repositoryRegistry) CalendarFeedRepository() *bookingRepo.CalendarFeedRepository {
+	return r.calendarFeedRepository
+}
+
+func (r *repositoryRegistry) AuthTaskRepository() *authTaskRepo.TaskClient {
+	return r.authTaskRepository
+}
+
+func (r *repositoryRegistry) AuthEmailRepository() *authEmailRepo.EmailClient {
+	return r.authEmailRepository
+}
+
+func (r *repositoryRegistry) RefreshTokenRepository() *authRepo.RefreshTokenRepository {
+	return r.refreshTokenRepository
+}
+
+func (r *repositoryRegistry) RevocationRepository() *authRepo.RevocationRepository {
+	return r.revocationRepository
+}
+
+func (r *repositoryRegistry) PasswordResetRepository() *authRepo.PasswordResetRepository {
+	return r.passwordResetRepository
+}
+
+func (r *repositoryRegistry) LoginAttemptRepository() *authRepo.LoginAttemptRepository {
+	return r.loginAttemptRepository
+}
+
+func (r *repositoryRegistry) LoginAuditRepository() *authRepo.LoginAuditRepository {
+	return r.loginAuditRepository
+}
+
+func (r *repositoryRegistry) MFARepository() *authRepo.MFARepository {
+	return r.mfaRepository
+}
+
+func (r *repositoryRegistry) OIDCStateRepository() *authRepo.OIDCStateRepository {
+	return r.oidcStateRepository
+}
+
+func (r *repositoryRegistry) IdentityRepository() *authRepo.IdentityRepository {
+	return r.identityRepository
+}
+
+func (r *repositoryRegistry) ServiceAccountRepository() *authRepo.ServiceAccountRepository {
+	return r.serviceAccountRepository
+}
+
+func (r *repositoryRegistry) OutboxRepository() *bookingRepo.OutboxRepository {
+	return r.outboxRepository
+}
+
+func (r *repositoryRegistry) ReminderRepository() *bookingRepo.ReminderRepository {
+	return r.reminderRepository
+}
+
+func (r *repositoryRegistry) WebhookRepository() *bookingRepo.WebhookRepository {
+	return r.webhookRepository
+}
@@ -1,9 +1,12 @@
 package appcontext
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"booking-event/config"
+	authModel "booking-event/internal/modules/auth/model"
 	authServices "booking-event/internal/modules/auth/services"
 	bookingServices "booking-event/internal/modules/booking/services"
 )
@@ -14,14 +17,54 @@ type ServiceRegistry interface {
 	BookingService() *bookingServices.BookingService
 	BookingEventTokenService() *bookingServices.EventTokenService
 	EmailService() *bookingServices.EmailService
+	CategoryService() *bookingServices.CategoryService
+	EventReviewService() *bookingServices.EventReviewService
+	DashboardService() *bookingServices.DashboardService
+	AttendeeService() *bookingServices.AttendeeService
+	EventTemplateService() *bookingServices.EventTemplateService
+	CalendarService() *bookingServices.CalendarService
+	ReminderService() *bookingServices.ReminderService
+	WebhookService() *bookingServices.WebhookService
+	RegistrationService() *authServices.RegistrationService
+	RevocationChecker() *authServices.RevocationChecker
+	TokenVerifier() *authServices.TokenVerifier
+	RoleService() *authServices.RoleService
+	PasswordService() *authServices.PasswordService
+	MFAService() *authServices.MFAService
+	OIDCService() *authServices.OIDCService
+	IntrospectionService() *authServices.IntrospectionService
+	ServiceAccountService() *authServices.ServiceAccountService
+	ProfileService() *authServices.ProfileService
+	AdminUserService() *authServices.AdminUserService
+	OutboxService() *bookingServices.OutboxService
 }
 
 type serviceRegistry struct {
-	eventService      *bookingServices.EventService
-	authService       *authServices.AuthService
-	bookingService    *bookingServices.BookingService
-	eventTokenService *bookingServices.EventTokenService
-	emailService      *bookingServices.EmailService
+	eventService          *bookingServices.EventService
+	authService           *authServices.AuthService
+	bookingService        *bookingServices.BookingService
+	eventTokenService     *bookingServices.EventTokenService
+	emailService          *bookingServices.EmailService
+	categoryService       *bookingServices.CategoryService
+	eventReviewService    *bookingServices.EventReviewService
+	dashboardService      *bookingServices.DashboardService
+	attendeeService       *bookingServices.AttendeeService
+	eventTemplateService  *bookingServices.EventTemplateService
+	calendarService       *bookingServices.CalendarService
+	reminderService       *bookingServices.ReminderService
+	webhookService        *bookingServices.WebhookService
+	registrationService   *authServices.RegistrationService
+	revocationChecker     *authServices.RevocationChecker
+	tokenVerifier         *authServices.TokenVerifier
+	roleService           *authServices.RoleService
+	passwordService       *authServices.PasswordService
+	mfaService            *authServices.MFAService
+	oidcService           *authServices.OIDCService
+	introspectionService  *authServices.IntrospectionService
+	serviceAccountService *authServices.ServiceAccountService
+	profileService        *authServices.ProfileService
+	adminUserService      *authServices.AdminUserService
+	outboxService         *bookingServices.OutboxService
 }
 
 func NewServiceRegistry(
@@ -35,37 +78,238 @@ func NewServiceRegistry(
 			return uuid.New().String()
 		},
 	)
+	reminderPolicy := bookingServices.ReminderPolicy{Schedule: config.Booking.ReminderSchedule}
+	eventService := bookingServices.NewEventService(
+		repositoryRegistry.EventRepository(),
+		repositoryRegistry.CategoryRepository(),
+		repositoryRegistry.EventImageRepository(),
+		infraRegistry.BlobStorage(),
+		func() string {
+			return uuid.New().String()
+		},
+		bookingServices.EventConfig{
+			Currency:     config.SupportingMoney.Currency,
+			MaxImageSize: config.BlobStorage.MaxUploadSize,
+		},
+	)
+	// central-auth checks its own tokens with the keys it holds, the other servers fetch them from its JWKS
+	var tokenKeys authServices.PublicKeyResolver = infraRegistry.JWKSClient()
+	if infraRegistry.SigningKeySet().CanSign() {
+		tokenKeys = infraRegistry.SigningKeySet()
+	}
+	tokenVerifier := authServices.NewTokenVerifier(tokenKeys)
+	revocationChecker := authServices.NewRevocationChecker(
+		repositoryRegistry.RevocationRepository(),
+		time.Now,
+		authServices.RevocationCheckerConfig{
+			CacheTTL:  config.Revocation.CacheTTL,
+			CacheSize: config.Revocation.CacheSize,
+		},
+	)
+	loginGuard := authServices.NewLoginGuard(
+		repositoryRegistry.LoginAttemptRepository(),
+		repositoryRegistry.LoginAuditRepository(),
+		repositoryRegistry.AuthTaskRepository(),
+		time.Now,
+		authServices.LoginGuardConfig{
+			IPWindow:           config.LoginProtection.IPWindow,
+			IPMaxAttempts:      config.LoginProtection.IPMaxAttempts,
+			AccountWindow:      config.LoginProtection.AccountWindow,
+			AccountMaxFailures: config.LoginProtection.AccountMaxFailures,
+			LockoutBase:        config.LoginProtection.LockoutBase,
+			LockoutMax:         config.LoginProtection.LockoutMax,
+			UnlockURL:          config.LoginProtection.UnlockURL,
+		},
+	)
+	mfaRequiredRoles := make([]authModel.UserRole, 0, len(config.MFA.RequiredRoles))
+	for _, role := range config.MFA.RequiredRoles {
+		mfaRequiredRoles = append(mfaRequiredRoles, authModel.UserRole(role))
+	}
+	mfaService := authServices.NewMFAService(
+		repositoryRegistry.UserRepository(),
+		repositoryRegistry.MFARepository(),
+		time.Now,
+		authServices.MFAConfig{
+			Issuer:        config.MFA.Issuer,
+			RequiredRoles: mfaRequiredRoles,
+			RecoveryCodes: config.MFA.RecoveryCodes,
+		},
+	)
+	authService := authServices.NewAuthService(
+		repositoryRegistry.UserRepository(),
+		repositoryRegistry.RefreshTokenRepository(),
+		repositoryRegistry.RevocationRepository(),
+		loginGuard,
+		mfaService,
+		infraRegistry.SigningKeySet(),
+		tokenKeys,
+		func() string {
+			return uuid.New().String()
+		},
+		authServices.AuthServiceConfig{
+			AccessTokenExp:  config.JWT.AccessTokenExp,
+			RefreshTokenExp: config.JWT.RefreshTokenExp,
+			MFAChallengeExp: config.MFA.ChallengeExp,
+		},
+	)
+	identityProviders := make(map[string]authServices.IdentityProvider, len(infraRegistry.OIDCProviders()))
+	for name, provider := range infraRegistry.OIDCProviders() {
+		identityProviders[name] = provider
+	}
 	return &serviceRegistry{
-		eventService: bookingServices.NewEventService(
-			repositoryRegistry.EventRepository(),
-			config.SupportingMoney.Currency,
-			func() string {
-				return uuid.New().String()
-			},
-		),
-		authService: authServices.NewAuthService(
+		eventService: eventService,
+		authService:  authService,
+		mfaService:   mfaService,
+		oidcService: authServices.NewOIDCService(
+			identityProviders,
+			repositoryRegistry.OIDCStateRepository(),
+			repositoryRegistry.IdentityRepository(),
 			repositoryRegistry.UserRepository(),
-			authServices.AuthServiceConfig{
-				SecretKey:       config.JWT.SecretKey,
-				AccessTokenExp:  config.JWT.AccessTokenExp,
-				RefreshTokenExp: config.JWT.RefreshTokenExp,
+			authService,
+			authServices.OIDCConfig{
+				StateTTL: config.OIDC.StateTTL,
 			},
 		),
 		bookingService: bookingServices.NewBookingService(
 			repositoryRegistry.EventRepository(),
 			repositoryRegistry.BookingEventTokenRepository(),
+			repositoryRegistry.BookingUserRepository(),
 			repositoryRegistry.BookingRepository(),
 			repositoryRegistry.BookingItemRepository(),
 			infraRegistry.PaymentService(),
 			bookingServices.BookingConfig{
 				MaxBookingPerUser: config.Booking.MaxBookingPerUser,
+				Reminders:         reminderPolicy,
 			},
 		),
 		eventTokenService: bookingEventTokenService,
 		emailService: bookingServices.NewEmailService(
 			repositoryRegistry.BookingRepository(),
+			repositoryRegistry.EventRepository(),
+			repositoryRegistry.BookingUserRepository(),
 			repositoryRegistry.BookingEmailRepository(),
 		),
+		categoryService: bookingServices.NewCategoryService(repositoryRegistry.CategoryRepository()),
+		eventReviewService: bookingServices.NewEventReviewService(
+			repositoryRegistry.EventRepository(),
+			repositoryRegistry.EventReviewRepository(),
+			repositoryRegistry.BookingUserRepository(),
+			repositoryRegistry.BookingTaskRepository(),
+		),
+		dashboardService: bookingServices.NewDashboardService(
+			repositoryRegistry.SalesStatsRepository(),
+			repositoryRegistry.EventRepository(),
+			time.Now,
+			bookingServices.DashboardConfig{
+				RefreshLookback:    config.Dashboard.RefreshLookback,
+				MaxTimeSeriesRange: config.Dashboard.MaxTimeSeriesRange,
+			},
+		),
+		outboxService: bookingServices.NewOutboxService(
+			repositoryRegistry.OutboxRepository(),
+			repositoryRegistry.BookingTaskRepository(),
+			time.Now,
+			bookingServices.OutboxConfig{
+				BatchSize:       config.Outbox.BatchSize,
+				MaxAttempts:     config.Outbox.MaxAttempts,
+				RetryBackoff:    config.Outbox.RetryBackoff,
+				MaxRetryBackoff: config.Outbox.MaxRetryBackoff,
+				LeaseDuration:   config.Outbox.LeaseDuration,
+			},
+		),
+		attendeeService: bookingServices.NewAttendeeService(
+			repositoryRegistry.AttendeeRepository(),
+			repositoryRegistry.EventRepository(),
+		),
+		eventTemplateService: bookingServices.NewEventTemplateService(
+			repositoryRegistry.EventTemplateRepository(),
+			repositoryRegistry.EventRepository(),
+			eventService,
+		),
+		calendarService: bookingServices.NewCalendarService(
+			repositoryRegistry.CalendarFeedRepository(),
+			time.Now,
+			bookingServices.CalendarConfig{
+				FeedURL:         config.Calendar.FeedURL,
+				EventDuration:   config.Calendar.EventDuration,
+				RefreshInterval: config.Calendar.RefreshInterval,
+			},
+		),
+		reminderService: bookingServices.NewReminderService(
+			repositoryRegistry.BookingRepository(),
+			repositoryRegistry.EventRepository(),
+			repositoryRegistry.BookingUserRepository(),
+			repositoryRegistry.ReminderRepository(),
+			repositoryRegistry.BookingTaskRepository(),
+			time.Now,
+			reminderPolicy,
+		),
+		webhookService: bookingServices.NewWebhookService(
+			repositoryRegistry.WebhookRepository(),
+			infraRegistry.WebhookSender(),
+			time.Now,
+			bookingServices.WebhookConfig{
+				AllowInsecureURL: config.Webhook.AllowInsecureURL,
+				MaxAttempts:      config.Webhook.MaxAttempts,
+				RetryBackoff:     config.Webhook.RetryBackoff,
+				MaxRetryBackoff:  config.Webhook.MaxRetryBackoff,
+				DisableAfter:     config.Webhook.DisableAfter,
+			},
+		),
+		registrationService: authServices.NewRegistrationService(
+			repositoryRegistry.UserRepository(),
+			repositoryRegistry.AuthTaskRepository(),
+			infraRegistry.SigningKeySet(),
+			tokenKeys,
+			time.Now,
+			authServices.RegistrationConfig{
+				VerificationURL:      config.Registration.VerificationURL,
+				VerificationTokenExp: config.Registration.VerificationTokenExp,
+			},
+		),
+		revocationChecker:    revocationChecker,
+		tokenVerifier:        tokenVerifier,
+		introspectionService: authServices.NewIntrospectionService(tokenVerifier, revocationChecker),
+		serviceAccountService: authServices.NewServiceAccountService(
+			repositoryRegistry.UserRepository(),
+			repositoryRegistry.ServiceAccountRepository(),
+			time.Now,
+			authServices.ServiceAccountConfig{
+				RotationGrace:    config.APIKeys.RotationGrace,
+				LastUsedInterval: config.APIKeys.LastUsedInterval,
+			},
+		),
+		roleService: authServices.NewRoleService(
+			repositoryRegistry.UserRepository(),
+			repositoryRegistry.RevocationRepository(),
+			time.Now,
+			authServices.RoleServiceConfig{
+				AccessTokenExp: config.JWT.AccessTokenExp,
+			},
+		),
+		passwordService: authServices.NewPasswordService(
+			repositoryRegistry.UserRepository(),
+			repositoryRegistry.PasswordResetRepository(),
+			repositoryRegistry.AuthTaskRepository(),
+			authService,
+			time.Now,
+			authServices.PasswordConfig{
+				ResetURL:      config.PasswordReset.URL,
+				ResetTokenExp: config.PasswordReset.TokenExp,
+			},
+		),
+		profileService: authServices.NewProfileService(
+			repositoryRegistry.UserRepository(),
+			repositoryRegistry.AuthTaskRepository(),
+			infraRegistry.SigningKeySet(),
+			tokenKeys,
+			time.Now,
+			authServices.ProfileConfig{
+				EmailChangeURL:      config.Profile.EmailChangeURL,
+				EmailChangeTokenExp: config.Profile.EmailChangeTokenExp,
+			},
+		),
+		adminUserService: authServices.NewAdminUserService(repositoryRegistry.UserRepository(), authService),
 	}
 }
 
@@ -88,3 +332,83 @@ func (s *serviceRegistry) BookingEventTokenService() *bookingServices.EventToken
 func (s *serviceRegistry) EmailService() *bookingServices.EmailService {
 	return s.emailService
 }
+
+func (s *serviceRegistry) CategoryService() *bookingServices.CategoryService {
+	return s.categoryService
+}
+
+func (s *serviceRegistry) EventReviewService() *bookingServices.EventReviewService {
+	return s.eventReviewService
+}
+
+func (s *serviceRegistry) DashboardService() *bookingServices.DashboardService {
+	return s.dashboardService
+}
+
+func (s *serviceRegistry) AttendeeService() *bookingServices.AttendeeService {
+	return s.attendeeService
+}
+
+func (s *serviceRegistry) EventTemplateService() *bookingServices.EventTemplateService {
+	return s.eventTemplateService
+}
+
+func (s *serviceRegistry) CalendarService() *bookingServices.CalendarService {
+	return s.calendarService
+}
+
+func (s *serviceRegistry) ReminderService() *bookingServices.ReminderService {
+	return s.reminderService
+}
+
+func (s *serviceRegistry) WebhookService() *bookingServices.WebhookService {
+	return s.webhookService
+}
+
+func (s *serviceRegistry) RegistrationService() *authServices.RegistrationService {
+	return s.registrationService
+}
+
+func (s *serviceRegistry) RevocationChecker() *authServices.RevocationChecker {
+	return s.revocationChecker
+}
+
+func (s *serviceRegistry) TokenVerifier() *authServices.TokenVerifier {
+	return s.tokenVerifier
+}
+
+func (s *serviceRegistry) RoleService() *authServices.RoleService {
+	return s.roleService
+}
+
+func (s *serviceRegistry) PasswordService() *authServices.PasswordService {
+	return s.passwordService
+}
+
+func (s *serviceRegistry) MFAService() *authServices.MFAService {
+	return s.mfaService
+}
+
+func (s *serviceRegistry) OIDCService() *authServices.OIDCService {
+	return s.oidcService
+}
+
+func (s *serviceRegistry) IntrospectionService() *authServices.IntrospectionService {
+	return s.introspectionService
+}
+
+func (s *serviceRegistry) ServiceAccountService() *authServices.ServiceAccountService {
+	return s.serviceAccountService
+}
+
+func (s *serviceRegistry) ProfileService() *authServices.ProfileService {
+	return s.profileService
+}
+
+func (s *serviceRegistry) AdminUserService() *authServices.AdminUserService {
+	return s.adminUserService
+}
+
+func (s *serviceRegistry) OutboxService() *bookingServices.OutboxService {
+	return s.outboxService
+}
@@ -1,8 +1,20 @@
 package model
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
 type Pagination struct {
-	Limit int `json:"limit"`
-	Page  int `json:"page"` // 1-indexed page number
+	Limit int `json:"limit" form:"limit"`
+	Page  int `json:"page" form:"page"` // 1-indexed page number, used by the admin UI
+	// Cursor is the opaque next_cursor/prev_cursor of a previous response.
+	// Keyset pagination is used when it is set or when no page is requested.
+	Cursor string `json:"cursor" form:"cursor"`
 }
 
 func (p *Pagination) GetLimit() int {
@@ -18,3 +30,79 @@ func (p *Pagination) GetOffset() int {
 	}
 	return (p.Page - 1) * p.Limit
 }
+
+func (p *Pagination) UseCursor() bool {
+	return p.Cursor != "" || p.Page == 0
+}
+
+// GetCursor decodes the requested cursor, it returns nil for the first page.
+func (p *Pagination) GetCursor() (*Cursor, error) {
+	if p.Cursor == "" {
+		return nil, nil
+	}
+	return DecodeCursor(p.Cursor)
+}
+
+// Cursor is the position of a row in a keyset ordered listing: the value of the
+// sort column (a timestamp or a number) followed by the row id as tie breaker.
+type Cursor struct {
+	Time     *time.Time `json:"t,omitempty"`
+	Number   *float64   `json:"n,omitempty"`
+	ID       int        `json:"id"`
+	Backward bool       `json:"b,omitempty"`
+}
+
+func EncodeCursor(c Cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeCursor(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.ID == 0 || (c.Time == nil && c.Number == nil) {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+	PrevCursor string
+}
+
+// NewCursorPage builds a page from rows fetched in the direction of cursor with
+// one extra row, which only tells whether there are more rows after the page.
+func NewCursorPage[T any](rows []T, limit int, cursor *Cursor, cursorOf func(T) Cursor) *Page[T] {
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	page := &Page[T]{Items: rows}
+	if len(rows) == 0 {
+		return page
+	}
+	if (backward && hasMore) || (!backward && cursor != nil) {
+		prev := cursorOf(rows[0])
+		prev.Backward = true
+		page.PrevCursor = EncodeCursor(prev)
+	}
+	if backward || hasMore {
+		page.NextCursor = EncodeCursor(cursorOf(rows[len(rows)-1]))
+	}
+	return page
+}
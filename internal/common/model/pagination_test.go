@@ -0,0 +1,66 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 10, 1, 10, 0, 0, 123456000, time.UTC)
+	cursor := Cursor{Time: &now, ID: 10, Backward: true}
+
+	decoded, err := DecodeCursor(EncodeCursor(cursor))
+	assert.NoError(t, err)
+	assert.True(t, now.Equal(*decoded.Time))
+	assert.Equal(t, 10, decoded.ID)
+	assert.True(t, decoded.Backward)
+
+	_, err = DecodeCursor("not-a-cursor")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = DecodeCursor(EncodeCursor(Cursor{ID: 1}))
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestNewCursorPage(t *testing.T) {
+	t.Parallel()
+	cursorOf := func(id int) Cursor {
+		n := float64(id)
+		return Cursor{Number: &n, ID: id}
+	}
+	idOf := func(s string) int {
+		c, err := DecodeCursor(s)
+		assert.NoError(t, err)
+		return c.ID
+	}
+
+	t.Run("First page with more rows", func(t *testing.T) {
+		page := NewCursorPage([]int{1, 2, 3}, 2, nil, cursorOf)
+		assert.Equal(t, []int{1, 2}, page.Items)
+		assert.Equal(t, 2, idOf(page.NextCursor))
+		assert.Empty(t, page.PrevCursor)
+	})
+
+	t.Run("Last page forward", func(t *testing.T) {
+		page := NewCursorPage([]int{3, 4}, 2, &Cursor{ID: 2}, cursorOf)
+		assert.Equal(t, []int{3, 4}, page.Items)
+		assert.Empty(t, page.NextCursor)
+		assert.Equal(t, 3, idOf(page.PrevCursor))
+	})
+
+	t.Run("Backward page with more rows", func(t *testing.T) {
+		page := NewCursorPage([]int{4, 3, 2}, 2, &Cursor{ID: 5, Backward: true}, cursorOf)
+		assert.Equal(t, []int{3, 4}, page.Items)
+		assert.Equal(t, 4, idOf(page.NextCursor))
+		assert.Equal(t, 3, idOf(page.PrevCursor))
+	})
+
+	t.Run("Backward to the first page", func(t *testing.T) {
+		page := NewCursorPage([]int{2, 1}, 2, &Cursor{ID: 3, Backward: true}, cursorOf)
+		assert.Equal(t, []int{1, 2}, page.Items)
+		assert.Equal(t, 2, idOf(page.NextCursor))
+		assert.Empty(t, page.PrevCursor)
+	})
+}
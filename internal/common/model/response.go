@@ -1,7 +1,9 @@
 package model
 
 type Response struct {
-	Success bool   `json:"success"`
-	Data    any    `json:"data"`
-	Message string `json:"message"`
+	Success    bool   `json:"success"`
+	Data       any    `json:"data"`
+	Message    string `json:"message"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
@@ -4,16 +4,47 @@ import (
 	"context"
 )
 
-type UserContextKey struct{}
+type PrincipalContextKey struct{}
+
+type PrincipalType string
+
+const (
+	PrincipalTypeUser    PrincipalType = "user"
+	PrincipalTypeService PrincipalType = "service"
+)
+
+// Principal is the caller of a request, a user with an access token or a service account with an API key. A
+// service account acts for the user owning it, so UserID is set for both, but it never carries the role of the
+// owner: its permissions are the scopes of the key.
+type Principal struct {
+	Type             PrincipalType
+	UserID           int
+	ServiceAccountID int // only set for service accounts
+	APIKeyID         int // only set for service accounts
+	Role             string
+	Permissions      []string
+}
+
+func SetPrincipalContext(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, PrincipalContextKey{}, principal)
+}
+
+func GetPrincipalContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(PrincipalContextKey{}).(Principal)
+	return principal, ok
+}
 
 func GetUserIDContext(ctx context.Context) int {
-	userID, ok := ctx.Value(UserContextKey{}).(int)
-	if !ok {
-		return 0
-	}
-	return userID
+	principal, _ := GetPrincipalContext(ctx)
+	return principal.UserID
+}
+
+func GetUserRoleContext(ctx context.Context) string {
+	principal, _ := GetPrincipalContext(ctx)
+	return principal.Role
 }
 
-func SetUserIDContext(ctx context.Context, userID int) context.Context {
-	return context.WithValue(ctx, UserContextKey{}, userID)
+func GetUserPermissionsContext(ctx context.Context) []string {
+	principal, _ := GetPrincipalContext(ctx)
+	return principal.Permissions
 }
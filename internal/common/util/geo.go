@@ -0,0 +1,69 @@
+package util
+
+import "math"
+
+const EarthRadiusKm = 6371.0
+
+// BoundingBox is the smallest latitude/longitude rectangle that contains a circle
+// on the earth surface. MinLng is greater than MaxLng when the box crosses the
+// antimeridian.
+type BoundingBox struct {
+	MinLat float64
+	MaxLat float64
+	MinLng float64
+	MaxLng float64
+}
+
+func (b BoundingBox) CrossesAntimeridian() bool {
+	return b.MinLng > b.MaxLng
+}
+
+func NewBoundingBox(lat, lng, radiusKm float64) BoundingBox {
+	angular := radiusKm / EarthRadiusKm
+	latRad := degToRad(lat)
+	minLat := latRad - angular
+	maxLat := latRad + angular
+
+	// the circle covers a pole, every longitude is inside the box
+	if minLat <= -math.Pi/2 || maxLat >= math.Pi/2 {
+		return BoundingBox{
+			MinLat: math.Max(radToDeg(minLat), -90),
+			MaxLat: math.Min(radToDeg(maxLat), 90),
+			MinLng: -180,
+			MaxLng: 180,
+		}
+	}
+
+	deltaLng := math.Asin(math.Sin(angular) / math.Cos(latRad))
+	minLng := radToDeg(degToRad(lng) - deltaLng)
+	maxLng := radToDeg(degToRad(lng) + deltaLng)
+	if minLng < -180 {
+		minLng += 360
+	}
+	if maxLng > 180 {
+		maxLng -= 360
+	}
+
+	return BoundingBox{
+		MinLat: radToDeg(minLat),
+		MaxLat: radToDeg(maxLat),
+		MinLng: minLng,
+		MaxLng: maxLng,
+	}
+}
+
+// HaversineDistanceKm returns the great-circle distance between two points in kilometers.
+func HaversineDistanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := degToRad(lat2 - lat1)
+	dLng := degToRad(lng2 - lng1)
+	a := math.Pow(math.Sin(dLat/2), 2) + math.Cos(degToRad(lat1))*math.Cos(degToRad(lat2))*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+func degToRad(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func radToDeg(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,46 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHaversineDistanceKm(t *testing.T) {
+	t.Parallel()
+	// Ho Chi Minh City -> Ha Noi
+	distance := HaversineDistanceKm(10.7769, 106.7009, 21.0285, 105.8542)
+	assert.InDelta(t, 1141, distance, 5)
+	assert.InDelta(t, 0, HaversineDistanceKm(10.7769, 106.7009, 10.7769, 106.7009), 0.0001)
+}
+
+func TestNewBoundingBox(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                string
+		lat, lng, radiusKm  float64
+		crossesAntimeridian bool
+		fullLongitude       bool
+	}{
+		{name: "Regular box", lat: 10.7769, lng: 106.7009, radiusKm: 20},
+		{name: "Crosses antimeridian", lat: -17.7134, lng: 179.9, radiusKm: 50, crossesAntimeridian: true},
+		{name: "Covers north pole", lat: 89.9, lng: 0, radiusKm: 50, fullLongitude: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			box := NewBoundingBox(tt.lat, tt.lng, tt.radiusKm)
+			assert.Equal(t, tt.crossesAntimeridian, box.CrossesAntimeridian())
+			assert.Less(t, box.MinLat, tt.lat)
+			assert.Greater(t, box.MaxLat, tt.lat)
+			if tt.fullLongitude {
+				assert.Equal(t, -180.0, box.MinLng)
+				assert.Equal(t, 180.0, box.MaxLng)
+				return
+			}
+			// points on the edge of the circle must be inside the box
+			assert.InDelta(t, tt.radiusKm, HaversineDistanceKm(tt.lat, tt.lng, box.MaxLat, tt.lng), 0.01)
+			assert.InDelta(t, tt.radiusKm, HaversineDistanceKm(tt.lat, tt.lng, box.MinLat, tt.lng), 0.01)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package util
+
+import (
+	"sync"
+	"time"
+)
+
+type ttlCacheEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTLCache is an in-process cache of at most size entries, each kept until its own expiry. It is safe for concurrent
+// use, the time is passed in so the callers keep control of the clock.
+type TTLCache[V any] struct {
+	size int
+
+	mu      sync.Mutex
+	entries map[string]ttlCacheEntry[V]
+}
+
+func NewTTLCache[V any](size int) *TTLCache[V] {
+	return &TTLCache[V]{size: size, entries: make(map[string]ttlCacheEntry[V])}
+}
+
+// Get returns the value of the key if it has not expired at now.
+func (c *TTLCache[V]) Get(key string, now time.Time) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Set keeps the value until expiresAt. A full cache drops its expired entries first, the value is not kept when
+// none had expired.
+func (c *TTLCache[V]) Set(key string, value V, expiresAt time.Time, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= c.size {
+			return
+		}
+	}
+	c.entries[key] = ttlCacheEntry[V]{value: value, expiresAt: expiresAt}
+}
@@ -0,0 +1,40 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTLCache(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	cache := NewTTLCache[int](2)
+
+	cache.Set("a", 1, now.Add(time.Second), now)
+	cache.Set("b", 2, now.Add(time.Minute), now)
+	value, ok := cache.Get("a", now)
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	// full and nothing expired, the new entry is not kept
+	cache.Set("c", 3, now.Add(time.Minute), now)
+	_, ok = cache.Get("c", now)
+	assert.False(t, ok)
+
+	// an existing entry is still replaced
+	cache.Set("b", 4, now.Add(time.Minute), now)
+	value, ok = cache.Get("b", now)
+	assert.True(t, ok)
+	assert.Equal(t, 4, value)
+
+	// the expired entry makes room
+	now = now.Add(2 * time.Second)
+	_, ok = cache.Get("a", now)
+	assert.False(t, ok)
+	cache.Set("c", 3, now.Add(time.Minute), now)
+	value, ok = cache.Get("c", now)
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+}
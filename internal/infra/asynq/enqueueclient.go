@@ -2,21 +2,29 @@ package asynq
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hibiken/asynq"
 )
 
+// DefaultQueue is the queue of the tasks enqueued without a queue option.
+const DefaultQueue = "default"
+
 type AsyncTaskEnqueueClient interface {
 	Enqueue(ctx context.Context, task *asynq.Task, opts ...asynq.Option) error
+	// Delete removes a task waiting in the queue, a task already processed or unknown is ignored.
+	Delete(ctx context.Context, queue string, taskID string) error
 }
 
 type enqueueClient struct {
-	client *asynq.Client
+	client    *asynq.Client
+	inspector *asynq.Inspector
 }
 
 func NewEnqueueClient(config Config) AsyncTaskEnqueueClient {
 	return &enqueueClient{
-		client: asynq.NewClient(asynq.RedisClientOpt{Addr: config.Addr}),
+		client:    asynq.NewClient(asynq.RedisClientOpt{Addr: config.Addr}),
+		inspector: asynq.NewInspector(asynq.RedisClientOpt{Addr: config.Addr}),
 	}
 }
 
@@ -24,3 +32,11 @@ func (c *enqueueClient) Enqueue(ctx context.Context, task *asynq.Task, opts ...a
 	_, err := c.client.EnqueueContext(ctx, task, opts...)
 	return err
 }
+
+func (c *enqueueClient) Delete(ctx context.Context, queue string, taskID string) error {
+	err := c.inspector.DeleteTask(queue, taskID)
+	if errors.Is(err, asynq.ErrTaskNotFound) || errors.Is(err, asynq.ErrQueueNotFound) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,29 @@
+package asynq
+
+import (
+	"github.com/hibiken/asynq"
+)
+
+// Scheduler enqueues periodic tasks, the tasks are processed by the AsynqServer like any other task.
+type Scheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewScheduler(config Config) *Scheduler {
+	return &Scheduler{
+		scheduler: asynq.NewScheduler(asynq.RedisClientOpt{Addr: config.Addr}, nil),
+	}
+}
+
+func (s *Scheduler) Register(cronspec string, task *asynq.Task, opts ...asynq.Option) error {
+	_, err := s.scheduler.Register(cronspec, task, opts...)
+	return err
+}
+
+func (s *Scheduler) Start() error {
+	return s.scheduler.Start()
+}
+
+func (s *Scheduler) Shutdown() {
+	s.scheduler.Shutdown()
+}
@@ -0,0 +1,99 @@
+package blobstorage
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+type LocalConfig struct {
+	Dir     string
+	BaseURL string // public url the directory is served from, e.g. http://localhost:5000/media
+}
+
+type localBlobStorage struct {
+	cfg LocalConfig
+}
+
+func NewLocalBlobStorage(cfg LocalConfig) BlobStorage {
+	return &localBlobStorage{cfg: cfg}
+}
+
+func (s *localBlobStorage) Put(ctx context.Context, key string, content io.Reader, contentType string) (*Object, error) {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return nil, err
+	}
+
+	// write to a temporary file first so readers never see a partially written object
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	size, err := io.Copy(tmp, content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return nil, err
+	}
+
+	return &Object{
+		Key:         key,
+		URL:         s.url(key),
+		ContentType: contentType,
+		Size:        size,
+	}, nil
+}
+
+func (s *localBlobStorage) Copy(ctx context.Context, srcKey string, dstKey string, contentType string) (*Object, error) {
+	srcPath, err := s.filePath(srcKey)
+	if err != nil {
+		return nil, err
+	}
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	return s.Put(ctx, dstKey, src, contentType)
+}
+
+func (s *localBlobStorage) Delete(ctx context.Context, key string) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *localBlobStorage) filePath(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if key == "" || cleaned == "/" || cleaned != "/"+key || strings.Contains(key, "\\") {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.cfg.Dir, filepath.FromSlash(cleaned)), nil
+}
+
+func (s *localBlobStorage) url(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.TrimRight(s.cfg.BaseURL, "/") + "/" + strings.Join(segments, "/")
+}
@@ -0,0 +1,44 @@
+package blobstorage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalBlobStorage(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	storage := NewLocalBlobStorage(LocalConfig{Dir: dir, BaseURL: "http://localhost:5000/media/"})
+
+	object, err := storage.Put(context.Background(), "events/1/cover image.png", strings.NewReader("content"), "image/png")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:5000/media/events/1/cover%20image.png", object.URL)
+	assert.Equal(t, int64(7), object.Size)
+
+	content, err := os.ReadFile(filepath.Join(dir, "events", "1", "cover image.png"))
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	copied, err := storage.Copy(context.Background(), "events/1/cover image.png", "events/2/cover.png", "image/png")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:5000/media/events/2/cover.png", copied.URL)
+	assert.Equal(t, int64(7), copied.Size)
+	content, err = os.ReadFile(filepath.Join(dir, "events", "2", "cover.png"))
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	assert.NoError(t, storage.Delete(context.Background(), "events/1/cover image.png"))
+	assert.NoError(t, storage.Delete(context.Background(), "events/1/cover image.png"))
+	_, err = os.Stat(filepath.Join(dir, "events", "1", "cover image.png"))
+	assert.True(t, os.IsNotExist(err))
+
+	for _, key := range []string{"", "../outside.png", "events/../../outside.png", "/absolute.png", "events//1.png"} {
+		_, err := storage.Put(context.Background(), key, strings.NewReader("content"), "image/png")
+		assert.ErrorIs(t, err, ErrInvalidKey, key)
+	}
+}
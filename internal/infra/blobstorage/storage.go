@@ -0,0 +1,23 @@
+package blobstorage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrInvalidKey = errors.New("invalid storage key")
+
+type Object struct {
+	Key         string
+	URL         string
+	ContentType string
+	Size        int64
+}
+
+type BlobStorage interface {
+	Put(ctx context.Context, key string, content io.Reader, contentType string) (*Object, error)
+	// Copy duplicates the object stored at srcKey to dstKey.
+	Copy(ctx context.Context, srcKey string, dstKey string, contentType string) (*Object, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -3,10 +3,21 @@ package emailsender
 import "context"
 
 type Email struct {
-	From    string
-	To      string
-	Subject string
-	Body    string
+	From     string
+	To       string
+	Subject  string
+	Body     string
+	HTMLBody string // optional alternative to the plain text Body
+	// Inline are the attachments referenced from HTMLBody by their content id, like the ticket QR codes.
+	Inline      []Attachment
+	Attachments []Attachment
+}
+
+type Attachment struct {
+	ContentID   string
+	Filename    string
+	ContentType string
+	Data        []byte
 }
 
 type EmailService interface {
@@ -0,0 +1,156 @@
+package emailsender
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+)
+
+// part is a MIME entity not written yet, the multipart ones hold their parts already encoded in body.
+type part struct {
+	header textproto.MIMEHeader
+	body   []byte
+}
+
+// buildMessage encodes the email as a MIME message: the text, the HTML with its inline attachments in a
+// multipart/related, both in a multipart/alternative, and the attachments around them in a multipart/mixed.
+func buildMessage(email *Email, from *mail.Address, to *mail.Address, now time.Time) ([]byte, error) {
+	body, err := textPart("text/plain", email.Body)
+	if err != nil {
+		return nil, err
+	}
+	if email.HTMLBody != "" {
+		html, err := textPart("text/html", email.HTMLBody)
+		if err != nil {
+			return nil, err
+		}
+		if len(email.Inline) > 0 {
+			parts := []part{html}
+			for _, attachment := range email.Inline {
+				parts = append(parts, attachmentPart(attachment, "inline"))
+			}
+			if html, err = multipartPart("related", parts); err != nil {
+				return nil, err
+			}
+		}
+		if body, err = multipartPart("alternative", []part{body, html}); err != nil {
+			return nil, err
+		}
+	}
+	if len(email.Attachments) > 0 {
+		parts := []part{body}
+		for _, attachment := range email.Attachments {
+			parts = append(parts, attachmentPart(attachment, "attachment"))
+		}
+		if body, err = multipartPart("mixed", parts); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	writeHeader(&buf, "From", from.String())
+	writeHeader(&buf, "To", to.String())
+	writeHeader(&buf, "Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	writeHeader(&buf, "Date", now.Format(time.RFC1123Z))
+	writeHeader(&buf, "Message-ID", messageID(from))
+	writeHeader(&buf, "MIME-Version", "1.0")
+	keys := make([]string, 0, len(body.header))
+	for key := range body.header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		writeHeader(&buf, key, body.header.Get(key))
+	}
+	buf.WriteString("\r\n")
+	buf.Write(body.body)
+	return buf.Bytes(), nil
+}
+
+func writeHeader(buf *bytes.Buffer, key string, value string) {
+	buf.WriteString(key)
+	buf.WriteString(": ")
+	buf.WriteString(value)
+	buf.WriteString("\r\n")
+}
+
+func textPart(contentType string, content string) (part, error) {
+	var body bytes.Buffer
+	w := quotedprintable.NewWriter(&body)
+	if _, err := w.Write([]byte(content)); err != nil {
+		return part{}, err
+	}
+	if err := w.Close(); err != nil {
+		return part{}, err
+	}
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", mime.FormatMediaType(contentType, map[string]string{"charset": "utf-8"}))
+	header.Set("Content-Transfer-Encoding", "quoted-printable")
+	return part{header: header, body: body.Bytes()}, nil
+}
+
+func attachmentPart(attachment Attachment, disposition string) part {
+	header := textproto.MIMEHeader{}
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Transfer-Encoding", "base64")
+	header.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Filename}))
+	if attachment.ContentID != "" {
+		header.Set("Content-ID", "<"+attachment.ContentID+">")
+	}
+
+	// base64 lines are limited to 76 characters
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+	var body bytes.Buffer
+	for len(encoded) > 76 {
+		body.WriteString(encoded[:76])
+		body.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	body.WriteString(encoded)
+	body.WriteString("\r\n")
+	return part{header: header, body: body.Bytes()}
+}
+
+func multipartPart(subtype string, parts []part) (part, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for _, p := range parts {
+		pw, err := w.CreatePart(p.header)
+		if err != nil {
+			return part{}, err
+		}
+		if _, err := pw.Write(p.body); err != nil {
+			return part{}, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return part{}, err
+	}
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", mime.FormatMediaType("multipart/"+subtype, map[string]string{"boundary": w.Boundary()}))
+	return part{header: header, body: body.Bytes()}, nil
+}
+
+func messageID(from *mail.Address) string {
+	domain := "localhost"
+	if i := strings.LastIndex(from.Address, "@"); i >= 0 {
+		domain = from.Address[i+1:]
+	}
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(id), domain)
+}
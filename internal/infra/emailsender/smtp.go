@@ -0,0 +1,231 @@
+package emailsender
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+const (
+	// SMTPSecurityStartTLS upgrades the connection with STARTTLS and refuses servers not offering it.
+	SMTPSecurityStartTLS = "starttls"
+	// SMTPSecurityTLS connects with TLS from the start, usually on port 465.
+	SMTPSecurityTLS = "tls"
+	// SMTPSecurityNone sends in clear text, only meant for a local capture server like MailHog.
+	SMTPSecurityNone = "none"
+)
+
+var ErrStartTLSNotSupported = errors.New("smtp server does not support STARTTLS")
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // no authentication when empty
+	Password string
+	Security string
+	// LocalName is sent with EHLO, the server sees localhost when empty.
+	LocalName string
+	// PoolSize is the number of idle connections kept open for the next emails.
+	PoolSize    int
+	IdleTimeout time.Duration
+	// Timeout bounds the delivery of one email, dialing included.
+	Timeout time.Duration
+	// TLSConfig is optional, e.g. to trust a private CA, its ServerName defaults to Host.
+	TLSConfig *tls.Config
+}
+
+type smtpConn struct {
+	conn   net.Conn
+	client *smtp.Client
+	usedAt time.Time
+}
+
+type smtpEmailService struct {
+	cfg  SMTPConfig
+	idle chan *smtpConn
+}
+
+func NewSMTPEmailService(cfg SMTPConfig) EmailService {
+	if cfg.Security == "" {
+		cfg.Security = SMTPSecurityStartTLS
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	if cfg.PoolSize < 0 {
+		cfg.PoolSize = 0
+	}
+	return &smtpEmailService{cfg: cfg, idle: make(chan *smtpConn, cfg.PoolSize)}
+}
+
+func (s *smtpEmailService) SendEmail(ctx context.Context, email *Email) error {
+	from, err := mail.ParseAddress(email.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender %q: %w", email.From, err)
+	}
+	to, err := mail.ParseAddress(email.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient %q: %w", email.To, err)
+	}
+	message, err := buildMessage(email, from, to, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+	c, err := s.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// a canceled context interrupts the pending read or write
+	stop := context.AfterFunc(ctx, func() {
+		_ = c.conn.SetDeadline(time.Now())
+	})
+	deadline, _ := ctx.Deadline()
+	_ = c.conn.SetDeadline(deadline)
+
+	err = send(c.client, from.Address, to.Address, message)
+	if !stop() {
+		// the deadline was moved to now, the connection can not be reused
+		c.close()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ctx.Err(), err)
+		}
+		return nil
+	}
+	if err != nil {
+		c.close()
+		return err
+	}
+	s.release(c)
+	return nil
+}
+
+func send(client *smtp.Client, from string, to string, message []byte) error {
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// acquire returns an idle connection still answering a RSET, or dials a new one.
+func (s *smtpEmailService) acquire(ctx context.Context) (*smtpConn, error) {
+	for {
+		select {
+		case c := <-s.idle:
+			if s.cfg.IdleTimeout > 0 && time.Since(c.usedAt) > s.cfg.IdleTimeout {
+				c.quit()
+				continue
+			}
+			deadline, _ := ctx.Deadline()
+			_ = c.conn.SetDeadline(deadline)
+			if err := c.client.Reset(); err != nil {
+				c.close()
+				continue
+			}
+			return c, nil
+		default:
+			return s.dial(ctx)
+		}
+	}
+}
+
+func (s *smtpEmailService) release(c *smtpConn) {
+	c.usedAt = time.Now()
+	_ = c.conn.SetDeadline(time.Time{})
+	select {
+	case s.idle <- c:
+	default:
+		c.quit()
+	}
+}
+
+func (s *smtpEmailService) dial(ctx context.Context) (*smtpConn, error) {
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+	tlsConfig := &tls.Config{ServerName: s.cfg.Host}
+	if s.cfg.TLSConfig != nil {
+		tlsConfig = s.cfg.TLSConfig.Clone()
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = s.cfg.Host
+		}
+	}
+
+	var conn net.Conn
+	var err error
+	switch s.cfg.Security {
+	case SMTPSecurityTLS:
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	case SMTPSecurityStartTLS, SMTPSecurityNone:
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	default:
+		return nil, fmt.Errorf("unsupported smtp security %q", s.cfg.Security)
+	}
+	if err != nil {
+		return nil, err
+	}
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	c := &smtpConn{conn: conn}
+	if err := s.handshake(c, tlsConfig); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *smtpEmailService) handshake(c *smtpConn, tlsConfig *tls.Config) error {
+	client, err := smtp.NewClient(c.conn, s.cfg.Host)
+	if err != nil {
+		return err
+	}
+	c.client = client
+	if s.cfg.LocalName != "" {
+		if err := client.Hello(s.cfg.LocalName); err != nil {
+			return err
+		}
+	}
+	if s.cfg.Security == SMTPSecurityStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return ErrStartTLSNotSupported
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+	if s.cfg.Username != "" {
+		// PlainAuth refuses to send the password without TLS unless the server is on localhost
+		if err := client.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *smtpConn) quit() {
+	_ = c.conn.SetDeadline(time.Now().Add(time.Second))
+	if err := c.client.Quit(); err != nil {
+		_ = c.conn.Close()
+	}
+}
+
+func (c *smtpConn) close() {
+	_ = c.conn.Close()
+}
@@ -0,0 +1,249 @@
+package emailsender
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"booking-event/internal/infra/emailsender/smtptest"
+)
+
+func smtpConfig(server *smtptest.Server, security string) SMTPConfig {
+	return SMTPConfig{
+		Host:      server.Host,
+		Port:      server.Port,
+		Username:  "user",
+		Password:  "secret",
+		Security:  security,
+		PoolSize:  1,
+		Timeout:   5 * time.Second,
+		TLSConfig: &tls.Config{RootCAs: server.RootCAs()},
+	}
+}
+
+func TestSMTPEmailService_SendEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		options       smtptest.Options
+		config        func(server *smtptest.Server) SMTPConfig
+		expectedError bool
+	}{
+		{
+			name:    "STARTTLS with authentication",
+			options: smtptest.Options{Username: "user", Password: "secret"},
+			config: func(server *smtptest.Server) SMTPConfig {
+				return smtpConfig(server, SMTPSecurityStartTLS)
+			},
+		},
+		{
+			name:    "Implicit TLS",
+			options: smtptest.Options{ImplicitTLS: true, Username: "user", Password: "secret"},
+			config: func(server *smtptest.Server) SMTPConfig {
+				return smtpConfig(server, SMTPSecurityTLS)
+			},
+		},
+		{
+			name:    "Clear text capture server",
+			options: smtptest.Options{NoStartTLS: true},
+			config: func(server *smtptest.Server) SMTPConfig {
+				cfg := smtpConfig(server, SMTPSecurityNone)
+				cfg.Username = ""
+				return cfg
+			},
+		},
+		{
+			name:    "Server without STARTTLS",
+			options: smtptest.Options{NoStartTLS: true},
+			config: func(server *smtptest.Server) SMTPConfig {
+				return smtpConfig(server, SMTPSecurityStartTLS)
+			},
+			expectedError: true,
+		},
+		{
+			name:    "Wrong password",
+			options: smtptest.Options{Username: "user", Password: "other"},
+			config: func(server *smtptest.Server) SMTPConfig {
+				return smtpConfig(server, SMTPSecurityStartTLS)
+			},
+			expectedError: true,
+		},
+		{
+			name:    "Untrusted certificate",
+			options: smtptest.Options{Username: "user", Password: "secret"},
+			config: func(server *smtptest.Server) SMTPConfig {
+				cfg := smtpConfig(server, SMTPSecurityStartTLS)
+				cfg.TLSConfig = nil
+				return cfg
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server := smtptest.NewServer(tt.options)
+			defer server.Close()
+
+			service := NewSMTPEmailService(tt.config(server))
+			err := service.SendEmail(context.Background(), &Email{
+				From:    "Booking Event <noreply@booking-event.com>",
+				To:      "user@example.com",
+				Subject: "Booking confirmed",
+				Body:    "Your booking is confirmed.",
+			})
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Empty(t, server.Messages())
+				return
+			}
+			assert.NoError(t, err)
+			messages := server.Messages()
+			assert.Len(t, messages, 1)
+			assert.Equal(t, "noreply@booking-event.com", messages[0].From)
+			assert.Equal(t, []string{"user@example.com"}, messages[0].To)
+		})
+	}
+}
+
+func TestSMTPEmailService_MIME(t *testing.T) {
+	t.Parallel()
+	server := smtptest.NewServer(smtptest.Options{Username: "user", Password: "secret"})
+	defer server.Close()
+
+	service := NewSMTPEmailService(smtpConfig(server, SMTPSecurityStartTLS))
+	err := service.SendEmail(context.Background(), &Email{
+		From:        "Booking Event <noreply@booking-event.com>",
+		To:          "user@example.com",
+		Subject:     "Đặt vé thành công",
+		Body:        "Your booking is confirmed.\nSee you soon.",
+		HTMLBody:    `<p>Your booking is confirmed.</p><img src="cid:ticket-1@booking-event">`,
+		Inline:      []Attachment{{ContentID: "ticket-1@booking-event", Filename: "ticket-1.png", ContentType: "image/png", Data: []byte("png")}},
+		Attachments: []Attachment{{Filename: "event.ics", ContentType: "text/calendar", Data: bytes.Repeat([]byte("ics"), 100)}},
+	})
+	assert.NoError(t, err)
+	messages := server.Messages()
+	assert.Len(t, messages, 1)
+
+	message, err := mail.ReadMessage(bytes.NewReader(messages[0].Data))
+	assert.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Đặt vé thành công", subject)
+	assert.Equal(t, `"Booking Event" <noreply@booking-event.com>`, message.Header.Get("From"))
+	assert.Contains(t, message.Header.Get("Message-ID"), "@booking-event.com>")
+
+	mixed := readParts(t, message.Header.Get("Content-Type"), message.Body)
+	assert.Len(t, mixed, 2)
+	assert.Equal(t, "text/calendar", mixed[1].Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename=event.ics`, mixed[1].Header.Get("Content-Disposition"))
+	assert.Equal(t, bytes.Repeat([]byte("ics"), 100), mixed[1].body)
+
+	alternative := readParts(t, mixed[0].Header.Get("Content-Type"), bytes.NewReader(mixed[0].body))
+	assert.Len(t, alternative, 2)
+	// the capture server stores the lines with LF endings
+	assert.Equal(t, "Your booking is confirmed.\nSee you soon.", string(alternative[0].body))
+
+	related := readParts(t, alternative[1].Header.Get("Content-Type"), bytes.NewReader(alternative[1].body))
+	assert.Len(t, related, 2)
+	assert.Equal(t, `<p>Your booking is confirmed.</p><img src="cid:ticket-1@booking-event">`, string(related[0].body))
+	assert.Equal(t, "<ticket-1@booking-event>", related[1].Header.Get("Content-ID"))
+	assert.Equal(t, []byte("png"), related[1].body)
+}
+
+func TestSMTPEmailService_Pool(t *testing.T) {
+	t.Parallel()
+	server := smtptest.NewServer(smtptest.Options{Username: "user", Password: "secret"})
+	defer server.Close()
+
+	service := NewSMTPEmailService(smtpConfig(server, SMTPSecurityStartTLS))
+	for i := 0; i < 3; i++ {
+		err := service.SendEmail(context.Background(), &Email{From: "noreply@booking-event.com", To: "user" + strconv.Itoa(i) + "@example.com", Subject: "Hello", Body: "Hello"})
+		assert.NoError(t, err)
+	}
+	assert.Len(t, server.Messages(), 3)
+	assert.Equal(t, 1, server.Connections())
+
+	cfg := smtpConfig(server, SMTPSecurityStartTLS)
+	cfg.PoolSize = 0
+	service = NewSMTPEmailService(cfg)
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, service.SendEmail(context.Background(), &Email{From: "noreply@booking-event.com", To: "user@example.com", Subject: "Hello", Body: "Hello"}))
+	}
+	assert.Equal(t, 3, server.Connections())
+}
+
+func TestSMTPEmailService_Timeout(t *testing.T) {
+	t.Parallel()
+	// accepts the connections and never greets the client
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		var conns []net.Conn
+		defer func() {
+			for _, conn := range conns {
+				conn.Close()
+			}
+		}()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conns = append(conns, conn)
+		}
+	}()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	service := NewSMTPEmailService(SMTPConfig{Host: "127.0.0.1", Port: port, Security: SMTPSecurityNone, Timeout: 100 * time.Millisecond})
+	start := time.Now()
+	err = service.SendEmail(context.Background(), &Email{From: "noreply@booking-event.com", To: "user@example.com", Subject: "Hello", Body: "Hello"})
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+
+	err = service.SendEmail(context.Background(), &Email{From: "not an address", To: "user@example.com"})
+	assert.Error(t, err)
+}
+
+type mimePart struct {
+	Header mail.Header
+	body   []byte
+}
+
+// readParts decodes the parts of a multipart body, multipart.Reader already decodes the quoted-printable ones.
+func readParts(t *testing.T, contentType string, body io.Reader) []mimePart {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	assert.NoError(t, err)
+	assert.Contains(t, mediaType, "multipart/")
+	var parts []mimePart
+	reader := multipart.NewReader(body, params["boundary"])
+	for {
+		p, err := reader.NextPart()
+		if err == io.EOF {
+			return parts
+		}
+		assert.NoError(t, err)
+		content, err := io.ReadAll(p)
+		assert.NoError(t, err)
+		if p.Header.Get("Content-Transfer-Encoding") == "base64" {
+			content, err = base64.StdEncoding.DecodeString(strings.ReplaceAll(string(content), "\r\n", ""))
+			assert.NoError(t, err)
+		}
+		parts = append(parts, mimePart{Header: mail.Header(p.Header), body: content})
+	}
+}
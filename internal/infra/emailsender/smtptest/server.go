@@ -0,0 +1,286 @@
+// Package smtptest runs a local SMTP server capturing the emails, like MailHog, for the tests of the SMTP sender.
+package smtptest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Options struct {
+	// ImplicitTLS expects TLS from the start of the connection instead of offering STARTTLS.
+	ImplicitTLS bool
+	// NoStartTLS does not offer STARTTLS, like a server only meant for local captures.
+	NoStartTLS bool
+	// Username requires AUTH PLAIN with these credentials before MAIL when set.
+	Username string
+	Password string
+}
+
+// Message is an email accepted by the server, Data is the message as sent after DATA with LF line endings.
+type Message struct {
+	From string
+	To   []string
+	Data []byte
+}
+
+// Server accepts every email once the client followed the options, its certificate is only trusted through
+// RootCAs.
+type Server struct {
+	Host string
+	Port int
+
+	opts      Options
+	listener  net.Listener
+	tlsConfig *tls.Config
+	rootCAs   *x509.CertPool
+
+	mu          sync.Mutex
+	messages    []Message
+	connections int
+	open        map[net.Conn]struct{}
+	wg          sync.WaitGroup
+}
+
+func NewServer(opts Options) *Server {
+	certificate, rootCAs := selfSignedCertificate()
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{certificate}}
+
+	var listener net.Listener
+	var err error
+	if opts.ImplicitTLS {
+		listener, err = tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+	} else {
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		panic(err)
+	}
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+
+	s := &Server{
+		Host:      host,
+		Port:      portNumber,
+		opts:      opts,
+		listener:  listener,
+		tlsConfig: tlsConfig,
+		rootCAs:   rootCAs,
+		open:      make(map[net.Conn]struct{}),
+	}
+	s.wg.Add(1)
+	go s.accept()
+	return s
+}
+
+// RootCAs trusts the certificate of the server.
+func (s *Server) RootCAs() *x509.CertPool {
+	return s.rootCAs
+}
+
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// Connections returns the number of connections accepted so far.
+func (s *Server) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.connections
+}
+
+// Close stops the server and closes the connections left open by the clients.
+func (s *Server) Close() {
+	_ = s.listener.Close()
+	s.mu.Lock()
+	for conn := range s.open {
+		_ = conn.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+func (s *Server) accept() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.connections++
+		s.open[conn] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.serve(conn)
+			s.mu.Lock()
+			delete(s.open, conn)
+			s.mu.Unlock()
+			_ = conn.Close()
+		}()
+	}
+}
+
+type session struct {
+	tls           bool
+	authenticated bool
+	from          string
+	to            []string
+}
+
+func (s *Server) serve(conn net.Conn) {
+	text := textproto.NewConn(conn)
+	state := session{tls: s.opts.ImplicitTLS, authenticated: s.opts.Username == ""}
+	_ = text.PrintfLine("220 localhost ESMTP smtptest")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			extensions := []string{"localhost", "8BITMIME"}
+			if !state.tls && !s.opts.NoStartTLS {
+				extensions = append(extensions, "STARTTLS")
+			}
+			if s.opts.Username != "" {
+				extensions = append(extensions, "AUTH PLAIN")
+			}
+			for i, extension := range extensions {
+				separator := "-"
+				if i == len(extensions)-1 {
+					separator = " "
+				}
+				_ = text.PrintfLine("250%s%s", separator, extension)
+			}
+		case "STARTTLS":
+			if state.tls || s.opts.NoStartTLS {
+				_ = text.PrintfLine("502 5.5.1 STARTTLS not available")
+				continue
+			}
+			_ = text.PrintfLine("220 2.0.0 Ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			text = textproto.NewConn(tlsConn)
+			state = session{tls: true, authenticated: s.opts.Username == ""}
+		case "AUTH":
+			mechanism, response, _ := strings.Cut(arg, " ")
+			if !strings.EqualFold(mechanism, "PLAIN") {
+				_ = text.PrintfLine("504 5.5.4 Unrecognized authentication type")
+				continue
+			}
+			if response == "" {
+				_ = text.PrintfLine("334 ")
+				if response, err = text.ReadLine(); err != nil {
+					return
+				}
+			}
+			decoded, _ := base64.StdEncoding.DecodeString(response)
+			credentials := strings.Split(string(decoded), "\x00")
+			if len(credentials) != 3 || credentials[1] != s.opts.Username || credentials[2] != s.opts.Password {
+				_ = text.PrintfLine("535 5.7.8 Authentication credentials invalid")
+				continue
+			}
+			state.authenticated = true
+			_ = text.PrintfLine("235 2.7.0 Authentication successful")
+		case "MAIL":
+			if !state.authenticated {
+				_ = text.PrintfLine("530 5.7.0 Authentication required")
+				continue
+			}
+			state.from = address(arg)
+			_ = text.PrintfLine("250 2.1.0 Ok")
+		case "RCPT":
+			if state.from == "" {
+				_ = text.PrintfLine("503 5.5.1 Need MAIL first")
+				continue
+			}
+			state.to = append(state.to, address(arg))
+			_ = text.PrintfLine("250 2.1.5 Ok")
+		case "DATA":
+			if len(state.to) == 0 {
+				_ = text.PrintfLine("503 5.5.1 Need RCPT first")
+				continue
+			}
+			_ = text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, Message{From: state.from, To: state.to, Data: data})
+			s.mu.Unlock()
+			state.from, state.to = "", nil
+			_ = text.PrintfLine("250 2.0.0 Ok: queued")
+		case "RSET":
+			state.from, state.to = "", nil
+			_ = text.PrintfLine("250 2.0.0 Ok")
+		case "NOOP":
+			_ = text.PrintfLine("250 2.0.0 Ok")
+		case "QUIT":
+			_ = text.PrintfLine("221 2.0.0 Bye")
+			return
+		default:
+			_ = text.PrintfLine("502 5.5.2 Command not recognized")
+		}
+	}
+}
+
+// address returns the address of a FROM:<address> or TO:<address> argument.
+func address(arg string) string {
+	start := strings.Index(arg, "<")
+	end := strings.Index(arg, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return arg[start+1 : end]
+}
+
+func selfSignedCertificate() (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "smtptest"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		panic(err)
+	}
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(certificate)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, rootCAs
+}
@@ -0,0 +1,244 @@
+// Package emailtemplate renders the emails from templates embedded in the binary, the files of an optional
+// directory override the embedded ones of the same name.
+//
+// A template is a pair of files per locale: <name>.<locale>.txt defines the "subject" and the "body" of the plain
+// text part and <name>.<locale>.html, optional, defines the "content" of the HTML part, rendered inside layout.html.
+// Both are executed with a View, the data given by the caller being in View.Data.
+package emailtemplate
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/Rhymond/go-money"
+)
+
+const (
+	TemplateBookingConfirmation = "booking_confirmation"
+	TemplateBookingReminder     = "booking_reminder"
+	TemplateBookingCancellation = "booking_cancellation"
+	TemplateEventUpdate         = "event_update"
+	TemplateEventCancellation   = "event_cancellation"
+	TemplateEventReview         = "event_review"
+	TemplateBookingRefund       = "booking_refund"
+	TemplateWaitlistOffer       = "waitlist_offer"
+	TemplatePasswordReset       = "password_reset"
+	TemplateAlreadyRegistered   = "already_registered"
+)
+
+const layoutFile = "layout.html"
+
+var ErrTemplateNotFound = errors.New("email template not found")
+
+//go:embed templates
+var embedded embed.FS
+
+type Branding struct {
+	ProductName  string
+	WebsiteURL   string
+	LogoURL      string
+	SupportEmail string
+	PrimaryColor string
+}
+
+type Config struct {
+	// Dir is optional, its files replace the embedded templates of the same name.
+	Dir string
+	// DefaultLocale is used when the template has no variant for the locale of the recipient.
+	DefaultLocale string
+	Branding      Branding
+}
+
+type Message struct {
+	Subject string
+	Text    string
+	HTML    string // empty when the template has no HTML variant
+}
+
+// View is the value the templates are executed with.
+type View struct {
+	Brand   Branding
+	Locale  string
+	Subject string // only set for the HTML part
+	Data    any
+
+	location *time.Location
+}
+
+// Time formats t in the time zone of the recipient.
+func (v View) Time(t time.Time, layout string) string {
+	return t.In(v.location).Format(layout)
+}
+
+// Money formats an amount in the smallest unit of the currency, like the prices of the events.
+func (v View) Money(amount int64, currency string) string {
+	return money.New(amount, currency).Display()
+}
+
+// ContentID returns the URL of an inline attachment, html/template would otherwise replace the cid scheme.
+func (v View) ContentID(id string) htmltemplate.URL {
+	return htmltemplate.URL("cid:" + id)
+}
+
+type Renderer struct {
+	cfg  Config
+	text map[string]*texttemplate.Template // keyed by <name>.<locale>
+	html map[string]*htmltemplate.Template
+}
+
+func NewRenderer(cfg Config) (*Renderer, error) {
+	if cfg.DefaultLocale == "" {
+		cfg.DefaultLocale = "en"
+	}
+	cfg.DefaultLocale = normalizeLocale(cfg.DefaultLocale)
+
+	files, err := loadFiles(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+	layout, ok := files[layoutFile]
+	if !ok {
+		return nil, fmt.Errorf("missing email template %s", layoutFile)
+	}
+	layoutTemplate, err := htmltemplate.New(layoutFile).Parse(layout)
+	if err != nil {
+		return nil, fmt.Errorf("parse email template %s: %w", layoutFile, err)
+	}
+
+	r := &Renderer{
+		cfg:  cfg,
+		text: make(map[string]*texttemplate.Template),
+		html: make(map[string]*htmltemplate.Template),
+	}
+	for fileName, content := range files {
+		parts := strings.Split(fileName, ".")
+		if len(parts) != 3 {
+			continue
+		}
+		key := parts[0] + "." + normalizeLocale(parts[1])
+		switch parts[2] {
+		case "txt":
+			t, err := texttemplate.New(fileName).Parse(content)
+			if err != nil {
+				return nil, fmt.Errorf("parse email template %s: %w", fileName, err)
+			}
+			if t.Lookup("subject") == nil || t.Lookup("body") == nil {
+				return nil, fmt.Errorf("email template %s must define subject and body", fileName)
+			}
+			r.text[key] = t
+		case "html":
+			t, err := layoutTemplate.Clone()
+			if err != nil {
+				return nil, err
+			}
+			if _, err := t.New(fileName).Parse(content); err != nil {
+				return nil, fmt.Errorf("parse email template %s: %w", fileName, err)
+			}
+			if t.Lookup("content") == nil {
+				return nil, fmt.Errorf("email template %s must define content", fileName)
+			}
+			r.html[key] = t
+		}
+	}
+	return r, nil
+}
+
+// Render renders the template for the locale and the time zone of the recipient. The locale falls back to its
+// language, e.g. vi for vi-VN, then to the default locale.
+func (r *Renderer) Render(name string, locale string, timezone string, data any) (*Message, error) {
+	key, ok := r.resolve(name, locale)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" {
+		location = time.UTC
+	}
+	view := View{Brand: r.cfg.Branding, Locale: key[len(name)+1:], Data: data, location: location}
+
+	var subject, text bytes.Buffer
+	if err := r.text[key].ExecuteTemplate(&subject, "subject", view); err != nil {
+		return nil, err
+	}
+	if err := r.text[key].ExecuteTemplate(&text, "body", view); err != nil {
+		return nil, err
+	}
+	message := &Message{
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+	}
+
+	if t, ok := r.html[key]; ok {
+		view.Subject = message.Subject
+		var html bytes.Buffer
+		if err := t.ExecuteTemplate(&html, layoutFile, view); err != nil {
+			return nil, err
+		}
+		message.HTML = html.String()
+	}
+	return message, nil
+}
+
+func (r *Renderer) resolve(name string, locale string) (string, bool) {
+	locale = normalizeLocale(locale)
+	candidates := []string{locale}
+	if language, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, language)
+	}
+	candidates = append(candidates, r.cfg.DefaultLocale)
+	for _, candidate := range candidates {
+		if _, ok := r.text[name+"."+candidate]; ok {
+			return name + "." + candidate, true
+		}
+	}
+	return "", false
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// loadFiles returns the content of the embedded templates overridden by the files of dir.
+func loadFiles(dir string) (map[string]string, error) {
+	files := make(map[string]string)
+	entries, err := fs.ReadDir(embedded, "templates")
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		content, err := fs.ReadFile(embedded, "templates/"+entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		files[entry.Name()] = string(content)
+	}
+
+	if dir == "" {
+		return files, nil
+	}
+	entries, err = os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read email templates dir: %w", err)
+	}
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if !entry.Type().IsRegular() || (ext != ".txt" && ext != ".html") {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files[entry.Name()] = string(content)
+	}
+	return files, nil
+}
@@ -0,0 +1,145 @@
+package emailtemplate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testEvent struct {
+	Name     string
+	StartAt  time.Time
+	Location string
+}
+
+type testBooking struct {
+	ID       int
+	Quantity int
+}
+
+type testTicket struct {
+	Token     string
+	ContentID string
+}
+
+var testBranding = Branding{ProductName: "Booking Event", SupportEmail: "support@booking-event.com", PrimaryColor: "#1a73e8"}
+
+func confirmationData() any {
+	return struct {
+		Event     testEvent
+		Booking   testBooking
+		Tickets   []testTicket
+		UnitPrice int64
+		Total     int64
+		Currency  string
+	}{
+		Event:     testEvent{Name: "Go Conf <2026>", StartAt: time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC), Location: "Hall A"},
+		Booking:   testBooking{ID: 7, Quantity: 2},
+		Tickets:   []testTicket{{Token: "token-1", ContentID: "ticket-1@booking-event"}, {Token: "token-2", ContentID: "ticket-2@booking-event"}},
+		UnitPrice: 1500,
+		Total:     3000,
+		Currency:  "USD",
+	}
+}
+
+func TestRenderer_Render(t *testing.T) {
+	t.Parallel()
+	renderer, err := NewRenderer(Config{DefaultLocale: "en", Branding: testBranding})
+	assert.NoError(t, err)
+
+	message, err := renderer.Render(TemplateBookingConfirmation, "en-US", "Asia/Ho_Chi_Minh", confirmationData())
+	assert.NoError(t, err)
+	assert.Equal(t, "Booking confirmed: Go Conf <2026>", message.Subject)
+	assert.Contains(t, message.Text, "Your booking #7 for Go Conf <2026> is confirmed.")
+	assert.Contains(t, message.Text, "Thursday, October 1, 2026 at 17:00 +07")
+	assert.Contains(t, message.Text, "Ticket token-2  $15.00")
+	assert.Contains(t, message.Text, "Total: $30.00")
+	assert.Contains(t, message.HTML, "<title>Booking confirmed: Go Conf &lt;2026&gt;</title>")
+	assert.Contains(t, message.HTML, `<img src="cid:ticket-1@booking-event"`)
+	assert.Contains(t, message.HTML, "border-bottom:4px solid #1a73e8")
+	assert.Contains(t, message.HTML, "mailto:support@booking-event.com")
+	assert.NotContains(t, message.HTML, "ZgotmplZ")
+
+	message, err = renderer.Render(TemplateBookingConfirmation, "vi_VN", "", confirmationData())
+	assert.NoError(t, err)
+	assert.Equal(t, "Đặt vé thành công: Go Conf <2026>", message.Subject)
+	assert.Contains(t, message.Text, "Thời gian: 10:00 UTC, 01/10/2026")
+	assert.Contains(t, message.HTML, `<html lang="vi">`)
+
+	// no variant for the locale, falls back to the default one
+	message, err = renderer.Render(TemplateBookingConfirmation, "fr", "Not/AZone", confirmationData())
+	assert.NoError(t, err)
+	assert.Equal(t, "Booking confirmed: Go Conf <2026>", message.Subject)
+	assert.Contains(t, message.Text, "at 10:00 UTC")
+
+	_, err = renderer.Render("unknown", "en", "UTC", nil)
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func TestRenderer_AllTemplates(t *testing.T) {
+	t.Parallel()
+	renderer, err := NewRenderer(Config{Branding: testBranding})
+	assert.NoError(t, err)
+
+	event := testEvent{Name: "Go Conf", StartAt: time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC), Location: "Hall A"}
+	booking := testBooking{ID: 7, Quantity: 2}
+	expiresAt := time.Date(2026, 9, 30, 10, 0, 0, 0, time.UTC)
+	data := map[string]any{
+		TemplateBookingConfirmation: confirmationData(),
+		TemplateBookingReminder:     map[string]any{"Event": event, "Booking": booking},
+		TemplateBookingCancellation: map[string]any{"Event": event, "Booking": booking},
+		TemplateEventUpdate:         map[string]any{"Event": event, "Booking": booking},
+		TemplateEventCancellation:   map[string]any{"Event": event, "Booking": booking},
+		TemplateEventReview:         map[string]any{"Event": event, "Review": map[string]any{"FromStatus": "pending_review", "ToStatus": "rejected", "Reason": "missing venue"}},
+		TemplateBookingRefund:       map[string]any{"Event": event, "Booking": booking, "Amount": int64(3000), "Currency": "USD"},
+		TemplateWaitlistOffer:       map[string]any{"Event": event, "Quantity": 2, "Link": "http://localhost:3000/events/1", "ExpiresAt": expiresAt},
+		TemplatePasswordReset:       map[string]any{"Link": "http://localhost:3000/reset-password?token=abc", "ExpiresAt": expiresAt},
+		TemplateAlreadyRegistered:   map[string]any{"Email": "user@example.com"},
+	}
+	for name, d := range data {
+		for _, locale := range []string{"en", "vi"} {
+			message, err := renderer.Render(name, locale, "UTC", d)
+			assert.NoError(t, err, name+"."+locale)
+			assert.NotEmpty(t, message.Subject, name+"."+locale)
+			assert.NotContains(t, message.Text, "<no value>", name+"."+locale)
+			assert.NotEmpty(t, message.HTML, name+"."+locale)
+			assert.NotContains(t, message.HTML, "ZgotmplZ", name+"."+locale)
+		}
+	}
+}
+
+func TestRenderer_Overrides(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "password_reset.en.txt"),
+		[]byte(`{{define "subject"}}Password help from {{.Brand.ProductName}}{{end}}{{define "body"}}{{.Data.Link}}{{end}}`), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "password_reset.fr.txt"),
+		[]byte(`{{define "subject"}}Réinitialiser votre mot de passe{{end}}{{define "body"}}{{.Data.Link}}{{end}}`), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("ignored"), 0o644))
+
+	renderer, err := NewRenderer(Config{Dir: dir, Branding: testBranding})
+	assert.NoError(t, err)
+	data := map[string]any{"Link": "http://localhost:3000/reset-password?token=abc", "ExpiresAt": time.Now()}
+
+	message, err := renderer.Render(TemplatePasswordReset, "en", "UTC", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Password help from Booking Event", message.Subject)
+	assert.Equal(t, "http://localhost:3000/reset-password?token=abc\n", message.Text)
+	// the embedded HTML variant is kept
+	assert.Contains(t, message.HTML, "Choose a new password")
+
+	message, err = renderer.Render(TemplatePasswordReset, "fr-CA", "UTC", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Réinitialiser votre mot de passe", message.Subject)
+	assert.Empty(t, message.HTML)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "password_reset.de.txt"), []byte(`{{define "subject"}}Passwort{{end}}`), 0o644))
+	_, err = NewRenderer(Config{Dir: dir})
+	assert.Error(t, err)
+
+	_, err = NewRenderer(Config{Dir: filepath.Join(dir, "missing")})
+	assert.Error(t, err)
+}
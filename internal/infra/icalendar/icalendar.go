@@ -0,0 +1,141 @@
+// Package icalendar writes the iCalendar objects of RFC 5545 imported by the calendar apps, limited to the VEVENT
+// properties the bookings need.
+package icalendar
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MethodRequest is an invitation, a new one with the same UID and a higher SEQUENCE updates the entry.
+	MethodRequest = "REQUEST"
+	// MethodCancel removes the entry of the UID from the calendar.
+	MethodCancel = "CANCEL"
+
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// maxLineLength is the length in octets after which the lines are folded.
+const maxLineLength = 75
+
+type Event struct {
+	UID         string
+	Sequence    int
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Location    string
+	Description string
+	URL         string
+	Status      string
+	Latitude    *float64
+	Longitude   *float64
+	// Organizer and Attendee are email addresses, the apps need both to show an invitation.
+	Organizer string
+	Attendee  string
+}
+
+type Calendar struct {
+	ProdID string
+	// Method is left empty for a subscribed feed.
+	Method string
+	// Name and RefreshInterval are only used by the apps subscribed to a feed.
+	Name            string
+	RefreshInterval time.Duration
+	Events          []Event
+}
+
+// Encode writes the calendar, stamp is the DTSTAMP of its events.
+func (c Calendar) Encode(stamp time.Time) []byte {
+	w := &writer{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + c.ProdID)
+	w.line("CALSCALE:GREGORIAN")
+	if c.Method != "" {
+		w.line("METHOD:" + c.Method)
+	}
+	if c.Name != "" {
+		w.line("X-WR-CALNAME:" + escape(c.Name))
+		w.line("NAME:" + escape(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		duration := fmt.Sprintf("PT%dM", int(c.RefreshInterval.Minutes()))
+		w.line("REFRESH-INTERVAL;VALUE=DURATION:" + duration)
+		w.line("X-PUBLISHED-TTL:" + duration)
+	}
+	for _, event := range c.Events {
+		w.line("BEGIN:VEVENT")
+		w.line("UID:" + event.UID)
+		w.line("SEQUENCE:" + fmt.Sprint(event.Sequence))
+		w.line("DTSTAMP:" + formatTime(stamp))
+		w.line("DTSTART:" + formatTime(event.Start))
+		if !event.End.IsZero() {
+			w.line("DTEND:" + formatTime(event.End))
+		}
+		w.line("SUMMARY:" + escape(event.Summary))
+		if event.Location != "" {
+			w.line("LOCATION:" + escape(event.Location))
+		}
+		if event.Latitude != nil && event.Longitude != nil {
+			w.line(fmt.Sprintf("GEO:%f;%f", *event.Latitude, *event.Longitude))
+		}
+		if event.Description != "" {
+			w.line("DESCRIPTION:" + escape(event.Description))
+		}
+		if event.URL != "" {
+			w.line("URL:" + event.URL)
+		}
+		if event.Status != "" {
+			w.line("STATUS:" + event.Status)
+		}
+		if event.Organizer != "" {
+			w.line("ORGANIZER:mailto:" + event.Organizer)
+		}
+		if event.Attendee != "" {
+			w.line("ATTENDEE;ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED;RSVP=FALSE:mailto:" + event.Attendee)
+		}
+		w.line("END:VEVENT")
+	}
+	w.line("END:VCALENDAR")
+	return w.buf.Bytes()
+}
+
+type writer struct {
+	buf bytes.Buffer
+}
+
+// line writes a content line, folded in lines of at most 75 octets without splitting a character.
+func (w *writer) line(s string) {
+	limit := maxLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.buf.WriteString(s[:cut])
+		w.buf.WriteString("\r\n ")
+		s = s[cut:]
+		// the leading space of the continuation lines counts in their length
+		limit = maxLineLength - 1
+	}
+	w.buf.WriteString(s)
+	w.buf.WriteString("\r\n")
+}
+
+// textEscaper turns every line break into the \n escape, a bare CR included: left raw it would end the content line
+// and let the text after it be read as a property of its own.
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
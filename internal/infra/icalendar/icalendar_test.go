@@ -0,0 +1,90 @@
+package icalendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendar_Encode(t *testing.T) {
+	t.Parallel()
+	stamp := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 10, 20, 19, 30, 0, 0, time.FixedZone("ICT", 7*3600))
+	latitude, longitude := 10.7769, 106.7009
+
+	calendar := Calendar{
+		ProdID: "-//Booking Event//Bookings//EN",
+		Method: MethodRequest,
+		Events: []Event{{
+			UID:         "booking-7@booking-event",
+			Sequence:    2,
+			Start:       start,
+			End:         start.Add(2 * time.Hour),
+			Summary:     "Go Conf; Saigon, 2026",
+			Location:    "Hall A\nDistrict 1",
+			Description: "Booking #7, 2 ticket(s). " + strings.Repeat("Nhà hát ", 10),
+			Status:      StatusConfirmed,
+			Latitude:    &latitude,
+			Longitude:   &longitude,
+			Organizer:   "noreply@booking-event.com",
+			Attendee:    "user@example.com",
+		}},
+	}
+	encoded := string(calendar.Encode(stamp))
+
+	assert.True(t, strings.HasPrefix(encoded, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//Booking Event//Bookings//EN\r\n"))
+	assert.True(t, strings.HasSuffix(encoded, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	assert.Contains(t, encoded, "\r\nMETHOD:REQUEST\r\n")
+	assert.Contains(t, encoded, "\r\nUID:booking-7@booking-event\r\nSEQUENCE:2\r\nDTSTAMP:20261001T100000Z\r\n")
+	assert.Contains(t, encoded, "\r\nDTSTART:20261020T123000Z\r\nDTEND:20261020T143000Z\r\n")
+	assert.Contains(t, encoded, "\r\nSUMMARY:Go Conf\\; Saigon\\, 2026\r\n")
+	assert.Contains(t, encoded, "\r\nLOCATION:Hall A\\nDistrict 1\r\n")
+	assert.Contains(t, encoded, "\r\nGEO:10.776900;106.700900\r\n")
+	assert.Contains(t, encoded, "\r\nSTATUS:CONFIRMED\r\nORGANIZER:mailto:noreply@booking-event.com\r\n")
+	assert.NotContains(t, encoded, "X-WR-CALNAME")
+
+	for _, line := range strings.Split(strings.TrimSuffix(encoded, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, line)
+	}
+	unfolded := strings.ReplaceAll(encoded, "\r\n ", "")
+	assert.Contains(t, unfolded, "\r\nDESCRIPTION:Booking #7\\, 2 ticket(s). "+strings.Repeat("Nhà hát ", 10)+"\r\n")
+	assert.Contains(t, unfolded, "\r\nATTENDEE;ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED;RSVP=FALSE:mailto:user@example.com\r\n")
+}
+
+func TestCalendar_EncodeFeed(t *testing.T) {
+	t.Parallel()
+	stamp := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	calendar := Calendar{ProdID: "-//Booking Event//Bookings//EN", Name: "My bookings", RefreshInterval: time.Hour}
+	encoded := string(calendar.Encode(stamp))
+
+	assert.NotContains(t, encoded, "METHOD")
+	assert.NotContains(t, encoded, "BEGIN:VEVENT")
+	assert.Contains(t, encoded, "\r\nX-WR-CALNAME:My bookings\r\n")
+	assert.Contains(t, encoded, "\r\nREFRESH-INTERVAL;VALUE=DURATION:PT60M\r\n")
+}
+
+func TestEscape(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "Separators", text: `Go Conf; Saigon, 2026 \o/`, expected: `Go Conf\; Saigon\, 2026 \\o/`},
+		{name: "CRLF", text: "Hall A\r\nDistrict 1", expected: `Hall A\nDistrict 1`},
+		{name: "LF", text: "Hall A\nDistrict 1", expected: `Hall A\nDistrict 1`},
+		{name: "Bare CR", text: "Hall A\rATTENDEE:mailto:attacker@example.com", expected: `Hall A\nATTENDEE:mailto:attacker@example.com`},
+		{name: "LF CR", text: "Hall A\n\rDistrict 1", expected: `Hall A\n\nDistrict 1`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, escape(tt.text))
+			assert.NotContains(t, escape(tt.text), "\r")
+		})
+	}
+}
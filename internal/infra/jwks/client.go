@@ -0,0 +1,127 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type ClientConfig struct {
+	URL string
+	// CacheTTL is how long the fetched keys are trusted before the document is fetched again.
+	CacheTTL time.Duration
+	// MinRefreshInterval limits how often an unknown kid can trigger a fetch, tokens with made up kids must not
+	// turn into a flood of requests to the issuer.
+	MinRefreshInterval time.Duration
+	Timeout            time.Duration
+}
+
+// Client resolves the public keys published by the token issuer. The keys are cached and the document is fetched
+// again when it gets stale or when a token is signed with a key not seen yet, which happens after a rotation.
+type Client struct {
+	cfg        ClientConfig
+	httpClient *http.Client
+	nowFn      func() time.Time
+
+	mu        sync.RWMutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+
+	refreshMu   sync.Mutex
+	lastRefresh time.Time
+}
+
+func NewClient(cfg ClientConfig, nowFn func() time.Time) *Client {
+	return &Client{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		nowFn:      nowFn,
+		keys:       make(map[string]crypto.PublicKey),
+	}
+}
+
+func (c *Client) PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	fresh := c.nowFn().Sub(c.fetchedAt) < c.cfg.CacheTTL
+	c.mu.RUnlock()
+	if ok && fresh {
+		return key, nil
+	}
+
+	if err := c.refresh(ctx); err != nil {
+		// keep accepting a known key while the issuer is unreachable
+		if ok {
+			log.Println("error refreshing jwks, using the cached keys", err)
+			return key, nil
+		}
+		return nil, err
+	}
+
+	c.mu.RLock()
+	key, ok = c.keys[kid]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+func (c *Client) refresh(ctx context.Context) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	now := c.nowFn()
+	if !c.lastRefresh.IsZero() && now.Sub(c.lastRefresh) < c.cfg.MinRefreshInterval {
+		return nil
+	}
+	c.lastRefresh = now
+
+	keys, err := c.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.keys = keys
+	c.fetchedAt = now
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *Client) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.cfg.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected jwks response status %d", resp.StatusCode)
+	}
+
+	var document JSONWebKeySet
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PublicKey, len(document.Keys))
+	for _, jwk := range document.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			log.Println("skipping jwks key", jwk.KID, err)
+			continue
+		}
+		keys[jwk.KID] = key
+	}
+	return keys, nil
+}
@@ -0,0 +1,110 @@
+package jwks
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_PublicKey(t *testing.T) {
+	t.Parallel()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	var (
+		mu       sync.Mutex
+		keys     = []SigningKey{{KID: "2026-01", PrivateKey: rsaKey}}
+		requests atomic.Int32
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		mu.Lock()
+		set, err := NewKeySet(keys[len(keys)-1].KID, keys)
+		mu.Unlock()
+		assert.NoError(t, err)
+		assert.NoError(t, json.NewEncoder(w).Encode(set.JWKS()))
+	}))
+	defer server.Close()
+
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	client := NewClient(ClientConfig{
+		URL:                server.URL,
+		CacheTTL:           time.Hour,
+		MinRefreshInterval: time.Minute,
+		Timeout:            time.Second,
+	}, func() time.Time { return now })
+
+	key, err := client.PublicKey(context.Background(), "2026-01")
+	assert.NoError(t, err)
+	assert.True(t, rsaKey.PublicKey.Equal(key))
+	_, err = client.PublicKey(context.Background(), "2026-01")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load(), "known keys are served from the cache")
+
+	// an unknown kid refetches the document, at most once per MinRefreshInterval
+	now = now.Add(time.Minute)
+	_, err = client.PublicKey(context.Background(), "unknown")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	_, err = client.PublicKey(context.Background(), "unknown")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, int32(2), requests.Load())
+
+	// rotation, the new key is picked up on first use while the old one stays valid
+	mu.Lock()
+	keys = append(keys, SigningKey{KID: "2026-02", PrivateKey: edKey})
+	mu.Unlock()
+	now = now.Add(time.Minute)
+	key, err = client.PublicKey(context.Background(), "2026-02")
+	assert.NoError(t, err)
+	assert.True(t, edKey.Public().(ed25519.PublicKey).Equal(key))
+	key, err = client.PublicKey(context.Background(), "2026-01")
+	assert.NoError(t, err)
+	assert.True(t, rsaKey.PublicKey.Equal(key))
+	assert.Equal(t, int32(3), requests.Load())
+
+	// the issuer going down does not invalidate the cached keys
+	server.Close()
+	now = now.Add(2 * time.Hour)
+	_, err = client.PublicKey(context.Background(), "2026-02")
+	assert.NoError(t, err)
+}
+
+func TestKeySet_SignToken(t *testing.T) {
+	t.Parallel()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	set, err := NewKeySet("new", []SigningKey{{KID: "old", PrivateKey: rsaKey}, {KID: "new", PrivateKey: edKey}})
+	assert.NoError(t, err)
+	assert.Len(t, set.JWKS().Keys, 2)
+	for _, jwk := range set.JWKS().Keys {
+		key, err := jwk.PublicKey()
+		assert.NoError(t, err)
+		expected, err := set.PublicKey(context.Background(), jwk.KID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, key)
+	}
+
+	_, err = NewKeySet("missing", []SigningKey{{KID: "old", PrivateKey: rsaKey}})
+	assert.Error(t, err)
+
+	empty, err := NewKeySet("", nil)
+	assert.NoError(t, err)
+	assert.False(t, empty.CanSign())
+	_, err = empty.SignToken(nil)
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+}
@@ -0,0 +1,143 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+var ErrNoSigningKey = errors.New("no signing key configured")
+
+type SigningKey struct {
+	KID        string
+	PrivateKey crypto.Signer
+}
+
+// KeySet holds the private keys of the token issuer. Tokens are signed with the active key only, the other keys
+// are still published so the tokens they signed stay valid while the keys are rotated.
+type KeySet struct {
+	active     *SigningKey
+	publicKeys map[string]crypto.PublicKey
+	jwks       JSONWebKeySet
+}
+
+// NewKeySet builds the key set, an empty activeKID is only allowed without keys and gives a set that can not sign.
+func NewKeySet(activeKID string, keys []SigningKey) (*KeySet, error) {
+	set := &KeySet{
+		publicKeys: make(map[string]crypto.PublicKey, len(keys)),
+		jwks:       JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(keys))},
+	}
+	for i := range keys {
+		key := keys[i]
+		if _, ok := set.publicKeys[key.KID]; ok || key.KID == "" {
+			return nil, fmt.Errorf("invalid or duplicated key id %q", key.KID)
+		}
+		jwk, err := NewJSONWebKey(key.KID, key.PrivateKey.Public())
+		if err != nil {
+			return nil, fmt.Errorf("key %s: %w", key.KID, err)
+		}
+		set.publicKeys[key.KID] = key.PrivateKey.Public()
+		set.jwks.Keys = append(set.jwks.Keys, jwk)
+		if key.KID == activeKID {
+			set.active = &key
+		}
+	}
+	if set.active == nil && (activeKID != "" || len(keys) > 0) {
+		return nil, fmt.Errorf("active key %q not found", activeKID)
+	}
+	return set, nil
+}
+
+// LoadKeySet reads every <kid>.pem private key of the directory.
+func LoadKeySet(dir string, activeKID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	keys := make([]SigningKey, 0, len(paths))
+	for _, path := range paths {
+		privateKey, err := LoadPrivateKey(path)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, SigningKey{
+			KID:        strings.TrimSuffix(filepath.Base(path), ".pem"),
+			PrivateKey: privateKey,
+		})
+	}
+	return NewKeySet(activeKID, keys)
+}
+
+// LoadPrivateKey reads a PEM encoded PKCS#8 RSA or Ed25519 key, or a PKCS#1 RSA key.
+func LoadPrivateKey(path string) (crypto.Signer, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM data", path)
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return key, nil
+	case ed25519.PrivateKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("%s: %w", path, ErrUnsupportedKeyType)
+	}
+}
+
+// SignToken signs the claims with the active key and tags the token with its kid.
+func (s *KeySet) SignToken(claims jwt.Claims) (string, error) {
+	if s.active == nil {
+		return "", ErrNoSigningKey
+	}
+	var method jwt.SigningMethod
+	switch s.active.PrivateKey.(type) {
+	case *rsa.PrivateKey:
+		method = jwt.SigningMethodRS256
+	case ed25519.PrivateKey:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return "", ErrUnsupportedKeyType
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = s.active.KID
+	return token.SignedString(s.active.PrivateKey)
+}
+
+func (s *KeySet) CanSign() bool {
+	return s.active != nil
+}
+
+func (s *KeySet) PublicKey(_ context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok := s.publicKeys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+func (s *KeySet) JWKS() JSONWebKeySet {
+	return s.jwks
+}
@@ -0,0 +1,85 @@
+package oidc_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"booking-event/internal/infra/oidc"
+	"booking-event/internal/infra/oidc/oidctest"
+)
+
+const redirectURL = "http://localhost:8083/api/v1/oauth/mock/callback"
+
+func TestProvider_Exchange(t *testing.T) {
+	t.Parallel()
+	server := oidctest.NewServer(oidctest.User{Subject: "subject-1", Email: "user@example.com", EmailVerified: true})
+	defer server.Close()
+
+	login := func(provider *oidc.Provider, nonce string) (string, string) {
+		verifier, err := oidc.NewCodeVerifier()
+		assert.NoError(t, err)
+		authURL, err := provider.AuthCodeURL(context.Background(), "state-1", nonce, oidc.CodeChallenge(verifier))
+		assert.NoError(t, err)
+		link, err := url.Parse(authURL)
+		assert.NoError(t, err)
+		assert.Equal(t, redirectURL, link.Query().Get("redirect_uri"))
+
+		code, state, err := server.Authorize(authURL)
+		assert.NoError(t, err)
+		assert.Equal(t, "state-1", state)
+		return code, verifier
+	}
+
+	t.Run("Valid login", func(t *testing.T) {
+		provider := oidc.NewProvider(server.Config(redirectURL), time.Now)
+		code, verifier := login(provider, "nonce-1")
+
+		claims, err := provider.Exchange(context.Background(), code, verifier, "nonce-1")
+		assert.NoError(t, err)
+		assert.Equal(t, "subject-1", claims.Subject)
+		assert.Equal(t, "user@example.com", claims.Email)
+		assert.True(t, bool(claims.EmailVerified))
+
+		// codes are single use
+		_, err = provider.Exchange(context.Background(), code, verifier, "nonce-1")
+		assert.Error(t, err)
+	})
+
+	t.Run("Wrong code verifier", func(t *testing.T) {
+		provider := oidc.NewProvider(server.Config(redirectURL), time.Now)
+		code, _ := login(provider, "nonce-1")
+
+		otherVerifier, err := oidc.NewCodeVerifier()
+		assert.NoError(t, err)
+		_, err = provider.Exchange(context.Background(), code, otherVerifier, "nonce-1")
+		assert.Error(t, err)
+	})
+
+	t.Run("Replayed ID token of another login", func(t *testing.T) {
+		provider := oidc.NewProvider(server.Config(redirectURL), time.Now)
+		code, verifier := login(provider, "nonce-1")
+
+		_, err := provider.Exchange(context.Background(), code, verifier, "nonce-2")
+		assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+	})
+
+	t.Run("ID token for another client", func(t *testing.T) {
+		server := oidctest.NewServer(oidctest.User{Subject: "subject-1", Email: "user@example.com", EmailVerified: true})
+		defer server.Close()
+		server.Audience = "other-client"
+		provider := oidc.NewProvider(server.Config(redirectURL), time.Now)
+		verifier, err := oidc.NewCodeVerifier()
+		assert.NoError(t, err)
+		authURL, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", oidc.CodeChallenge(verifier))
+		assert.NoError(t, err)
+		code, _, err := server.Authorize(authURL)
+		assert.NoError(t, err)
+
+		_, err = provider.Exchange(context.Background(), code, verifier, "nonce-1")
+		assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+	})
+}
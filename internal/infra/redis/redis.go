@@ -35,7 +35,9 @@ type Redis interface {
 	AppendPrefix(key string) string
 	AppendPrefixSlice(keys []string) []string
 	Get(ctx context.Context, key string) (string, error)
+	MGet(ctx context.Context, keys ...string) ([]interface{}, error)
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) (string, error)
+	Del(ctx context.Context, keys ...string) (int64, error)
 	Publish(ctx context.Context, channel string, message interface{}) (int64, error)
 	Subscribe(ctx context.Context, channels ...string) *redis.PubSub
 	Ping(ctx context.Context) (string, error)
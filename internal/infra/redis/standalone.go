@@ -28,10 +28,18 @@ func (r *standaloneRedis) Get(ctx context.Context, key string) (string, error) {
 	return r.client.Get(ctx, r.AppendPrefix(key)).Result()
 }
 
+func (r *standaloneRedis) MGet(ctx context.Context, keys ...string) ([]interface{}, error) {
+	return r.client.MGet(ctx, r.AppendPrefixSlice(keys)...).Result()
+}
+
 func (r *standaloneRedis) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) (string, error) {
 	return r.client.Set(ctx, r.AppendPrefix(key), value, expiration).Result()
 }
 
+func (r *standaloneRedis) Del(ctx context.Context, keys ...string) (int64, error) {
+	return r.client.Del(ctx, r.AppendPrefixSlice(keys)...).Result()
+}
+
 func (r *standaloneRedis) Ping(ctx context.Context) (string, error) {
 	return r.client.Ping(ctx).Result()
 }
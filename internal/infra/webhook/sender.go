@@ -0,0 +1,198 @@
+// Package webhook posts the signed webhook events to the endpoints of the partners.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	HeaderID        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderSignature = "X-Webhook-Signature"
+
+	// maxResponseBody is the part of the response body kept in the log of the attempt.
+	maxResponseBody = 1024
+)
+
+// ErrPrivateAddress is returned for an endpoint resolving to a loopback, private, link-local or otherwise internal
+// address, the deliveries must not reach the network of the servers.
+var ErrPrivateAddress = errors.New("webhook endpoint resolves to a private address")
+
+// reservedNetworks are the internal ranges not covered by the methods of net.IP.
+var reservedNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+	mustParseCIDR("192.0.0.0/24"),
+	mustParseCIDR("198.18.0.0/15"),
+	mustParseCIDR("240.0.0.0/4"),
+	mustParseCIDR("64:ff9b::/96"),
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// IsPublicIP reports whether ip can be reached by a delivery.
+func IsPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, network := range reservedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+type Config struct {
+	Timeout time.Duration
+	// AllowPrivateNetwork lets the deliveries reach the internal addresses, for the local setups only.
+	AllowPrivateNetwork bool
+}
+
+type Request struct {
+	URL    string
+	Secret string
+	// ID and Event are sent as headers, so the receiver can route or drop a delivery before reading the body.
+	ID    string
+	Event string
+	Body  []byte
+}
+
+// Response is the answer of the endpoint, any status is returned and the caller decides what succeeds.
+type Response struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+}
+
+// Sign computes the signature header of a body sent at timestamp: the HMAC-SHA256 with the secret of
+// "<timestamp>.<body>", the timestamp lets the receiver refuse the old deliveries replayed by a third party.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", unix, hex.EncodeToString(mac.Sum(nil)))
+}
+
+type Sender struct {
+	httpClient          *http.Client
+	resolver            *net.Resolver
+	allowPrivateNetwork bool
+	nowFn               func() time.Time
+}
+
+func NewSender(cfg Config, nowFn func() time.Time) *Sender {
+	s := &Sender{
+		resolver:            net.DefaultResolver,
+		allowPrivateNetwork: cfg.AllowPrivateNetwork,
+		nowFn:               nowFn,
+	}
+	// the address is checked once resolved, right before connecting, so a host changing its DNS records after
+	// CheckURL still cannot reach an internal address
+	dialer := &net.Dialer{Timeout: cfg.Timeout, Control: s.checkDialAddress}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	s.httpClient = &http.Client{
+		Timeout:   cfg.Timeout,
+		Transport: transport,
+		// a redirect is answered like any other status, the signed body is not sent to another host
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return s
+}
+
+// CheckURL resolves the host of an endpoint and refuses it when one of its addresses is internal.
+func (s *Sender) CheckURL(ctx context.Context, rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if s.allowPrivateNetwork {
+		return nil
+	}
+	host := parsed.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return ErrPrivateAddress
+		}
+		return nil
+	}
+	addrs, err := s.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return ErrPrivateAddress
+		}
+	}
+	return nil
+}
+
+func (s *Sender) checkDialAddress(network, address string, _ syscall.RawConn) error {
+	if s.allowPrivateNetwork {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !IsPublicIP(ip) {
+		return ErrPrivateAddress
+	}
+	return nil
+}
+
+// Send posts the body to the endpoint, an error is only returned when no response was received.
+func (s *Sender) Send(ctx context.Context, request Request) (*Response, error) {
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return nil, err
+	}
+	start := s.nowFn()
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set(HeaderID, request.ID)
+	httpRequest.Header.Set(HeaderEvent, request.Event)
+	httpRequest.Header.Set(HeaderSignature, Sign(request.Secret, start, request.Body))
+
+	httpResponse, err := s.httpClient.Do(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResponse.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(httpResponse.Body, maxResponseBody))
+	if err != nil {
+		return nil, err
+	}
+	return &Response{
+		StatusCode: httpResponse.StatusCode,
+		Body:       string(body),
+		Duration:   s.nowFn().Sub(start),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	timestamp := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	body := []byte(`{"id":"booking.confirmed:7"}`)
+
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write([]byte("1790848800." + string(body)))
+	assert.Equal(t, "t=1790848800,v1="+hex.EncodeToString(mac.Sum(nil)), Sign("whsec_test", timestamp, body))
+	assert.NotEqual(t, Sign("whsec_test", timestamp, body), Sign("whsec_other", timestamp, body))
+}
+
+func TestSender_Send(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	body := []byte(`{"id":"booking.confirmed:7","type":"booking.confirmed"}`)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, body, received)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "booking.confirmed:7", r.Header.Get(HeaderID))
+		assert.Equal(t, "booking.confirmed", r.Header.Get(HeaderEvent))
+		assert.Equal(t, Sign("whsec_test", now, body), r.Header.Get(HeaderSignature))
+
+		switch r.URL.Path {
+		case "/redirect":
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		case "/large":
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(strings.Repeat("x", 4096)))
+		default:
+			_, _ = w.Write([]byte("ok"))
+		}
+	}))
+	defer server.Close()
+
+	sender := NewSender(Config{Timeout: time.Second, AllowPrivateNetwork: true}, func() time.Time { return now })
+	request := Request{Secret: "whsec_test", ID: "booking.confirmed:7", Event: "booking.confirmed", Body: body}
+
+	request.URL = server.URL + "/hook"
+	response, err := sender.Send(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "ok", response.Body)
+
+	// the redirects are not followed
+	request.URL = server.URL + "/redirect"
+	response, err = sender.Send(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusFound, response.StatusCode)
+
+	request.URL = server.URL + "/large"
+	response, err = sender.Send(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+	assert.Len(t, response.Body, maxResponseBody)
+
+	server.Close()
+	_, err = sender.Send(context.Background(), request)
+	assert.Error(t, err)
+}
+
+func TestIsPublicIP(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		ip       string
+		expected bool
+	}{
+		{ip: "93.184.216.34", expected: true},
+		{ip: "2606:4700::1111", expected: true},
+		{ip: "127.0.0.1", expected: false},
+		{ip: "::1", expected: false},
+		{ip: "10.1.2.3", expected: false},
+		{ip: "172.16.0.1", expected: false},
+		{ip: "192.168.1.1", expected: false},
+		{ip: "169.254.169.254", expected: false},
+		{ip: "fe80::1", expected: false},
+		{ip: "fd00::1", expected: false},
+		{ip: "0.0.0.0", expected: false},
+		{ip: "::", expected: false},
+		{ip: "100.64.0.1", expected: false},
+		{ip: "::ffff:127.0.0.1", expected: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, IsPublicIP(net.ParseIP(tt.ip)), tt.ip)
+	}
+}
+
+func TestSender_CheckURL(t *testing.T) {
+	t.Parallel()
+	sender := NewSender(Config{Timeout: time.Second}, time.Now)
+
+	assert.NoError(t, sender.CheckURL(context.Background(), "https://93.184.216.34/hooks"))
+	assert.ErrorIs(t, sender.CheckURL(context.Background(), "https://169.254.169.254/latest/meta-data"), ErrPrivateAddress)
+	assert.ErrorIs(t, sender.CheckURL(context.Background(), "https://[::1]:8443/hooks"), ErrPrivateAddress)
+	assert.ErrorIs(t, sender.CheckURL(context.Background(), "https://10.0.0.5/hooks"), ErrPrivateAddress)
+	assert.ErrorIs(t, sender.CheckURL(context.Background(), "https://localhost:8443/hooks"), ErrPrivateAddress)
+
+	allowed := NewSender(Config{Timeout: time.Second, AllowPrivateNetwork: true}, time.Now)
+	assert.NoError(t, allowed.CheckURL(context.Background(), "https://localhost:8443/hooks"))
+}
+
+func TestSender_Send_PrivateAddress(t *testing.T) {
+	t.Parallel()
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	// the address is checked when connecting, whatever the check of the subscription said
+	sender := NewSender(Config{Timeout: time.Second}, time.Now)
+	_, err := sender.Send(context.Background(), Request{URL: server.URL, Secret: "whsec_test", Body: []byte("{}")})
+	assert.ErrorIs(t, err, ErrPrivateAddress)
+	assert.False(t, called)
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strings"
@@ -12,9 +13,19 @@ import (
 )
 
 type AuthValidator interface {
-	VerifyJWTToken(token string) (*model.TokenClaims, error)
+	VerifyJWTToken(ctx context.Context, token string) (*model.TokenClaims, error)
 }
 
+type RevocationChecker interface {
+	IsTokenRevoked(ctx context.Context, claims *model.TokenClaims) (bool, error)
+}
+
+type APIKeyValidator interface {
+	VerifyAPIKey(ctx context.Context, key string) (*model.APIKeyPrincipal, error)
+}
+
+const apiKeyScheme = "ApiKey "
+
 func ExtractTokenFromBearer(token string) (string, error) {
 	splitToken := strings.Split(token, "Bearer ")
 	if len(splitToken) != 2 {
@@ -23,29 +34,38 @@ func ExtractTokenFromBearer(token string) (string, error) {
 	return splitToken[1], nil
 }
 
-func AuthMiddleware(validator AuthValidator) gin.HandlerFunc {
+// AuthMiddleware accepts access tokens, and API keys of service accounts when apiKeyValidator is not nil.
+func AuthMiddleware(validator AuthValidator, revocationChecker RevocationChecker, apiKeyValidator APIKeyValidator) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		bearerToken := ctx.GetHeader("Authorization")
 		if bearerToken == "" {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 			return
 		}
+		if key, ok := strings.CutPrefix(bearerToken, apiKeyScheme); ok && apiKeyValidator != nil {
+			principal, ok := verifyAPIKey(ctx, apiKeyValidator, key)
+			if !ok {
+				return
+			}
+			ctx.Request = ctx.Request.WithContext(util.SetPrincipalContext(ctx.Request.Context(), principal))
+			ctx.Next()
+			return
+		}
 		token, err := ExtractTokenFromBearer(bearerToken)
 		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 			return
 		}
-		claims, err := validator.VerifyJWTToken(token)
-		if err != nil {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		claims, ok := verifyToken(ctx, validator, revocationChecker, token)
+		if !ok {
 			return
 		}
-		ctx.Request = ctx.Request.WithContext(util.SetUserIDContext(ctx.Request.Context(), claims.UserID))
+		ctx.Request = ctx.Request.WithContext(withClaims(ctx.Request.Context(), claims))
 		ctx.Next()
 	}
 }
 
-func AdminAuthMiddleware(validator AuthValidator) gin.HandlerFunc {
+func AdminAuthMiddleware(validator AuthValidator, revocationChecker RevocationChecker) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		bearerToken := ctx.GetHeader("Authorization")
 		if bearerToken == "" {
@@ -57,16 +77,76 @@ func AdminAuthMiddleware(validator AuthValidator) gin.HandlerFunc {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 			return
 		}
-		claims, err := validator.VerifyJWTToken(token)
-		if err != nil {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		claims, ok := verifyToken(ctx, validator, revocationChecker, token)
+		if !ok {
 			return
 		}
 		if !strings.EqualFold(string(claims.Role), string(model.RoleAdmin)) {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
 			return
 		}
-		ctx.Request = ctx.Request.WithContext(util.SetUserIDContext(ctx.Request.Context(), claims.UserID))
+		ctx.Request = ctx.Request.WithContext(withClaims(ctx.Request.Context(), claims))
 		ctx.Next()
 	}
 }
+
+// verifyToken aborts the request unless the token is valid and not revoked. The revocation checker may be nil
+// when the validator already accounts for revocations, like the introspection validator.
+func verifyToken(ctx *gin.Context, validator AuthValidator, revocationChecker RevocationChecker, token string) (*model.TokenClaims, bool) {
+	claims, err := validator.VerifyJWTToken(ctx.Request.Context(), token)
+	if errors.Is(err, ErrAuthUnavailable) {
+		ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "Service Unavailable"})
+		return nil, false
+	} else if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return nil, false
+	}
+	if revocationChecker == nil {
+		return claims, true
+	}
+	revoked, err := revocationChecker.IsTokenRevoked(ctx.Request.Context(), claims)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "Service Unavailable"})
+		return nil, false
+	}
+	if revoked {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return nil, false
+	}
+	return claims, true
+}
+
+func verifyAPIKey(ctx *gin.Context, apiKeyValidator APIKeyValidator, key string) (util.Principal, bool) {
+	apiKey, err := apiKeyValidator.VerifyAPIKey(ctx.Request.Context(), key)
+	if errors.Is(err, model.ErrInvalidAPIKey) {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return util.Principal{}, false
+	} else if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "Service Unavailable"})
+		return util.Principal{}, false
+	}
+	return util.Principal{
+		Type:             util.PrincipalTypeService,
+		UserID:           apiKey.OwnerID,
+		ServiceAccountID: apiKey.ServiceAccountID,
+		APIKeyID:         apiKey.APIKeyID,
+		Permissions:      permissionNames(apiKey.Permissions),
+	}, true
+}
+
+func withClaims(ctx context.Context, claims *model.TokenClaims) context.Context {
+	return util.SetPrincipalContext(ctx, util.Principal{
+		Type:        util.PrincipalTypeUser,
+		UserID:      claims.UserID,
+		Role:        string(claims.Role),
+		Permissions: permissionNames(claims.Permissions),
+	})
+}
+
+func permissionNames(permissions []model.Permission) []string {
+	names := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		names = append(names, string(permission))
+	}
+	return names
+}
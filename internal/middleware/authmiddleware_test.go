@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+type fakeValidator struct{}
+
+func (fakeValidator) VerifyJWTToken(ctx context.Context, token string) (*model.TokenClaims, error) {
+	if token != "access" {
+		return nil, errors.New("invalid token")
+	}
+	return &model.TokenClaims{UserID: 1, Role: model.RoleOrganizer, Permissions: []model.Permission{model.PermissionEventCreate}, Type: model.TokenTypeAccess}, nil
+}
+
+type fakeAPIKeyValidator struct{}
+
+func (fakeAPIKeyValidator) VerifyAPIKey(ctx context.Context, key string) (*model.APIKeyPrincipal, error) {
+	if key != "bek_key" {
+		return nil, model.ErrInvalidAPIKey
+	}
+	return &model.APIKeyPrincipal{ServiceAccountID: 3, APIKeyID: 11, OwnerID: 7, Permissions: []model.Permission{model.PermissionCheckinScan}}, nil
+}
+
+func TestAuthMiddleware_Principal(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		authorization     string
+		apiKeyValidator   APIKeyValidator
+		expectedStatus    int
+		expectedPrincipal util.Principal
+	}{
+		{
+			name:           "Access token",
+			authorization:  "Bearer access",
+			expectedStatus: http.StatusOK,
+			expectedPrincipal: util.Principal{
+				Type:        util.PrincipalTypeUser,
+				UserID:      1,
+				Role:        string(model.RoleOrganizer),
+				Permissions: []string{string(model.PermissionEventCreate)},
+			},
+		},
+		{
+			name:            "API key",
+			authorization:   "ApiKey bek_key",
+			apiKeyValidator: fakeAPIKeyValidator{},
+			expectedStatus:  http.StatusOK,
+			expectedPrincipal: util.Principal{
+				Type:             util.PrincipalTypeService,
+				UserID:           7,
+				ServiceAccountID: 3,
+				APIKeyID:         11,
+				Permissions:      []string{string(model.PermissionCheckinScan)},
+			},
+		},
+		{
+			name:            "Invalid API key",
+			authorization:   "ApiKey bek_other",
+			apiKeyValidator: fakeAPIKeyValidator{},
+			expectedStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:           "API keys not accepted",
+			authorization:  "ApiKey bek_key",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Invalid access token",
+			authorization:  "Bearer other",
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var principal util.Principal
+			router := gin.New()
+			router.GET("/", AuthMiddleware(fakeValidator{}, nil, tt.apiKeyValidator), func(c *gin.Context) {
+				principal, _ = util.GetPrincipalContext(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", tt.authorization)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedPrincipal, principal)
+			}
+		})
+	}
+}
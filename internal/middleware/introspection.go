@@ -0,0 +1,189 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+// ErrAuthUnavailable is returned when the token could not be checked, the request is answered with 503 and not 401.
+var ErrAuthUnavailable = errors.New("auth server unavailable")
+
+var errInactiveToken = errors.New("inactive token")
+
+type IntrospectionConfig struct {
+	URL          string
+	ClientID     string
+	ClientSecret string
+	Timeout      time.Duration
+	// CacheTTL is how long an answer is reused, a revocation takes up to CacheTTL to be seen by the server.
+	CacheTTL  time.Duration
+	CacheSize int
+	// FailureThreshold consecutive failures open the circuit, the endpoint is not called for OpenDuration.
+	FailureThreshold int
+	OpenDuration     time.Duration
+}
+
+// IntrospectionValidator checks the tokens by asking central-auth, so revoked tokens are refused without the
+// server knowing the signing keys or the revocation store.
+type IntrospectionValidator struct {
+	cfg        IntrospectionConfig
+	httpClient *http.Client
+	nowFn      func() time.Time
+	// the claims of the active tokens, nil for the inactive ones
+	cache *util.TTLCache[*model.TokenClaims]
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time // zero while the circuit is closed
+	probing   bool      // a call is trying the endpoint while the circuit is half open
+}
+
+func NewIntrospectionValidator(cfg IntrospectionConfig, nowFn func() time.Time) *IntrospectionValidator {
+	return &IntrospectionValidator{
+		cfg:        cfg,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		nowFn:      nowFn,
+		cache:      util.NewTTLCache[*model.TokenClaims](cfg.CacheSize),
+	}
+}
+
+// VerifyJWTToken returns the claims of an active token, inactive tokens are cached too so a bad token can not
+// flood central-auth.
+func (v *IntrospectionValidator) VerifyJWTToken(ctx context.Context, token string) (*model.TokenClaims, error) {
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+	now := v.nowFn()
+
+	if claims, ok := v.cache.Get(key, now); ok {
+		if claims == nil {
+			return nil, errInactiveToken
+		}
+		return claims, nil
+	}
+	allowed, probe := v.allowCall(now)
+	if !allowed {
+		return nil, ErrAuthUnavailable
+	}
+
+	introspection, err := v.introspect(ctx, token)
+	v.recordResult(err, probe)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAuthUnavailable, err)
+	}
+
+	var claims *model.TokenClaims
+	expiresAt := now.Add(v.cfg.CacheTTL)
+	if introspection.Active {
+		claims = claimsFromIntrospection(introspection)
+		if claims.ExpiresAt != nil && claims.ExpiresAt.Time.Before(expiresAt) {
+			expiresAt = claims.ExpiresAt.Time
+		}
+	}
+	if v.cfg.CacheTTL > 0 {
+		v.cache.Set(key, claims, expiresAt, now)
+	}
+	if claims == nil {
+		return nil, errInactiveToken
+	}
+	return claims, nil
+}
+
+func (v *IntrospectionValidator) introspect(ctx context.Context, token string) (*model.IntrospectionResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, v.cfg.Timeout)
+	defer cancel()
+	form := url.Values{"token": {token}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.cfg.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(v.cfg.ClientID, v.cfg.ClientSecret)
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("introspection answered with status %d", resp.StatusCode)
+	}
+	var introspection model.IntrospectionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&introspection); err != nil {
+		return nil, err
+	}
+	return &introspection, nil
+}
+
+// allowCall tells whether the endpoint may be called. Nothing goes through while the circuit is open, once
+// OpenDuration has passed a single call probes the endpoint and the others are refused until it resolves.
+func (v *IntrospectionValidator) allowCall(now time.Time) (allowed bool, probe bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.openUntil.IsZero() {
+		return true, false
+	}
+	if now.Before(v.openUntil) || v.probing {
+		return false, false
+	}
+	v.probing = true
+	return true, true
+}
+
+// recordResult opens the circuit after FailureThreshold consecutive failures. A successful probe closes it, a
+// failed one opens it again for OpenDuration.
+func (v *IntrospectionValidator) recordResult(err error, probe bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if probe {
+		v.probing = false
+	}
+	if err == nil {
+		v.failures = 0
+		v.openUntil = time.Time{}
+		return
+	}
+	if probe {
+		v.openUntil = v.nowFn().Add(v.cfg.OpenDuration)
+		return
+	}
+	v.failures++
+	if v.cfg.FailureThreshold > 0 && v.failures >= v.cfg.FailureThreshold {
+		v.openUntil = v.nowFn().Add(v.cfg.OpenDuration)
+		v.failures = 0
+	}
+}
+
+func claimsFromIntrospection(introspection *model.IntrospectionResponse) *model.TokenClaims {
+	claims := &model.TokenClaims{
+		UserID:      introspection.UserID,
+		Email:       introspection.Email,
+		Role:        introspection.Role,
+		Permissions: introspection.Permissions,
+		Type:        introspection.TokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:      introspection.JTI,
+			Subject: introspection.Subject,
+		},
+	}
+	if introspection.IssuedAt != 0 {
+		claims.IssuedAt = jwt.NewNumericDate(time.Unix(introspection.IssuedAt, 0))
+	}
+	if introspection.ExpiresAt != 0 {
+		claims.ExpiresAt = jwt.NewNumericDate(time.Unix(introspection.ExpiresAt, 0))
+	}
+	return claims
+}
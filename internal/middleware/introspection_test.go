@@ -0,0 +1,180 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type introspectionServer struct {
+	*httptest.Server
+	calls   atomic.Int32
+	failing atomic.Bool
+}
+
+// newIntrospectionServer answers like central-auth, "good" is the only active token.
+func newIntrospectionServer(t *testing.T, expiresAt time.Time) *introspectionServer {
+	s := &introspectionServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.calls.Add(1)
+		if s.failing.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		clientID, clientSecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "booking-server", clientID)
+		assert.Equal(t, "secret", clientSecret)
+
+		response := model.IntrospectionResponse{Active: false}
+		if r.PostFormValue("token") == "good" {
+			response = model.IntrospectionResponse{
+				Active:    true,
+				TokenType: model.TokenTypeAccess,
+				Subject:   "1",
+				UserID:    1,
+				Role:      model.RoleUser,
+				JTI:       "access-1",
+				ExpiresAt: expiresAt.Unix(),
+			}
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	return s
+}
+
+func newTestValidator(url string, nowFn func() time.Time) *IntrospectionValidator {
+	return NewIntrospectionValidator(IntrospectionConfig{
+		URL:              url,
+		ClientID:         "booking-server",
+		ClientSecret:     "secret",
+		Timeout:          time.Second,
+		CacheTTL:         5 * time.Second,
+		CacheSize:        10,
+		FailureThreshold: 2,
+		OpenDuration:     10 * time.Second,
+	}, nowFn)
+}
+
+func TestIntrospectionValidator_Cache(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	server := newIntrospectionServer(t, now.Add(3*time.Second))
+	defer server.Close()
+	validator := newTestValidator(server.URL, func() time.Time { return now })
+
+	claims, err := validator.VerifyJWTToken(context.Background(), "good")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, claims.UserID)
+	assert.Equal(t, model.RoleUser, claims.Role)
+	assert.Equal(t, "access-1", claims.ID)
+
+	_, err = validator.VerifyJWTToken(context.Background(), "bad")
+	assert.ErrorIs(t, err, errInactiveToken)
+
+	// both answers are served from the cache
+	_, err = validator.VerifyJWTToken(context.Background(), "good")
+	assert.NoError(t, err)
+	_, err = validator.VerifyJWTToken(context.Background(), "bad")
+	assert.ErrorIs(t, err, errInactiveToken)
+	assert.Equal(t, int32(2), server.calls.Load())
+
+	// the active answer is not kept past the expiry of the token
+	now = now.Add(4 * time.Second)
+	_, err = validator.VerifyJWTToken(context.Background(), "good")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), server.calls.Load())
+}
+
+func TestIntrospectionValidator_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	server := newIntrospectionServer(t, now.Add(time.Hour))
+	defer server.Close()
+	validator := newTestValidator(server.URL, func() time.Time { return now })
+
+	server.failing.Store(true)
+	for range 2 {
+		_, err := validator.VerifyJWTToken(context.Background(), "good")
+		assert.ErrorIs(t, err, ErrAuthUnavailable)
+	}
+	// the circuit is open, central-auth is not called
+	_, err := validator.VerifyJWTToken(context.Background(), "good")
+	assert.ErrorIs(t, err, ErrAuthUnavailable)
+	assert.Equal(t, int32(2), server.calls.Load())
+
+	// a single failed trial opens it again
+	now = now.Add(11 * time.Second)
+	_, err = validator.VerifyJWTToken(context.Background(), "good")
+	assert.ErrorIs(t, err, ErrAuthUnavailable)
+	_, err = validator.VerifyJWTToken(context.Background(), "good")
+	assert.ErrorIs(t, err, ErrAuthUnavailable)
+	assert.Equal(t, int32(3), server.calls.Load())
+
+	// a successful trial closes it
+	server.failing.Store(false)
+	now = now.Add(11 * time.Second)
+	_, err = validator.VerifyJWTToken(context.Background(), "good")
+	assert.NoError(t, err)
+	_, err = validator.VerifyJWTToken(context.Background(), "bad")
+	assert.ErrorIs(t, err, errInactiveToken)
+	assert.Equal(t, int32(5), server.calls.Load())
+}
+
+func TestIntrospectionValidator_CircuitBreaker_SingleProbe(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	var calls atomic.Int32
+	probing := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1, 2:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		case 3:
+			// the probe is held until the test has tried the other calls
+			close(probing)
+			<-release
+		}
+		_ = json.NewEncoder(w).Encode(model.IntrospectionResponse{Active: false})
+	}))
+	defer server.Close()
+	validator := newTestValidator(server.URL, func() time.Time { return now })
+
+	for range 2 {
+		_, err := validator.VerifyJWTToken(context.Background(), "good")
+		assert.ErrorIs(t, err, ErrAuthUnavailable)
+	}
+
+	now = now.Add(11 * time.Second)
+	probeErr := make(chan error)
+	go func() {
+		_, err := validator.VerifyJWTToken(context.Background(), "probe")
+		probeErr <- err
+	}()
+	<-probing
+
+	// the other calls are refused while the probe is in flight
+	for range 5 {
+		_, err := validator.VerifyJWTToken(context.Background(), "good")
+		assert.ErrorIs(t, err, ErrAuthUnavailable)
+	}
+	assert.Equal(t, int32(3), calls.Load())
+
+	close(release)
+	assert.ErrorIs(t, <-probeErr, errInactiveToken)
+
+	// the successful probe closed the circuit
+	_, err := validator.VerifyJWTToken(context.Background(), "good")
+	assert.ErrorIs(t, err, errInactiveToken)
+	assert.Equal(t, int32(4), calls.Load())
+}
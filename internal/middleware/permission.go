@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/util"
+)
+
+// RequirePermissions lets a route declare the permissions its caller needs, it must run after AuthMiddleware
+// which puts the permissions of the access token into the request context.
+func RequirePermissions(permissions ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		granted := util.GetUserPermissionsContext(ctx.Request.Context())
+		for _, permission := range permissions {
+			if !slices.Contains(granted, permission) {
+				ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+				return
+			}
+		}
+		ctx.Next()
+	}
+}
+
+// RequireScopes gates the routes open to every user on the scopes of the API keys. Users pass whatever their token
+// carries, since every role holds these permissions, while a service account needs all of them among its scopes.
+func RequireScopes(scopes ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		principal, _ := util.GetPrincipalContext(ctx.Request.Context())
+		if principal.Type != util.PrincipalTypeService {
+			ctx.Next()
+			return
+		}
+		for _, scope := range scopes {
+			if !slices.Contains(principal.Permissions, scope) {
+				ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+				return
+			}
+		}
+		ctx.Next()
+	}
+}
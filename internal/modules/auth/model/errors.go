@@ -5,4 +5,46 @@ import "errors"
 var (
 	ErrUserNotFound    = errors.New("user not found")
 	ErrInvalidPassword = errors.New("invalid password")
+	ErrPasswordTooLong = errors.New("the password must be at most 72 bytes")
+
+	ErrInvalidCredentials   = errors.New("invalid email or password")
+	ErrTooManyLoginAttempts = errors.New("too many login attempts, try again later")
+	ErrInvalidUnlockToken   = errors.New("invalid or expired unlock token")
+
+	ErrEmailAlreadyRegistered   = errors.New("email is already registered")
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token was already used, all sessions of the login are revoked")
+
+	ErrInvalidResetToken = errors.New("invalid or expired password reset token")
+
+	ErrMFANotEnrolled      = errors.New("mfa is not enrolled")
+	ErrMFAAlreadyEnabled   = errors.New("mfa is already enabled")
+	ErrMFARequired         = errors.New("mfa is required for the role")
+	ErrInvalidMFACode      = errors.New("invalid mfa code")
+	ErrInvalidMFAChallenge = errors.New("invalid or expired mfa challenge")
+
+	ErrUnknownIdentityProvider = errors.New("unknown identity provider")
+	ErrInvalidOIDCState        = errors.New("invalid or expired login state")
+	ErrOIDCLoginFailed         = errors.New("login with the identity provider failed")
+	ErrOIDCEmailNotVerified    = errors.New("the identity provider did not verify the email")
+	ErrIdentityNotFound        = errors.New("identity not found")
+
+	ErrServiceAccountNotFound = errors.New("service account not found")
+	ErrAPIKeyNotFound         = errors.New("api key not found")
+	ErrInvalidAPIKey          = errors.New("invalid, expired or revoked api key")
+	ErrInvalidAPIKeyScope     = errors.New("api key scopes must be permissions of the service account owner")
+	ErrInvalidAPIKeyExpiry    = errors.New("api key expiry must be in the future")
+
+	ErrUserSuspended         = errors.New("the account is suspended")
+	ErrCannotSuspendSelf     = errors.New("admins can not suspend themselves")
+	ErrUserNotSuspended      = errors.New("the account is not suspended")
+	ErrInvalidBirthdate      = errors.New("invalid birthdate")
+	ErrEmailUnchanged        = errors.New("the new email is the current one")
+	ErrInvalidEmailChange    = errors.New("invalid or expired email change link")
+	ErrCurrentPasswordNeeded = errors.New("the current password is required")
+
+	ErrUnknownRole         = errors.New("unknown role")
+	ErrCannotChangeOwnRole = errors.New("admins can not change their own role")
 )
@@ -0,0 +1,20 @@
+package model
+
+// IntrospectionRequest is the form posted to the introspection endpoint as in RFC 7662.
+type IntrospectionRequest struct {
+	Token string `form:"token" binding:"required"`
+}
+
+// IntrospectionResponse follows RFC 7662, only active is set for tokens which are invalid, expired or revoked.
+type IntrospectionResponse struct {
+	Active      bool         `json:"active"`
+	TokenType   TokenType    `json:"token_type,omitempty"`
+	Subject     string       `json:"sub,omitempty"`
+	UserID      int          `json:"user_id,omitempty"`
+	Email       string       `json:"email,omitempty"`
+	Role        UserRole     `json:"role,omitempty"`
+	Permissions []Permission `json:"perms,omitempty"`
+	JTI         string       `json:"jti,omitempty"`
+	IssuedAt    int64        `json:"iat,omitempty"`
+	ExpiresAt   int64        `json:"exp,omitempty"`
+}
@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+// LoginAttempt is a login request together with where it comes from.
+type LoginAttempt struct {
+	Email     string
+	Password  string
+	IP        string
+	UserAgent string
+}
+
+type LoginOutcome string
+
+const (
+	LoginOutcomeInvalidCredentials LoginOutcome = "invalid_credentials"
+	LoginOutcomeRateLimited        LoginOutcome = "rate_limited"
+	LoginOutcomeLocked             LoginOutcome = "locked"
+	LoginOutcomeLockout            LoginOutcome = "lockout" // the attempt which locked the account
+)
+
+// LoginAudit records a rejected login attempt. UserID is nil for emails not registered.
+type LoginAudit struct {
+	ID        int
+	UserID    *int
+	Email     string
+	IP        string
+	UserAgent string
+	Outcome   LoginOutcome
+	CreatedAt time.Time
+}
+
+// LoginThrottledError rejects a login attempt of a locked account or from a client over its rate limit. Both cases
+// answer the same so the response does not tell whether the email is registered.
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return ErrTooManyLoginAttempts.Error()
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return ErrTooManyLoginAttempts
+}
+
+type UnlockAccountRequest struct {
+	Token string `form:"token" binding:"required"`
+}
@@ -0,0 +1,63 @@
+package model
+
+import "time"
+
+// UserMFA is the TOTP secret of a user. The enrollment is pending until a first code confirms the app holds the
+// secret, only then is the second factor asked at login.
+type UserMFA struct {
+	UserID       int
+	Secret       string
+	EnabledAt    *time.Time
+	LastUsedStep int64
+	CreatedAt    time.Time
+}
+
+func (m *UserMFA) Enabled() bool {
+	return m.EnabledAt != nil
+}
+
+type MFAEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // otpauth URI to render as a QR code
+}
+
+// MFAChallenge is returned by a login with valid credentials when a second factor is needed. EnrollmentRequired
+// is set when the role requires MFA but the user did not enroll yet, the enrollment is then done with the
+// challenge token before the login completes.
+type MFAChallenge struct {
+	Token              string
+	ExpiresAt          time.Time
+	EnrollmentRequired bool
+}
+
+type MFAChallengeResponse struct {
+	ChallengeToken     string    `json:"challenge_token"`
+	ExpiresAt          time.Time `json:"expires_at"`
+	EnrollmentRequired bool      `json:"enrollment_required"`
+}
+
+// MFALoginAttempt exchanges a challenge token and a code for the tokens of the login.
+type MFALoginAttempt struct {
+	ChallengeToken string
+	Code           string
+	IP             string
+	UserAgent      string
+}
+
+type MFALoginRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"` // TOTP code or recovery code
+}
+
+type MFAChallengeEnrollmentRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+}
+
+type MFACodeRequest struct {
+	Code   string `json:"code" binding:"required"`
+	UserID int    `json:"-"`
+}
+
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
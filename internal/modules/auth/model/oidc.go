@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// OIDCState is what the callback of a login with an identity provider needs from the request which started it,
+// it is kept server side under the random state sent to the provider.
+type OIDCState struct {
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// UserIdentity links the account of a user at an identity provider to the user.
+type UserIdentity struct {
+	Provider  string
+	Subject   string
+	UserID    int
+	Email     string
+	CreatedAt time.Time
+}
+
+type OIDCCallbackRequest struct {
+	Provider string `form:"-"`
+	Code     string `form:"code" binding:"required"`
+	State    string `form:"state" binding:"required"`
+}
@@ -0,0 +1,46 @@
+package model
+
+type Permission string
+
+const (
+	PermissionEventCreate    Permission = "event:create"
+	PermissionEventManageOwn Permission = "event:manage:own"
+	PermissionBookingRefund  Permission = "booking:refund"
+	PermissionCheckinScan    Permission = "checkin:scan"
+	PermissionWebhookManage  Permission = "webhook:manage"
+	// every role holds the permissions below, they only restrict the API keys to what their integration needs
+	PermissionEventRead         Permission = "event:read"
+	PermissionBookingManageOwn  Permission = "booking:manage:own"
+	PermissionCalendarManageOwn Permission = "calendar:manage:own"
+)
+
+var commonPermissions = []Permission{PermissionEventRead, PermissionBookingManageOwn, PermissionCalendarManageOwn}
+
+// rolePermissions is the permission set granted by each role, it is copied into the access tokens at issue time.
+var rolePermissions = map[UserRole][]Permission{
+	RoleUser:      commonPermissions,
+	RoleOrganizer: append([]Permission{PermissionEventCreate, PermissionEventManageOwn, PermissionCheckinScan, PermissionWebhookManage}, commonPermissions...),
+	RoleAdmin:     append([]Permission{PermissionEventCreate, PermissionEventManageOwn, PermissionBookingRefund, PermissionCheckinScan, PermissionWebhookManage}, commonPermissions...),
+}
+
+func (r UserRole) Valid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+func (r UserRole) Permissions() []Permission {
+	permissions := rolePermissions[r]
+	return append(make([]Permission, 0, len(permissions)), permissions...)
+}
+
+type AssignRoleRequest struct {
+	UserID     int
+	Role       UserRole `json:"role" binding:"required"`
+	ExecutorID int
+}
+
+type AssignRoleResponse struct {
+	UserID      int          `json:"user_id"`
+	Role        UserRole     `json:"role"`
+	Permissions []Permission `json:"permissions"`
+}
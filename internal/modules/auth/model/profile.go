@@ -0,0 +1,96 @@
+package model
+
+import (
+	"time"
+
+	commonmodel "booking-event/internal/common/model"
+)
+
+// birthdateLayout is the format of the birthdates in the requests and the answers.
+const birthdateLayout = "2006-01-02"
+
+// UserProfile is a user as shown to itself and to the admins, without its credentials.
+type UserProfile struct {
+	ID                 int        `json:"id"`
+	Email              string     `json:"email"`
+	PendingEmail       string     `json:"pending_email,omitempty"`
+	Role               UserRole   `json:"role"`
+	Status             UserStatus `json:"status"`
+	DisplayName        string     `json:"display_name"`
+	Phone              string     `json:"phone"`
+	Birthdate          string     `json:"birthdate,omitempty"`
+	Locale             string     `json:"locale"`
+	Timezone           string     `json:"timezone"`
+	MarketingConsent   bool       `json:"marketing_consent"`
+	MarketingConsentAt *time.Time `json:"marketing_consent_at,omitempty"`
+	VerifiedAt         *time.Time `json:"verified_at,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+}
+
+func NewUserProfile(user *User) UserProfile {
+	profile := UserProfile{
+		ID:                 user.ID,
+		Email:              user.Email,
+		PendingEmail:       user.PendingEmail,
+		Role:               user.Role,
+		Status:             user.Status,
+		DisplayName:        user.DisplayName,
+		Phone:              user.Phone,
+		Locale:             user.Locale,
+		Timezone:           user.Timezone,
+		MarketingConsent:   user.MarketingConsent,
+		MarketingConsentAt: user.MarketingConsentAt,
+		VerifiedAt:         user.VerifiedAt,
+		CreatedAt:          user.CreatedAt,
+	}
+	if user.Birthdate != nil {
+		profile.Birthdate = user.Birthdate.Format(birthdateLayout)
+	}
+	return profile
+}
+
+// UpdateProfileRequest changes the fields which are set, an empty phone or birthdate clears it.
+type UpdateProfileRequest struct {
+	UserID           int     `json:"-"`
+	DisplayName      *string `json:"display_name" binding:"omitempty,max=100"`
+	Phone            *string `json:"phone" binding:"omitempty,e164"`
+	Birthdate        *string `json:"birthdate" binding:"omitempty,datetime=2006-01-02"`
+	Locale           *string `json:"locale" binding:"omitempty,bcp47_language_tag"`
+	Timezone         *string `json:"timezone" binding:"omitempty,timezone"`
+	MarketingConsent *bool   `json:"marketing_consent"`
+}
+
+// ParseBirthdate reads a birthdate of the requests, an empty one is nil.
+func ParseBirthdate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	birthdate, err := time.Parse(birthdateLayout, value)
+	if err != nil {
+		return nil, ErrInvalidBirthdate
+	}
+	return &birthdate, nil
+}
+
+type ChangeEmailRequest struct {
+	UserID          int    `json:"-"`
+	Email           string `json:"email" binding:"required,email,max=255"`
+	CurrentPassword string `json:"current_password"` // required unless the user never set a password
+}
+
+type VerifyEmailChangeRequest struct {
+	Token string `form:"token" binding:"required"`
+}
+
+// ListUsersQuery searches the users by email or display name, newest first.
+type ListUsersQuery struct {
+	Search     string                 `form:"search" binding:"max=255"`
+	Role       UserRole               `form:"role" binding:"omitempty,oneof=admin organizer user"`
+	Status     UserStatus             `form:"status" binding:"omitempty,oneof=unverified active suspended"`
+	Pagination commonmodel.Pagination `form:"pagination"`
+}
+
+type SuspendUserRequest struct {
+	UserID     int
+	ExecutorID int
+}
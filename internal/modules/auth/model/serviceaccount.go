@@ -0,0 +1,72 @@
+package model
+
+import "time"
+
+// ServiceAccount is a machine client, like a reseller integration or a back-office script. It acts for its owner
+// with the scopes of the API key it calls with.
+type ServiceAccount struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	OwnerID   int       `json:"owner_id"`
+	CreatedBy int       `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// APIKey is a credential of a service account, only the hash of the key is stored and Prefix is kept to tell the
+// keys apart.
+type APIKey struct {
+	ID               int          `json:"id"`
+	ServiceAccountID int          `json:"service_account_id"`
+	KeyHash          string       `json:"-"`
+	Prefix           string       `json:"prefix"`
+	Scopes           []Permission `json:"scopes"`
+	ExpiresAt        *time.Time   `json:"expires_at"`
+	LastUsedAt       *time.Time   `json:"last_used_at"`
+	RevokedAt        *time.Time   `json:"revoked_at"`
+	CreatedAt        time.Time    `json:"created_at"`
+}
+
+// Usable tells whether the key is neither revoked nor expired.
+func (k *APIKey) Usable(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// APIKeyOwner is a key read together with the owner of its service account, to check a request in one query.
+type APIKeyOwner struct {
+	APIKey
+	OwnerID     int
+	OwnerRole   UserRole
+	OwnerStatus UserStatus
+}
+
+// APIKeyPrincipal is the caller authenticated by an API key. Its permissions are the scopes of the key which the
+// owner still holds.
+type APIKeyPrincipal struct {
+	ServiceAccountID int
+	APIKeyID         int
+	OwnerID          int
+	Permissions      []Permission
+}
+
+type CreateServiceAccountRequest struct {
+	Name       string `json:"name" binding:"required,max=255"`
+	OwnerID    int    `json:"owner_id" binding:"required"`
+	ExecutorID int    `json:"-"`
+}
+
+type CreateAPIKeyRequest struct {
+	ServiceAccountID int          `json:"-"`
+	Scopes           []Permission `json:"scopes"`
+	ExpiresAt        *time.Time   `json:"expires_at"`
+}
+
+type APIKeyRequest struct {
+	ServiceAccountID int
+	KeyID            int
+}
+
+// CreatedAPIKey is the only answer holding the key itself.
+type CreatedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
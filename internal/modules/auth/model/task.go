@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+type TaskType string
+
+const (
+	TaskTypeSendVerificationEmail  TaskType = "send_verification_email"
+	TaskTypeSendPasswordResetEmail TaskType = "send_password_reset_email"
+	TaskTypeSendAccountLockedEmail TaskType = "send_account_locked_email"
+	TaskTypeSendEmailChangeEmail   TaskType = "send_email_change_email"
+	// TaskTypeSendAlreadyRegisteredEmail tells the owner of an address that it was used to sign up again
+	TaskTypeSendAlreadyRegisteredEmail TaskType = "send_already_registered_email"
+)
+
+type SendVerificationEmailTask struct {
+	Email     string    `json:"email"`
+	Link      string    `json:"link"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type SendAlreadyRegisteredEmailTask struct {
+	Email    string `json:"email"`
+	Locale   string `json:"locale"`
+	Timezone string `json:"timezone"`
+}
+
+type SendPasswordResetEmailTask struct {
+	Email     string    `json:"email"`
+	Locale    string    `json:"locale"`
+	Timezone  string    `json:"timezone"`
+	Link      string    `json:"link"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type SendAccountLockedEmailTask struct {
+	Email       string    `json:"email"`
+	UnlockLink  string    `json:"unlock_link"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+type SendEmailChangeEmailTask struct {
+	Email     string    `json:"email"` // the new address, the link proves the user owns it
+	Link      string    `json:"link"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -6,16 +6,85 @@ import (
 	"github.com/golang-jwt/jwt/v4"
 )
 
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+	// TokenTypeMFAChallenge is only good for completing a login with a second factor
+	TokenTypeMFAChallenge TokenType = "mfa_challenge"
+)
+
 type TokenClaims struct {
-	UserID int      `json:"user_id"`
-	Email  string   `json:"email"`
-	Role   UserRole `json:"role"`
+	UserID      int          `json:"user_id"`
+	Email       string       `json:"email"`
+	Role        UserRole     `json:"role,omitempty"`  // only set on access tokens
+	Permissions []Permission `json:"perms,omitempty"` // only set on access tokens
+	Type        TokenType    `json:"typ"`
+	FamilyID    string       `json:"fid,omitempty"`        // only set on refresh tokens
+	MFAEnroll   bool         `json:"mfa_enroll,omitempty"` // only set on mfa challenge tokens
+	// IssuedAtMs repeats iat in milliseconds for the revocation cutoffs, iat only has the second. Only set on access
+	// tokens.
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// IssuedAtTime is the time the token was issued at, to the second for the tokens signed before iat_ms.
+func (c *TokenClaims) IssuedAtTime() time.Time {
+	if c.IssuedAtMs != 0 {
+		return time.UnixMilli(c.IssuedAtMs)
+	}
+	if c.IssuedAt != nil {
+		return c.IssuedAt.Time
+	}
+	return time.Time{}
+}
+
 type TokenPair struct {
 	AccessToken     string
 	RefreshToken    string
 	AccessTokenExp  time.Time
 	RefreshTokenExp time.Time
 }
+
+// RefreshToken is the server side record of an issued refresh token. Every refresh token can be used once, the
+// tokens rotated from the same login form a family which is revoked as a whole when one of them is replayed.
+type RefreshToken struct {
+	JTI        string
+	FamilyID   string
+	UserID     int
+	ExpiresAt  time.Time
+	UsedAt     *time.Time
+	RevokedAt  *time.Time
+	ReplacedBy string
+	CreatedAt  time.Time
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"` // optional, revokes the refresh tokens of the session too
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type TokenPairResponse struct {
+	AccessToken     string    `json:"access_token"`
+	RefreshToken    string    `json:"refresh_token"`
+	ExpAccessToken  time.Time `json:"exp_access_token"`
+	ExpRefreshToken time.Time `json:"exp_refresh_token"`
+}
+
+// TokenPurposeEmailVerification marks the tokens sent in the verification links.
+const TokenPurposeEmailVerification = "email_verification"
+
+// TokenPurposeEmailChange marks the tokens sent to confirm a new email address.
+const TokenPurposeEmailChange = "email_change"
+
+// EmailVerificationClaims are the claims of the signed token redeemed to verify an email address.
+type EmailVerificationClaims struct {
+	UserID  int    `json:"user_id"`
+	Email   string `json:"email"`
+	Purpose string `json:"purpose"`
+	jwt.RegisteredClaims
+}
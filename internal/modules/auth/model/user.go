@@ -5,30 +5,72 @@ import "time"
 type UserRole string
 
 const (
-	RoleAdmin UserRole = "admin"
-	RoleUser  UserRole = "user"
+	RoleAdmin     UserRole = "admin"
+	RoleOrganizer UserRole = "organizer"
+	RoleUser      UserRole = "user"
+)
+
+type UserStatus string
+
+const (
+	UserStatusUnverified UserStatus = "unverified"
+	UserStatusActive     UserStatus = "active"
+	UserStatusSuspended  UserStatus = "suspended"
 )
 
 type User struct {
-	ID             int       `json:"id"`
-	Email          string    `json:"email"`
-	Role           UserRole  `json:"role"`
-	HashedPassword string    `json:"hashed_password"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	ID             int        `json:"id"`
+	Email          string     `json:"email"`
+	Role           UserRole   `json:"role"`
+	Status         UserStatus `json:"status"`
+	HashedPassword string     `json:"hashed_password"`
+	VerifiedAt     *time.Time `json:"verified_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+
+	DisplayName        string     `json:"display_name"`
+	Phone              string     `json:"phone"`
+	Birthdate          *time.Time `json:"birthdate,omitempty"`
+	Locale             string     `json:"locale"`
+	Timezone           string     `json:"timezone"`
+	MarketingConsent   bool       `json:"marketing_consent"`
+	MarketingConsentAt *time.Time `json:"marketing_consent_at,omitempty"` // when the consent was last given or withdrawn
+	PendingEmail       string     `json:"pending_email,omitempty"`        // waiting for the verification of an email change
 }
 
 type LoginResponse struct {
-	Email           string    `json:"email"`
-	UserID          int       `json:"user_id"`
-	Role            string    `json:"role"`
-	AccessToken     string    `json:"access_token"`
-	RefreshToken    string    `json:"refresh_token"`
-	ExpAccessToken  time.Time `json:"exp_access_token"`
-	ExpRefreshToken time.Time `json:"exp_refresh_token"`
+	Email           string       `json:"email"`
+	UserID          int          `json:"user_id"`
+	Role            string       `json:"role"`
+	Permissions     []Permission `json:"permissions"`
+	AccessToken     string       `json:"access_token"`
+	RefreshToken    string       `json:"refresh_token"`
+	ExpAccessToken  time.Time    `json:"exp_access_token"`
+	ExpRefreshToken time.Time    `json:"exp_refresh_token"`
+	RecoveryCodes   []string     `json:"recovery_codes,omitempty"` // only set by the login enrolling MFA
 }
 
 type LoginRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
+
+type RegisterRequest struct {
+	Email    string `json:"email" binding:"required,email,max=255"`
+	Password string `json:"password" binding:"required,min=8,max=72"` // in characters, the services refuse more than 72 bytes
+}
+
+// RegisterResponse is the same whether the email was free or already registered, so signing up does not tell
+// which addresses have an account.
+type RegisterResponse struct {
+	Email  string     `json:"email"`
+	Status UserStatus `json:"status"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `form:"token" binding:"required"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
@@ -0,0 +1,80 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	"booking-event/internal/infra/emailsender"
+	"booking-event/internal/infra/emailtemplate"
+	"booking-event/internal/modules/auth/model"
+)
+
+type EmailClient struct {
+	emailsender.EmailService
+	renderer *emailtemplate.Renderer
+	from     string
+}
+
+func NewEmailClient(emailService emailsender.EmailService, renderer *emailtemplate.Renderer, from string) *EmailClient {
+	return &EmailClient{EmailService: emailService, renderer: renderer, from: from}
+}
+
+func (c *EmailClient) SendVerificationEmail(ctx context.Context, task model.SendVerificationEmailTask) error {
+	email := emailsender.Email{
+		To:      task.Email,
+		From:    c.from,
+		Subject: "Verify your email address",
+		Body:    fmt.Sprintf("Open the link below to activate your account, it expires at %s.\n%s", task.ExpiresAt.Format("2006-01-02 15:04 MST"), task.Link),
+	}
+	return c.EmailService.SendEmail(ctx, &email)
+}
+
+func (c *EmailClient) SendAlreadyRegisteredEmail(ctx context.Context, task model.SendAlreadyRegisteredEmailTask) error {
+	message, err := c.renderer.Render(emailtemplate.TemplateAlreadyRegistered, task.Locale, task.Timezone, task)
+	if err != nil {
+		return err
+	}
+	email := emailsender.Email{
+		To:       task.Email,
+		From:     c.from,
+		Subject:  message.Subject,
+		Body:     message.Text,
+		HTMLBody: message.HTML,
+	}
+	return c.EmailService.SendEmail(ctx, &email)
+}
+
+func (c *EmailClient) SendPasswordResetEmail(ctx context.Context, task model.SendPasswordResetEmailTask) error {
+	message, err := c.renderer.Render(emailtemplate.TemplatePasswordReset, task.Locale, task.Timezone, task)
+	if err != nil {
+		return err
+	}
+	email := emailsender.Email{
+		To:       task.Email,
+		From:     c.from,
+		Subject:  message.Subject,
+		Body:     message.Text,
+		HTMLBody: message.HTML,
+	}
+	return c.EmailService.SendEmail(ctx, &email)
+}
+
+func (c *EmailClient) SendAccountLockedEmail(ctx context.Context, task model.SendAccountLockedEmailTask) error {
+	email := emailsender.Email{
+		To:      task.Email,
+		From:    c.from,
+		Subject: "Your account was locked",
+		Body:    fmt.Sprintf("Your account was locked until %s after too many failed sign-in attempts. If it was you, open the link below to unlock it now, otherwise consider changing your password.\n%s", task.LockedUntil.Format("2006-01-02 15:04 MST"), task.UnlockLink),
+	}
+	return c.EmailService.SendEmail(ctx, &email)
+}
+
+func (c *EmailClient) SendEmailChangeEmail(ctx context.Context, task model.SendEmailChangeEmailTask) error {
+	email := emailsender.Email{
+		To:      task.Email,
+		From:    c.from,
+		Subject: "Confirm your new email address",
+		Body:    fmt.Sprintf("Open the link below to use this address for your account, it expires at %s. Ignore this email if you did not ask for it.\n%s", task.ExpiresAt.Format("2006-01-02 15:04 MST"), task.Link),
+	}
+	return c.EmailService.SendEmail(ctx, &email)
+}
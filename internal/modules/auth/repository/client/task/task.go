@@ -0,0 +1,60 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hibiken/asynq"
+
+	authasynq "booking-event/internal/infra/asynq"
+	"booking-event/internal/modules/auth/model"
+)
+
+// TaskClient enqueues the background tasks of the auth module handled by the worker.
+type TaskClient struct {
+	client authasynq.AsyncTaskEnqueueClient
+}
+
+func NewTaskClient(client authasynq.AsyncTaskEnqueueClient) *TaskClient {
+	return &TaskClient{client: client}
+}
+
+func (c *TaskClient) EnqueueVerificationEmail(ctx context.Context, task model.SendVerificationEmailTask) error {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return c.client.Enqueue(ctx, asynq.NewTask(string(model.TaskTypeSendVerificationEmail), payload))
+}
+
+func (c *TaskClient) EnqueueAlreadyRegisteredEmail(ctx context.Context, task model.SendAlreadyRegisteredEmailTask) error {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return c.client.Enqueue(ctx, asynq.NewTask(string(model.TaskTypeSendAlreadyRegisteredEmail), payload))
+}
+
+func (c *TaskClient) EnqueuePasswordResetEmail(ctx context.Context, task model.SendPasswordResetEmailTask) error {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return c.client.Enqueue(ctx, asynq.NewTask(string(model.TaskTypeSendPasswordResetEmail), payload))
+}
+
+func (c *TaskClient) EnqueueAccountLockedEmail(ctx context.Context, task model.SendAccountLockedEmailTask) error {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return c.client.Enqueue(ctx, asynq.NewTask(string(model.TaskTypeSendAccountLockedEmail), payload))
+}
+
+func (c *TaskClient) EnqueueEmailChangeEmail(ctx context.Context, task model.SendEmailChangeEmailTask) error {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return c.client.Enqueue(ctx, asynq.NewTask(string(model.TaskTypeSendEmailChangeEmail), payload))
+}
@@ -3,10 +3,33 @@ package entity
 import "booking-event/internal/modules/auth/model"
 
 func ConvertUserToModel(user User) *model.User {
-	return &model.User{
+	out := &model.User{
 		ID:             user.ID,
 		Email:          user.Email,
 		Role:           model.UserRole(user.Role),
+		Status:         model.UserStatus(user.Status),
 		HashedPassword: user.Password,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+
+		DisplayName:      user.DisplayName,
+		Phone:            user.Phone,
+		Locale:           user.Locale,
+		Timezone:         user.Timezone,
+		MarketingConsent: user.MarketingConsent,
+		PendingEmail:     user.PendingEmail.String,
+	}
+	if user.VerifiedAt.Valid {
+		verifiedAt := user.VerifiedAt.Time
+		out.VerifiedAt = &verifiedAt
+	}
+	if user.Birthdate.Valid {
+		birthdate := user.Birthdate.Time
+		out.Birthdate = &birthdate
+	}
+	if user.MarketingConsentAt.Valid {
+		marketingConsentAt := user.MarketingConsentAt.Time
+		out.MarketingConsentAt = &marketingConsentAt
 	}
+	return out
 }
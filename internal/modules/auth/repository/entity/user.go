@@ -1,12 +1,26 @@
 package entity
 
-import "time"
+import (
+	"database/sql"
+	"time"
+)
 
 type User struct {
-	ID        int       `db:"id"`
-	Email     string    `db:"email"`
-	Password  string    `db:"password"`
-	Role      string    `db:"role"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID         int          `db:"id"`
+	Email      string       `db:"email"`
+	Password   string       `db:"password"`
+	Role       string       `db:"role"`
+	Status     string       `db:"status"`
+	VerifiedAt sql.NullTime `db:"verified_at"`
+	CreatedAt  time.Time    `db:"created_at"`
+	UpdatedAt  time.Time    `db:"updated_at"`
+
+	DisplayName        string         `db:"display_name"`
+	Phone              string         `db:"phone"`
+	Birthdate          sql.NullTime   `db:"birthdate"`
+	Locale             string         `db:"locale"`
+	Timezone           string         `db:"timezone"`
+	MarketingConsent   bool           `db:"marketing_consent"`
+	MarketingConsentAt sql.NullTime   `db:"marketing_consent_at"`
+	PendingEmail       sql.NullString `db:"pending_email"`
 }
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type IdentityRepository struct {
+	db *sqlx.DB
+}
+
+func NewIdentityRepository(db *sqlx.DB) *IdentityRepository {
+	return &IdentityRepository{db: db}
+}
+
+func (r *IdentityRepository) GetUserIDByIdentity(ctx context.Context, provider string, subject string) (int, error) {
+	var userID int
+	err := r.db.QueryRowxContext(ctx, "SELECT user_id FROM user_identities WHERE provider = $1 AND subject = $2", provider, subject).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return 0, model.ErrIdentityNotFound
+	}
+	return userID, err
+}
+
+// LinkIdentity links the identity to an existing user. The provider verified the email, so an account still
+// waiting for its email verification is activated and its password dropped: it may have been registered by
+// someone else who never owned the email.
+func (r *IdentityRepository) LinkIdentity(ctx context.Context, identity *model.UserIdentity) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRowxContext(ctx, "INSERT INTO user_identities (provider, subject, user_id, email) VALUES ($1, $2, $3, $4) RETURNING created_at",
+		identity.Provider, identity.Subject, identity.UserID, identity.Email).Scan(&identity.CreatedAt)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE users SET status = $1, verified_at = CURRENT_TIMESTAMP, password = '', updated_at = CURRENT_TIMESTAMP WHERE id = $2 AND status = $3",
+		string(model.UserStatusActive), identity.UserID, string(model.UserStatusUnverified)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// CreateUserWithIdentity creates a verified user without password together with its identity, the user can set
+// a password later with the forgot password flow.
+func (r *IdentityRepository) CreateUserWithIdentity(ctx context.Context, user *model.User, identity *model.UserIdentity) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRowxContext(ctx, "INSERT INTO users (email, password, role, status, verified_at) VALUES ($1, '', $2, $3, CURRENT_TIMESTAMP) RETURNING id, verified_at, created_at, updated_at",
+		user.Email, string(user.Role), string(user.Status)).Scan(&user.ID, &user.VerifiedAt, &user.CreatedAt, &user.UpdatedAt)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		_ = tx.Rollback()
+		return model.ErrEmailAlreadyRegistered
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	identity.UserID = user.ID
+	err = tx.QueryRowxContext(ctx, "INSERT INTO user_identities (provider, subject, user_id, email) VALUES ($1, $2, $3, $4) RETURNING created_at",
+		identity.Provider, identity.Subject, identity.UserID, identity.Email).Scan(&identity.CreatedAt)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
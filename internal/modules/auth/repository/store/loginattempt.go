@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"booking-event/internal/infra/redis"
+	"booking-event/internal/modules/auth/model"
+)
+
+// LoginAttemptRepository keeps the login rate limits and account lockouts in redis. The limits are sliding
+// windows stored as sorted sets scored by the attempt time.
+type LoginAttemptRepository struct {
+	redis redis.Redis
+}
+
+func NewLoginAttemptRepository(redis redis.Redis) *LoginAttemptRepository {
+	return &LoginAttemptRepository{redis: redis}
+}
+
+func ipAttemptsKey(ip string) string {
+	return fmt.Sprintf("login_attempts:ip:%s", ip)
+}
+
+func accountFailuresKey(email string) string {
+	return fmt.Sprintf("login_failures:account:%s", email)
+}
+
+func accountLockedKey(email string) string {
+	return fmt.Sprintf("login_locked:%s", email)
+}
+
+func lockoutLevelKey(email string) string {
+	return fmt.Sprintf("login_lockout_level:%s", email)
+}
+
+func unlockTokenKey(tokenHash string) string {
+	return fmt.Sprintf("login_unlock:%s", tokenHash)
+}
+
+// RecordIPAttempt adds an attempt to the window of the IP and returns the number of attempts in the window.
+func (r *LoginAttemptRepository) RecordIPAttempt(ctx context.Context, ip string, at time.Time, window time.Duration) (int64, error) {
+	return r.recordInWindow(ctx, ipAttemptsKey(ip), at, window)
+}
+
+// RecordAccountFailure adds a failed attempt to the window of the account and returns the failures in the window.
+func (r *LoginAttemptRepository) RecordAccountFailure(ctx context.Context, email string, at time.Time, window time.Duration) (int64, error) {
+	return r.recordInWindow(ctx, accountFailuresKey(email), at, window)
+}
+
+func (r *LoginAttemptRepository) recordInWindow(ctx context.Context, key string, at time.Time, window time.Duration) (int64, error) {
+	key = r.redis.AppendPrefix(key)
+	pipe := r.redis.Pipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(at.Add(-window).UnixNano(), 10))
+	pipe.ZAdd(ctx, key, goredis.Z{Score: float64(at.UnixNano()), Member: at.UnixNano()})
+	count := pipe.ZCard(ctx, key)
+	pipe.Expire(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return count.Val(), nil
+}
+
+// IncrementLockoutLevel counts a new lockout of the account, it returns how many lockouts happened within levelTTL
+// the first one being 1. The failures counted so far are reset for the next window.
+func (r *LoginAttemptRepository) IncrementLockoutLevel(ctx context.Context, email string, levelTTL time.Duration) (int64, error) {
+	levelKey := r.redis.AppendPrefix(lockoutLevelKey(email))
+	pipe := r.redis.Pipeline()
+	level := pipe.Incr(ctx, levelKey)
+	pipe.Expire(ctx, levelKey, levelTTL)
+	pipe.Del(ctx, r.redis.AppendPrefix(accountFailuresKey(email)))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return level.Val(), nil
+}
+
+func (r *LoginAttemptRepository) LockAccount(ctx context.Context, email string, until time.Time, ttl time.Duration) error {
+	_, err := r.redis.Set(ctx, accountLockedKey(email), until.Unix(), ttl)
+	return err
+}
+
+// GetAccountLockedUntil returns the end of the lockout of the account, the zero time when it is not locked.
+func (r *LoginAttemptRepository) GetAccountLockedUntil(ctx context.Context, email string) (time.Time, error) {
+	value, err := r.redis.Get(ctx, accountLockedKey(email))
+	if errors.Is(err, goredis.Nil) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	until, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(until, 0), nil
+}
+
+// ClearAccountFailures forgets the failures and the lockout level of the account after a successful login.
+func (r *LoginAttemptRepository) ClearAccountFailures(ctx context.Context, email string) error {
+	_, err := r.redis.Del(ctx, accountFailuresKey(email), lockoutLevelKey(email))
+	return err
+}
+
+func (r *LoginAttemptRepository) UnlockAccount(ctx context.Context, email string) error {
+	_, err := r.redis.Del(ctx, accountLockedKey(email), accountFailuresKey(email), lockoutLevelKey(email))
+	return err
+}
+
+func (r *LoginAttemptRepository) SaveUnlockToken(ctx context.Context, tokenHash string, email string, ttl time.Duration) error {
+	_, err := r.redis.Set(ctx, unlockTokenKey(tokenHash), email, ttl)
+	return err
+}
+
+// ConsumeUnlockToken returns the email of the account the token unlocks, the token can only be used once.
+func (r *LoginAttemptRepository) ConsumeUnlockToken(ctx context.Context, tokenHash string) (string, error) {
+	pipe := r.redis.Pipeline()
+	email := pipe.GetDel(ctx, r.redis.AppendPrefix(unlockTokenKey(tokenHash)))
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, goredis.Nil) {
+		return "", err
+	}
+	if email.Err() != nil || email.Val() == "" {
+		return "", model.ErrInvalidUnlockToken
+	}
+	return email.Val(), nil
+}
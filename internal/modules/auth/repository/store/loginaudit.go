@@ -0,0 +1,22 @@
+package store
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type LoginAuditRepository struct {
+	db *sqlx.DB
+}
+
+func NewLoginAuditRepository(db *sqlx.DB) *LoginAuditRepository {
+	return &LoginAuditRepository{db: db}
+}
+
+func (r *LoginAuditRepository) CreateLoginAudit(ctx context.Context, audit *model.LoginAudit) error {
+	return r.db.QueryRowxContext(ctx, "INSERT INTO login_audit (user_id, email, ip, user_agent, outcome) VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at",
+		audit.UserID, audit.Email, audit.IP, audit.UserAgent, string(audit.Outcome)).Scan(&audit.ID, &audit.CreatedAt)
+}
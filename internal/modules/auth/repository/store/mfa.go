@@ -0,0 +1,133 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type MFARepository struct {
+	db *sqlx.DB
+}
+
+func NewMFARepository(db *sqlx.DB) *MFARepository {
+	return &MFARepository{db: db}
+}
+
+func (r *MFARepository) GetUserMFA(ctx context.Context, userID int) (*model.UserMFA, error) {
+	var mfa model.UserMFA
+	err := r.db.QueryRowxContext(ctx, "SELECT user_id, secret, enabled_at, last_used_step, created_at FROM user_mfa WHERE user_id = $1", userID).
+		Scan(&mfa.UserID, &mfa.Secret, &mfa.EnabledAt, &mfa.LastUsedStep, &mfa.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, model.ErrMFANotEnrolled
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &mfa, nil
+}
+
+// SavePendingMFA starts an enrollment, a pending one is replaced but an enabled one is kept.
+func (r *MFARepository) SavePendingMFA(ctx context.Context, userID int, secret string) error {
+	result, err := r.db.ExecContext(ctx, `INSERT INTO user_mfa (user_id, secret) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, last_used_step = 0, created_at = CURRENT_TIMESTAMP
+		WHERE user_mfa.enabled_at IS NULL`, userID, secret)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrMFAAlreadyEnabled
+	}
+	return nil
+}
+
+// EnableMFA confirms a pending enrollment with the step of its first code and replaces the recovery codes.
+func (r *MFARepository) EnableMFA(ctx context.Context, userID int, step int64, recoveryCodeHashes []string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, "UPDATE user_mfa SET enabled_at = CURRENT_TIMESTAMP, last_used_step = $2 WHERE user_id = $1 AND enabled_at IS NULL AND last_used_step < $2",
+		userID, step)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if rows == 0 {
+		_ = tx.Rollback()
+		return model.ErrInvalidMFACode
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM mfa_recovery_codes WHERE user_id = $1", userID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	for _, codeHash := range recoveryCodeHashes {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO mfa_recovery_codes (code_hash, user_id) VALUES ($1, $2)", codeHash, userID); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// UseTOTPStep records the step of an accepted code, it fails when that step or a later one was already used so
+// concurrent logins can not share a code.
+func (r *MFARepository) UseTOTPStep(ctx context.Context, userID int, step int64) error {
+	result, err := r.db.ExecContext(ctx, "UPDATE user_mfa SET last_used_step = $2 WHERE user_id = $1 AND enabled_at IS NOT NULL AND last_used_step < $2",
+		userID, step)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrInvalidMFACode
+	}
+	return nil
+}
+
+func (r *MFARepository) UseRecoveryCode(ctx context.Context, userID int, codeHash string) error {
+	result, err := r.db.ExecContext(ctx, "UPDATE mfa_recovery_codes SET used_at = CURRENT_TIMESTAMP WHERE code_hash = $1 AND user_id = $2 AND used_at IS NULL",
+		codeHash, userID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrInvalidMFACode
+	}
+	return nil
+}
+
+func (r *MFARepository) DeleteMFA(ctx context.Context, userID int) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM mfa_recovery_codes WHERE user_id = $1", userID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_mfa WHERE user_id = $1", userID); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"booking-event/internal/infra/redis"
+	"booking-event/internal/modules/auth/model"
+)
+
+// OIDCStateRepository keeps the pending logins with identity providers in redis until their callback.
+type OIDCStateRepository struct {
+	redis redis.Redis
+}
+
+func NewOIDCStateRepository(redis redis.Redis) *OIDCStateRepository {
+	return &OIDCStateRepository{redis: redis}
+}
+
+func oidcStateKey(state string) string {
+	return fmt.Sprintf("oidc_state:%s", state)
+}
+
+func (r *OIDCStateRepository) SaveOIDCState(ctx context.Context, state string, pending model.OIDCState, ttl time.Duration) error {
+	value, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+	_, err = r.redis.Set(ctx, oidcStateKey(state), string(value), ttl)
+	return err
+}
+
+// ConsumeOIDCState returns the pending login of the state, a state can only be used once.
+func (r *OIDCStateRepository) ConsumeOIDCState(ctx context.Context, state string) (*model.OIDCState, error) {
+	pipe := r.redis.Pipeline()
+	value := pipe.GetDel(ctx, r.redis.AppendPrefix(oidcStateKey(state)))
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, goredis.Nil) {
+		return nil, err
+	}
+	if value.Err() != nil || value.Val() == "" {
+		return nil, model.ErrInvalidOIDCState
+	}
+	var pending model.OIDCState
+	if err := json.Unmarshal([]byte(value.Val()), &pending); err != nil {
+		return nil, err
+	}
+	return &pending, nil
+}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type PasswordResetRepository struct {
+	db *sqlx.DB
+}
+
+func NewPasswordResetRepository(db *sqlx.DB) *PasswordResetRepository {
+	return &PasswordResetRepository{db: db}
+}
+
+func (r *PasswordResetRepository) CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error {
+	return r.db.QueryRowxContext(ctx, "INSERT INTO password_reset_tokens (token_hash, user_id, expires_at) VALUES ($1, $2, $3) RETURNING created_at",
+		token.TokenHash, token.UserID, token.ExpiresAt).Scan(&token.CreatedAt)
+}
+
+// ResetPassword redeems the token and sets the new password in one transaction, the other pending tokens of the
+// user are burnt too. Only one of concurrent calls with the same token can succeed.
+func (r *PasswordResetRepository) ResetPassword(ctx context.Context, tokenHash string, hashedPassword string) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var userID int
+	err = tx.QueryRowxContext(ctx, "UPDATE password_reset_tokens SET used_at = CURRENT_TIMESTAMP WHERE token_hash = $1 AND used_at IS NULL AND expires_at > CURRENT_TIMESTAMP RETURNING user_id",
+		tokenHash).Scan(&userID)
+	if err == sql.ErrNoRows {
+		_ = tx.Rollback()
+		return 0, model.ErrInvalidResetToken
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE users SET password = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2", hashedPassword, userID); err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE password_reset_tokens SET used_at = CURRENT_TIMESTAMP WHERE user_id = $1 AND used_at IS NULL", userID); err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	return userID, tx.Commit()
+}
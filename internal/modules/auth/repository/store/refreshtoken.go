@@ -0,0 +1,80 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type RefreshTokenRepository struct {
+	db *sqlx.DB
+}
+
+func NewRefreshTokenRepository(db *sqlx.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+func (r *RefreshTokenRepository) CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error {
+	return r.db.QueryRowxContext(ctx, "INSERT INTO refresh_tokens (jti, family_id, user_id, expires_at) VALUES ($1, $2, $3, $4) RETURNING created_at",
+		token.JTI, token.FamilyID, token.UserID, token.ExpiresAt).Scan(&token.CreatedAt)
+}
+
+// UseRefreshToken marks the token as used and stores the token replacing it, in one transaction. Only one of
+// concurrent calls with the same token can succeed, the others get ErrRefreshTokenReused.
+func (r *RefreshTokenRepository) UseRefreshToken(ctx context.Context, jti string, next *model.RefreshToken) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, "UPDATE refresh_tokens SET used_at = CURRENT_TIMESTAMP, replaced_by = $1 WHERE jti = $2 AND used_at IS NULL AND revoked_at IS NULL AND expires_at > CURRENT_TIMESTAMP",
+		next.JTI, jti)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if affected == 0 {
+		_ = tx.Rollback()
+		return r.diagnoseUnusableToken(ctx, jti)
+	}
+	err = tx.QueryRowxContext(ctx, "INSERT INTO refresh_tokens (jti, family_id, user_id, expires_at) VALUES ($1, $2, $3, $4) RETURNING created_at",
+		next.JTI, next.FamilyID, next.UserID, next.ExpiresAt).Scan(&next.CreatedAt)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// diagnoseUnusableToken tells a replayed token from an unknown or expired one.
+func (r *RefreshTokenRepository) diagnoseUnusableToken(ctx context.Context, jti string) error {
+	var usedAt, revokedAt sql.NullTime
+	err := r.db.QueryRowxContext(ctx, "SELECT used_at, revoked_at FROM refresh_tokens WHERE jti = $1", jti).Scan(&usedAt, &revokedAt)
+	if err == sql.ErrNoRows {
+		return model.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return err
+	}
+	if usedAt.Valid || revokedAt.Valid {
+		return model.ErrRefreshTokenReused
+	}
+	return model.ErrInvalidRefreshToken
+}
+
+func (r *RefreshTokenRepository) RevokeTokenFamily(ctx context.Context, familyID string) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE refresh_tokens SET revoked_at = CURRENT_TIMESTAMP WHERE family_id = $1 AND revoked_at IS NULL", familyID)
+	return err
+}
+
+func (r *RefreshTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID int) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE refresh_tokens SET revoked_at = CURRENT_TIMESTAMP WHERE user_id = $1 AND revoked_at IS NULL", userID)
+	return err
+}
@@ -1,13 +1,25 @@
 package store
 
 import (
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
 	"booking-event/internal/modules/auth/model"
 	"booking-event/internal/modules/auth/repository/entity"
 	"context"
+	"database/sql"
+	"errors"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
+// uniqueViolation is the postgres error code of a unique constraint violation.
+const uniqueViolation = "23505"
+
+const userColumns = "id, email, password, role, status, verified_at, created_at, updated_at, " +
+	"display_name, phone, birthdate, locale, timezone, marketing_consent, marketing_consent_at, pending_email"
+
 type UserRepository struct {
 	db sqlx.ExtContext
 }
@@ -18,9 +30,228 @@ func NewUserRepository(db sqlx.ExtContext) *UserRepository {
 
 func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
 	var user entity.User
-	if err := r.db.QueryRowxContext(ctx, "SELECT id, email, password, role FROM users WHERE email = $1", email).StructScan(&user); err != nil {
+	if err := r.db.QueryRowxContext(ctx, "SELECT "+userColumns+" FROM users WHERE email = $1", email).StructScan(&user); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, model.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return entity.ConvertUserToModel(user), nil
+}
+
+func (r *UserRepository) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	var user entity.User
+	if err := r.db.QueryRowxContext(ctx, "SELECT "+userColumns+" FROM users WHERE id = $1", id).StructScan(&user); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, model.ErrUserNotFound
+		}
 		return nil, err
 	}
 
 	return entity.ConvertUserToModel(user), nil
 }
+
+func (r *UserRepository) CreateUser(ctx context.Context, user *model.User) error {
+	err := r.db.QueryRowxContext(ctx, "INSERT INTO users (email, password, role, status) VALUES ($1, $2, $3, $4) RETURNING id, created_at, updated_at",
+		user.Email, user.HashedPassword, string(user.Role), string(user.Status)).Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return model.ErrEmailAlreadyRegistered
+	}
+	return err
+}
+
+// ActivateUser marks an unverified user as verified, it is a no-op for users already active.
+func (r *UserRepository) ActivateUser(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE users SET status = $1, verified_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP WHERE id = $2 AND status = $3",
+		string(model.UserStatusActive), id, string(model.UserStatusUnverified))
+	return err
+}
+
+func (r *UserRepository) UpdateUserRole(ctx context.Context, id int, role model.UserRole) error {
+	result, err := r.db.ExecContext(ctx, "UPDATE users SET role = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2", string(role), id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UserRepository) UpdatePassword(ctx context.Context, id int, hashedPassword string) error {
+	result, err := r.db.ExecContext(ctx, "UPDATE users SET password = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2", hashedPassword, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
+// UpdateProfile saves the profile fields of the user.
+func (r *UserRepository) UpdateProfile(ctx context.Context, user *model.User) error {
+	err := r.db.QueryRowxContext(ctx, `UPDATE users SET display_name = $2, phone = $3, birthdate = $4, locale = $5, timezone = $6,
+		marketing_consent = $7, marketing_consent_at = $8, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 RETURNING updated_at`,
+		user.ID, user.DisplayName, user.Phone, user.Birthdate, user.Locale, user.Timezone, user.MarketingConsent, user.MarketingConsentAt).Scan(&user.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return model.ErrUserNotFound
+	}
+	return err
+}
+
+// SetPendingEmail records the address the user asked to move to, it replaces a previous pending one.
+func (r *UserRepository) SetPendingEmail(ctx context.Context, id int, email string) error {
+	result, err := r.db.ExecContext(ctx, "UPDATE users SET pending_email = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2", email, id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
+// ConfirmEmailChange moves the user to its pending email when it is still the given one, the address may have
+// been registered by someone else in the meantime.
+func (r *UserRepository) ConfirmEmailChange(ctx context.Context, id int, email string) error {
+	result, err := r.db.ExecContext(ctx, "UPDATE users SET email = pending_email, pending_email = NULL, updated_at = CURRENT_TIMESTAMP WHERE id = $1 AND pending_email = $2",
+		id, email)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return model.ErrEmailAlreadyRegistered
+	}
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrInvalidEmailChange
+	}
+	return nil
+}
+
+func (r *UserRepository) ListUsers(ctx context.Context, query model.ListUsersQuery) (*commonmodel.Page[model.User], error) {
+	cursor, err := query.Pagination.GetCursor()
+	if err != nil {
+		return nil, err
+	}
+
+	queryString := "SELECT " + userColumns + " FROM users WHERE TRUE"
+	if query.Search != "" {
+		queryString += " AND (email ILIKE :search OR display_name ILIKE :search)"
+	}
+	if query.Role != "" {
+		queryString += " AND role = :role"
+	}
+	if query.Status != "" {
+		queryString += " AND status = :status"
+	}
+
+	limit := query.Pagination.GetLimit()
+	args := map[string]interface{}{
+		"search": "%" + escapeLike(query.Search) + "%",
+		"role":   string(query.Role),
+		"status": string(query.Status),
+		"limit":  limit,
+		"offset": query.Pagination.GetOffset(),
+	}
+
+	useCursor := query.Pagination.UseCursor()
+	backward := cursor != nil && cursor.Backward
+	if cursor != nil {
+		if cursor.Time == nil {
+			return nil, commonmodel.ErrInvalidCursor
+		}
+		args["cursor_value"] = *cursor.Time
+		args["cursor_id"] = cursor.ID
+		if backward {
+			queryString += " AND (created_at, id) > (:cursor_value, :cursor_id)"
+		} else {
+			queryString += " AND (created_at, id) < (:cursor_value, :cursor_id)"
+		}
+	}
+	if backward {
+		queryString += " ORDER BY created_at ASC, id ASC"
+	} else {
+		queryString += " ORDER BY created_at DESC, id DESC"
+	}
+	if useCursor {
+		args["limit"] = limit + 1
+		queryString += " LIMIT :limit"
+	} else {
+		queryString += " LIMIT :limit OFFSET :offset"
+	}
+
+	queryString, namedArgs, err := r.db.BindNamed(queryString, args)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryxContext(ctx, queryString, namedArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	users := []model.User{}
+	for rows.Next() {
+		var user entity.User
+		if err := rows.StructScan(&user); err != nil {
+			return nil, err
+		}
+		users = append(users, *entity.ConvertUserToModel(user))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if !useCursor {
+		return &commonmodel.Page[model.User]{Items: users}, nil
+	}
+	return commonmodel.NewCursorPage(users, limit, cursor, func(user model.User) commonmodel.Cursor {
+		return commonmodel.Cursor{Time: util.ToPtr(user.CreatedAt), ID: user.ID}
+	}), nil
+}
+
+// UpdateUserStatus moves the user from one of the given statuses to status.
+func (r *UserRepository) UpdateUserStatus(ctx context.Context, id int, status model.UserStatus, from ...model.UserStatus) error {
+	fromStatuses := make([]string, 0, len(from))
+	for _, s := range from {
+		fromStatuses = append(fromStatuses, string(s))
+	}
+	result, err := r.db.ExecContext(ctx, "UPDATE users SET status = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2 AND status = ANY($3)",
+		string(status), id, pq.Array(fromStatuses))
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return model.ErrUserNotFound
+	}
+	return nil
+}
+
+// escapeLike escapes the wildcards of a user input matched with LIKE.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"booking-event/internal/infra/redis"
+)
+
+// fakeRedis keeps the values as strings like redis does, only the calls of the revocation repository are implemented.
+type fakeRedis struct {
+	redis.Redis
+	values map[string]string
+}
+
+func (f *fakeRedis) Set(_ context.Context, key string, value interface{}, _ time.Duration) (string, error) {
+	f.values[key] = fmt.Sprint(value)
+	return "OK", nil
+}
+
+func (f *fakeRedis) MGet(_ context.Context, keys ...string) ([]interface{}, error) {
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		if value, ok := f.values[key]; ok {
+			values[i] = value
+		}
+	}
+	return values, nil
+}
+
+func TestRevocationRepository_IsTokenRevoked_SameSecondAsCutoff(t *testing.T) {
+	t.Parallel()
+	cutoff := time.Date(2026, 10, 1, 10, 0, 0, 400*int(time.Millisecond), time.UTC)
+
+	tests := []struct {
+		name     string
+		issuedAt time.Time
+		expected bool
+	}{
+		{name: "Issued earlier in the second of the cutoff", issuedAt: cutoff.Add(-300 * time.Millisecond), expected: true},
+		{name: "Issued with the cutoff", issuedAt: cutoff, expected: true},
+		{name: "Issued later in the second of the cutoff", issuedAt: cutoff.Add(300 * time.Millisecond), expected: false},
+		{name: "Issued after the cutoff", issuedAt: cutoff.Add(time.Minute), expected: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := NewRevocationRepository(&fakeRedis{values: map[string]string{}})
+			assert.NoError(t, repo.RevokeUserTokensBefore(context.Background(), 1, cutoff, time.Hour))
+
+			revoked, err := repo.IsTokenRevoked(context.Background(), "access-1", 1, tt.issuedAt)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, revoked)
+		})
+	}
+}
+
+func TestRevocationRepository_IsTokenRevoked_LegacyCutoff(t *testing.T) {
+	t.Parallel()
+	cutoff := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	repo := NewRevocationRepository(&fakeRedis{values: map[string]string{
+		legacyRevokedBeforeKey(1): fmt.Sprint(cutoff.Unix()),
+	}})
+
+	revoked, err := repo.IsTokenRevoked(context.Background(), "access-1", 1, cutoff.Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+
+	revoked, err = repo.IsTokenRevoked(context.Background(), "access-1", 1, cutoff.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+}
@@ -0,0 +1,203 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+const apiKeyColumns = "id, service_account_id, key_hash, prefix, scopes, expires_at, last_used_at, revoked_at, created_at"
+
+type ServiceAccountRepository struct {
+	db *sqlx.DB
+}
+
+func NewServiceAccountRepository(db *sqlx.DB) *ServiceAccountRepository {
+	return &ServiceAccountRepository{db: db}
+}
+
+func (r *ServiceAccountRepository) CreateServiceAccount(ctx context.Context, account *model.ServiceAccount) error {
+	return r.db.QueryRowxContext(ctx, "INSERT INTO service_accounts (name, owner_id, created_by) VALUES ($1, $2, $3) RETURNING id, created_at",
+		account.Name, account.OwnerID, account.CreatedBy).Scan(&account.ID, &account.CreatedAt)
+}
+
+func (r *ServiceAccountRepository) GetServiceAccount(ctx context.Context, id int) (*model.ServiceAccount, error) {
+	var account model.ServiceAccount
+	err := r.db.QueryRowxContext(ctx, "SELECT id, name, owner_id, created_by, created_at FROM service_accounts WHERE id = $1", id).
+		Scan(&account.ID, &account.Name, &account.OwnerID, &account.CreatedBy, &account.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, model.ErrServiceAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+func (r *ServiceAccountRepository) ListServiceAccounts(ctx context.Context) ([]model.ServiceAccount, error) {
+	rows, err := r.db.QueryxContext(ctx, "SELECT id, name, owner_id, created_by, created_at FROM service_accounts ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	accounts := make([]model.ServiceAccount, 0)
+	for rows.Next() {
+		var account model.ServiceAccount
+		if err := rows.Scan(&account.ID, &account.Name, &account.OwnerID, &account.CreatedBy, &account.CreatedAt); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, rows.Err()
+}
+
+func (r *ServiceAccountRepository) CreateAPIKey(ctx context.Context, key *model.APIKey) error {
+	return insertAPIKey(ctx, r.db, key)
+}
+
+func (r *ServiceAccountRepository) GetAPIKey(ctx context.Context, serviceAccountID int, keyID int) (*model.APIKey, error) {
+	key, err := scanAPIKey(r.db.QueryRowxContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE id = $1 AND service_account_id = $2", keyID, serviceAccountID))
+	if err == sql.ErrNoRows {
+		return nil, model.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (r *ServiceAccountRepository) ListAPIKeys(ctx context.Context, serviceAccountID int) ([]model.APIKey, error) {
+	rows, err := r.db.QueryxContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE service_account_id = $1 ORDER BY id", serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	keys := make([]model.APIKey, 0)
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+	return keys, rows.Err()
+}
+
+// GetAPIKeyOwnerByHash reads the key of a request with the owner of its service account.
+func (r *ServiceAccountRepository) GetAPIKeyOwnerByHash(ctx context.Context, keyHash string) (*model.APIKeyOwner, error) {
+	var (
+		owner  model.APIKeyOwner
+		scopes []string
+		role   string
+		status string
+	)
+	err := r.db.QueryRowxContext(ctx, `SELECT k.id, k.service_account_id, k.key_hash, k.prefix, k.scopes, k.expires_at, k.last_used_at, k.revoked_at, k.created_at,
+		u.id, u.role, u.status
+		FROM api_keys k
+		JOIN service_accounts sa ON sa.id = k.service_account_id
+		JOIN users u ON u.id = sa.owner_id
+		WHERE k.key_hash = $1`, keyHash).
+		Scan(&owner.ID, &owner.ServiceAccountID, &owner.KeyHash, &owner.Prefix, pq.Array(&scopes), &owner.ExpiresAt, &owner.LastUsedAt, &owner.RevokedAt, &owner.CreatedAt,
+			&owner.OwnerID, &role, &status)
+	if err == sql.ErrNoRows {
+		return nil, model.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	owner.Scopes = toPermissions(scopes)
+	owner.OwnerRole = model.UserRole(role)
+	owner.OwnerStatus = model.UserStatus(status)
+	return &owner, nil
+}
+
+// TouchAPIKey records the use of a key, at most once per interval so a busy integration does not write on every
+// request.
+func (r *ServiceAccountRepository) TouchAPIKey(ctx context.Context, keyID int, usedAt time.Time, interval time.Duration) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE api_keys SET last_used_at = $2 WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $3)",
+		keyID, usedAt, usedAt.Add(-interval))
+	return err
+}
+
+// RotateAPIKey creates the replacing key and shortens the life of the old one to the grace period, so the
+// integration can switch without downtime.
+func (r *ServiceAccountRepository) RotateAPIKey(ctx context.Context, oldKeyID int, graceEnd time.Time, key *model.APIKey) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, "UPDATE api_keys SET expires_at = LEAST(COALESCE(expires_at, $3), $3) WHERE id = $1 AND service_account_id = $2 AND revoked_at IS NULL",
+		oldKeyID, key.ServiceAccountID, graceEnd)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if rows == 0 {
+		_ = tx.Rollback()
+		return model.ErrAPIKeyNotFound
+	}
+	if err := insertAPIKey(ctx, tx, key); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *ServiceAccountRepository) RevokeAPIKey(ctx context.Context, serviceAccountID int, keyID int) error {
+	result, err := r.db.ExecContext(ctx, "UPDATE api_keys SET revoked_at = CURRENT_TIMESTAMP WHERE id = $1 AND service_account_id = $2 AND revoked_at IS NULL",
+		keyID, serviceAccountID)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return model.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func insertAPIKey(ctx context.Context, db sqlx.QueryerContext, key *model.APIKey) error {
+	return db.QueryRowxContext(ctx, "INSERT INTO api_keys (service_account_id, key_hash, prefix, scopes, expires_at) VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at",
+		key.ServiceAccountID, key.KeyHash, key.Prefix, pq.Array(fromPermissions(key.Scopes)), key.ExpiresAt).Scan(&key.ID, &key.CreatedAt)
+}
+
+func scanAPIKey(row sqlx.ColScanner) (*model.APIKey, error) {
+	var (
+		key    model.APIKey
+		scopes []string
+	)
+	if err := row.Scan(&key.ID, &key.ServiceAccountID, &key.KeyHash, &key.Prefix, pq.Array(&scopes), &key.ExpiresAt, &key.LastUsedAt, &key.RevokedAt, &key.CreatedAt); err != nil {
+		return nil, err
+	}
+	key.Scopes = toPermissions(scopes)
+	return &key, nil
+}
+
+func toPermissions(scopes []string) []model.Permission {
+	permissions := make([]model.Permission, 0, len(scopes))
+	for _, scope := range scopes {
+		permissions = append(permissions, model.Permission(scope))
+	}
+	return permissions
+}
+
+func fromPermissions(permissions []model.Permission) []string {
+	scopes := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		scopes = append(scopes, string(permission))
+	}
+	return scopes
+}
@@ -4,6 +4,7 @@ package services
 import (
 	"context"
 	"errors"
+	"log"
 	"time"
 
 	"booking-event/internal/modules/auth/model"
@@ -14,80 +15,296 @@ import (
 
 type UserRepository interface {
 	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
 }
 
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error
+	UseRefreshToken(ctx context.Context, jti string, next *model.RefreshToken) error
+	RevokeTokenFamily(ctx context.Context, familyID string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID int) error
+}
+
+type RevocationRepository interface {
+	RevokeToken(ctx context.Context, jti string, ttl time.Duration) error
+	RevokeUserTokensBefore(ctx context.Context, userID int, before time.Time, ttl time.Duration) error
+}
+
+type LoginAttemptGuard interface {
+	CheckAttempt(ctx context.Context, attempt model.LoginAttempt) error
+	RecordFailure(ctx context.Context, attempt model.LoginAttempt, user *model.User) error
+	RecordSuccess(ctx context.Context, attempt model.LoginAttempt) error
+	UnlockAccount(ctx context.Context, token string) error
+}
+
+type MFAVerifier interface {
+	RequiresMFA(ctx context.Context, user *model.User) (bool, bool, error)
+	Enroll(ctx context.Context, userID int) (*model.MFAEnrollment, error)
+	ConfirmEnrollment(ctx context.Context, request model.MFACodeRequest) ([]string, error)
+	VerifyCode(ctx context.Context, userID int, code string) error
+}
+
+// dummyPasswordHash is compared against when the email is not registered, so unknown emails take as long to
+// reject as wrong passwords.
+const dummyPasswordHash = "$2a$10$W.klY/GB4T1EsLw8gLZI3u/.EgsYtZiCfOKIRQeGN9/V17frLc1vC"
+
 type AuthServiceConfig struct {
-	SecretKey       string
 	AccessTokenExp  time.Duration
 	RefreshTokenExp time.Duration
+	MFAChallengeExp time.Duration
 }
 
 type AuthService struct {
-	userDBRepo UserRepository
-	cfg        AuthServiceConfig
+	userDBRepo         UserRepository
+	refreshTokenDBRepo RefreshTokenRepository
+	revocationRepo     RevocationRepository
+	loginGuard         LoginAttemptGuard
+	mfa                MFAVerifier
+	signer             TokenSigner
+	verifier           *TokenVerifier
+	uuidFn             func() string
+	cfg                AuthServiceConfig
 }
 
-func NewAuthService(userDBRepo UserRepository, cfg AuthServiceConfig) *AuthService {
+func NewAuthService(
+	userDBRepo UserRepository,
+	refreshTokenDBRepo RefreshTokenRepository,
+	revocationRepo RevocationRepository,
+	loginGuard LoginAttemptGuard,
+	mfa MFAVerifier,
+	signer TokenSigner,
+	keys PublicKeyResolver,
+	uuidFn func() string,
+	cfg AuthServiceConfig,
+) *AuthService {
 	return &AuthService{
-		userDBRepo: userDBRepo,
-		cfg:        cfg,
+		userDBRepo:         userDBRepo,
+		refreshTokenDBRepo: refreshTokenDBRepo,
+		revocationRepo:     revocationRepo,
+		loginGuard:         loginGuard,
+		mfa:                mfa,
+		signer:             signer,
+		verifier:           NewTokenVerifier(keys),
+		uuidFn:             uuidFn,
+		cfg:                cfg,
+	}
+}
+
+// LoginByEmail checks the credentials of the attempt. Unknown emails and wrong passwords get the same error after
+// the same work, and the attempts are throttled by the login guard. Users with MFA get a challenge instead of
+// tokens, it is completed by CompleteMFALogin.
+func (s *AuthService) LoginByEmail(ctx context.Context, attempt model.LoginAttempt) (*model.User, *model.TokenPair, *model.MFAChallenge, error) {
+	if err := s.loginGuard.CheckAttempt(ctx, attempt); err != nil {
+		return nil, nil, nil, err
+	}
+
+	user, err := s.userDBRepo.GetUserByEmail(ctx, attempt.Email)
+	if err != nil && !errors.Is(err, model.ErrUserNotFound) {
+		return nil, nil, nil, err
+	}
+	hashedPassword := dummyPasswordHash
+	if user != nil {
+		hashedPassword = user.HashedPassword
+	}
+	if !s.comparePassword(attempt.Password, hashedPassword) || user == nil {
+		if err := s.loginGuard.RecordFailure(ctx, attempt, user); err != nil {
+			log.Println("error recording failed login", attempt.Email, err)
+		}
+		return nil, nil, nil, model.ErrInvalidCredentials
+	}
+
+	tokenPair, challenge, err := s.StartLogin(ctx, user)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// with MFA the failures are only cleared by the second factor, else each password login would reset the
+	// count of wrong codes
+	if challenge == nil {
+		if err := s.loginGuard.RecordSuccess(ctx, attempt); err != nil {
+			log.Println("error recording login", user.ID, err)
+		}
 	}
+	return user, tokenPair, challenge, nil
 }
 
-func (s *AuthService) LoginByEmail(ctx context.Context, email string, password string) (*model.User, *model.TokenPair, error) {
-	user, err := s.userDBRepo.GetUserByEmail(ctx, email)
+// StartLogin logs in a user whose first factor was checked, by password or by an identity provider. It returns
+// the tokens, or a challenge when the user has to complete the login with a second factor.
+func (s *AuthService) StartLogin(ctx context.Context, user *model.User) (*model.TokenPair, *model.MFAChallenge, error) {
+	if user.Status == model.UserStatusSuspended {
+		return nil, nil, model.ErrUserSuspended
+	}
+	enabled, required, err := s.mfa.RequiresMFA(ctx, user)
 	if err != nil {
 		return nil, nil, err
 	}
-
-	if !s.comparePassword(password, user.HashedPassword) {
-		return nil, nil, model.ErrInvalidPassword
+	if enabled || required {
+		challenge, err := s.generateMFAChallenge(user, !enabled)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, challenge, nil
 	}
 
-	// Generate JWT tokens
-	tokenPair, err := s.generateTokenPair(user)
+	tokenPair, err := s.startSession(ctx, user)
 	if err != nil {
 		return nil, nil, err
 	}
+	return tokenPair, nil, nil
+}
 
-	return user, tokenPair, nil
+// EnrollMFAWithChallenge starts the enrollment of a user whose role requires MFA from the challenge of the login,
+// the login is completed with the first code of the app.
+func (s *AuthService) EnrollMFAWithChallenge(ctx context.Context, challengeToken string) (*model.MFAEnrollment, error) {
+	claims, err := s.parseMFAChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.MFAEnroll {
+		return nil, model.ErrInvalidMFAChallenge
+	}
+	return s.mfa.Enroll(ctx, claims.UserID)
 }
 
-func (s *AuthService) comparePassword(password string, hashedPassword string) bool {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)) == nil
+// CompleteMFALogin exchanges the challenge of a login and a code for the tokens. Wrong codes count as failed
+// logins of the account. When the challenge requires the enrollment, the code confirms it and the recovery codes
+// are returned too.
+func (s *AuthService) CompleteMFALogin(ctx context.Context, mfaAttempt model.MFALoginAttempt) (*model.User, *model.TokenPair, []string, error) {
+	claims, err := s.parseMFAChallenge(ctx, mfaAttempt.ChallengeToken)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	user, err := s.userDBRepo.GetUserByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if user.Status == model.UserStatusSuspended {
+		return nil, nil, nil, model.ErrUserSuspended
+	}
+	attempt := model.LoginAttempt{Email: user.Email, IP: mfaAttempt.IP, UserAgent: mfaAttempt.UserAgent}
+	if err := s.loginGuard.CheckAttempt(ctx, attempt); err != nil {
+		return nil, nil, nil, err
+	}
+
+	var recoveryCodes []string
+	if claims.MFAEnroll {
+		recoveryCodes, err = s.mfa.ConfirmEnrollment(ctx, model.MFACodeRequest{Code: mfaAttempt.Code, UserID: user.ID})
+	} else {
+		err = s.mfa.VerifyCode(ctx, user.ID, mfaAttempt.Code)
+	}
+	if errors.Is(err, model.ErrInvalidMFACode) {
+		if err := s.loginGuard.RecordFailure(ctx, attempt, user); err != nil {
+			log.Println("error recording failed mfa login", user.ID, err)
+		}
+		return nil, nil, nil, model.ErrInvalidMFACode
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := s.loginGuard.RecordSuccess(ctx, attempt); err != nil {
+		log.Println("error recording login", user.ID, err)
+	}
+
+	tokenPair, err := s.startSession(ctx, user)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return user, tokenPair, recoveryCodes, nil
 }
 
-func (s *AuthService) generateTokenPair(user *model.User) (*model.TokenPair, error) {
-	accessToken, err := s.generateJWTToken(user, s.cfg.AccessTokenExp)
+// startSession issues the tokens of a login, a login starts a new refresh token family.
+func (s *AuthService) startSession(ctx context.Context, user *model.User) (*model.TokenPair, error) {
+	tokenPair, refreshToken, err := s.generateTokenPair(user, s.uuidFn())
 	if err != nil {
 		return nil, err
 	}
+	if err := s.refreshTokenDBRepo.CreateRefreshToken(ctx, refreshToken); err != nil {
+		return nil, err
+	}
+	return tokenPair, nil
+}
 
-	refreshToken, err := s.generateJWTToken(user, s.cfg.RefreshTokenExp)
+func (s *AuthService) generateMFAChallenge(user *model.User, enroll bool) (*model.MFAChallenge, error) {
+	now := time.Now()
+	expiresAt := now.Add(s.cfg.MFAChallengeExp)
+	token, err := s.generateJWTToken(&model.TokenClaims{
+		UserID:    user.ID,
+		Email:     user.Email,
+		Type:      model.TokenTypeMFAChallenge,
+		MFAEnroll: enroll,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        s.uuidFn(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
+	return &model.MFAChallenge{Token: token, ExpiresAt: expiresAt, EnrollmentRequired: enroll}, nil
+}
 
-	return &model.TokenPair{
-		AccessToken:     accessToken,
-		RefreshToken:    refreshToken,
-		AccessTokenExp:  time.Now().Add(s.cfg.AccessTokenExp),
-		RefreshTokenExp: time.Now().Add(s.cfg.RefreshTokenExp),
-	}, nil
+func (s *AuthService) parseMFAChallenge(ctx context.Context, token string) (*model.TokenClaims, error) {
+	claims, err := s.parseJWTToken(ctx, token)
+	if err != nil || claims.Type != model.TokenTypeMFAChallenge {
+		return nil, model.ErrInvalidMFAChallenge
+	}
+	return claims, nil
 }
 
-func (s *AuthService) generateJWTToken(user *model.User, expiration time.Duration) (string, error) {
-	expirationTime := time.Now().Add(expiration)
-	claims := &model.TokenClaims{
-		UserID: user.ID,
-		Email:  user.Email,
+func (s *AuthService) comparePassword(password string, hashedPassword string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)) == nil
+}
+
+func (s *AuthService) generateTokenPair(user *model.User, familyID string) (*model.TokenPair, *model.RefreshToken, error) {
+	now := time.Now()
+	accessToken, err := s.generateJWTToken(&model.TokenClaims{
+		UserID:      user.ID,
+		Email:       user.Email,
+		Role:        user.Role,
+		Permissions: user.Role.Permissions(),
+		Type:        model.TokenTypeAccess,
+		IssuedAtMs:  now.UnixMilli(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        s.uuidFn(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.cfg.AccessTokenExp)),
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshRecord := &model.RefreshToken{
+		JTI:       s.uuidFn(),
+		FamilyID:  familyID,
+		UserID:    user.ID,
+		ExpiresAt: now.Add(s.cfg.RefreshTokenExp),
+	}
+	refreshToken, err := s.generateJWTToken(&model.TokenClaims{
+		UserID:   user.ID,
+		Email:    user.Email,
+		Type:     model.TokenTypeRefresh,
+		FamilyID: familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			ID:        refreshRecord.JTI,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(refreshRecord.ExpiresAt),
 		},
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(s.cfg.SecretKey))
+	return &model.TokenPair{
+		AccessToken:     accessToken,
+		RefreshToken:    refreshToken,
+		AccessTokenExp:  now.Add(s.cfg.AccessTokenExp),
+		RefreshTokenExp: refreshRecord.ExpiresAt,
+	}, refreshRecord, nil
+}
+
+func (s *AuthService) generateJWTToken(claims *model.TokenClaims) (string, error) {
+	tokenString, err := s.signer.SignToken(claims)
 	if err != nil {
 		return "", err
 	}
@@ -95,41 +312,95 @@ func (s *AuthService) generateJWTToken(user *model.User, expiration time.Duratio
 	return tokenString, nil
 }
 
+// RefreshToken exchanges a refresh token for a new token pair. The refresh token can only be used once, using it
+// again revokes every refresh token of its family since either the user or an attacker holds a stolen copy.
 func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (*model.TokenPair, error) {
 	// Verify the refresh token
-	claims := &model.TokenClaims{}
-	token, err := jwt.ParseWithClaims(refreshToken, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.cfg.SecretKey), nil
-	})
-
-	if err != nil || !token.Valid {
-		return nil, errors.New("invalid refresh token")
+	claims, err := s.parseJWTToken(ctx, refreshToken)
+	if err != nil || claims.Type != model.TokenTypeRefresh || claims.ID == "" || claims.FamilyID == "" {
+		return nil, model.ErrInvalidRefreshToken
 	}
 
-	user, err := s.userDBRepo.GetUserByEmail(ctx, claims.Email)
+	user, err := s.userDBRepo.GetUserByID(ctx, claims.UserID)
 	if err != nil {
 		return nil, err
 	}
+	if user.Status == model.UserStatusSuspended {
+		return nil, model.ErrUserSuspended
+	}
 
-	newAccessToken, err := s.generateJWTToken(user, s.cfg.AccessTokenExp)
+	tokenPair, next, err := s.generateTokenPair(user, claims.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.refreshTokenDBRepo.UseRefreshToken(ctx, claims.ID, next)
+	if errors.Is(err, model.ErrRefreshTokenReused) {
+		if err := s.refreshTokenDBRepo.RevokeTokenFamily(ctx, claims.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, model.ErrRefreshTokenReused
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &model.TokenPair{
-		AccessToken:  newAccessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	return tokenPair, nil
 }
 
-func (s *AuthService) VerifyJWTToken(token string) (*model.TokenClaims, error) {
-	claims := &model.TokenClaims{}
-	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.cfg.SecretKey), nil
-	})
+// Logout revokes the access token and, when given, the refresh token family of the session.
+func (s *AuthService) Logout(ctx context.Context, accessToken string, refreshToken string) error {
+	claims, err := s.VerifyJWTToken(ctx, accessToken)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if ttl := time.Until(claims.ExpiresAt.Time); claims.ID != "" && ttl > 0 {
+		if err := s.revocationRepo.RevokeToken(ctx, claims.ID, ttl); err != nil {
+			return err
+		}
+	}
+	if refreshToken == "" {
+		return nil
 	}
+	// an expired or foreign refresh token has nothing left to revoke for this user
+	refreshClaims, err := s.parseJWTToken(ctx, refreshToken)
+	if err != nil || refreshClaims.Type != model.TokenTypeRefresh || refreshClaims.UserID != claims.UserID || refreshClaims.FamilyID == "" {
+		return nil
+	}
+	return s.refreshTokenDBRepo.RevokeTokenFamily(ctx, refreshClaims.FamilyID)
+}
 
-	return claims, nil
+// LogoutAll revokes every access and refresh token issued to the user so far.
+func (s *AuthService) LogoutAll(ctx context.Context, accessToken string) error {
+	claims, err := s.VerifyJWTToken(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+	return s.RevokeUserSessions(ctx, claims.UserID)
+}
+
+// UnlockAccount lifts the lockout of an account with the token of the email sent when it was locked.
+func (s *AuthService) UnlockAccount(ctx context.Context, token string) error {
+	return s.loginGuard.UnlockAccount(ctx, token)
+}
+
+// RevokeUserSessions revokes every access and refresh token issued to the user so far.
+func (s *AuthService) RevokeUserSessions(ctx context.Context, userID int) error {
+	// the cutoff only has to outlive the tokens issued before it
+	ttl := s.cfg.AccessTokenExp
+	if s.cfg.RefreshTokenExp > ttl {
+		ttl = s.cfg.RefreshTokenExp
+	}
+	if err := s.revocationRepo.RevokeUserTokensBefore(ctx, userID, time.Now(), ttl); err != nil {
+		return err
+	}
+	return s.refreshTokenDBRepo.RevokeUserRefreshTokens(ctx, userID)
+}
+
+// VerifyJWTToken validates an access token, refresh tokens are rejected.
+func (s *AuthService) VerifyJWTToken(ctx context.Context, token string) (*model.TokenClaims, error) {
+	return s.verifier.VerifyJWTToken(ctx, token)
+}
+
+func (s *AuthService) parseJWTToken(ctx context.Context, token string) (*model.TokenClaims, error) {
+	return s.verifier.ParseJWTToken(ctx, token)
 }
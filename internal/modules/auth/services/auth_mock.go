@@ -13,6 +13,7 @@ import (
 	model "booking-event/internal/modules/auth/model"
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -54,3 +55,310 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByEmail(ctx, email any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUserRepository)(nil).GetUserByEmail), ctx, email)
 }
+
+// GetUserByID mocks base method.
+func (m *MockUserRepository) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepository)(nil).GetUserByID), ctx, id)
+}
+
+// MockRefreshTokenRepository is a mock of RefreshTokenRepository interface.
+type MockRefreshTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenRepositoryMockRecorder
+}
+
+// MockRefreshTokenRepositoryMockRecorder is the mock recorder for MockRefreshTokenRepository.
+type MockRefreshTokenRepositoryMockRecorder struct {
+	mock *MockRefreshTokenRepository
+}
+
+// NewMockRefreshTokenRepository creates a new mock instance.
+func NewMockRefreshTokenRepository(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+	mock := &MockRefreshTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokenRepository) EXPECT() *MockRefreshTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MockRefreshTokenRepository) CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MockRefreshTokenRepositoryMockRecorder) CreateRefreshToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MockRefreshTokenRepository)(nil).CreateRefreshToken), ctx, token)
+}
+
+// RevokeTokenFamily mocks base method.
+func (m *MockRefreshTokenRepository) RevokeTokenFamily(ctx context.Context, familyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeTokenFamily", ctx, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeTokenFamily indicates an expected call of RevokeTokenFamily.
+func (mr *MockRefreshTokenRepositoryMockRecorder) RevokeTokenFamily(ctx, familyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeTokenFamily", reflect.TypeOf((*MockRefreshTokenRepository)(nil).RevokeTokenFamily), ctx, familyID)
+}
+
+// RevokeUserRefreshTokens mocks base method.
+func (m *MockRefreshTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserRefreshTokens", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserRefreshTokens indicates an expected call of RevokeUserRefreshTokens.
+func (mr *MockRefreshTokenRepositoryMockRecorder) RevokeUserRefreshTokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserRefreshTokens", reflect.TypeOf((*MockRefreshTokenRepository)(nil).RevokeUserRefreshTokens), ctx, userID)
+}
+
+// UseRefreshToken mocks base method.
+func (m *MockRefreshTokenRepository) UseRefreshToken(ctx context.Context, jti string, next *model.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRefreshToken", ctx, jti, next)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRefreshToken indicates an expected call of UseRefreshToken.
+func (mr *MockRefreshTokenRepositoryMockRecorder) UseRefreshToken(ctx, jti, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRefreshToken", reflect.TypeOf((*MockRefreshTokenRepository)(nil).UseRefreshToken), ctx, jti, next)
+}
+
+// MockRevocationRepository is a mock of RevocationRepository interface.
+type MockRevocationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevocationRepositoryMockRecorder
+}
+
+// MockRevocationRepositoryMockRecorder is the mock recorder for MockRevocationRepository.
+type MockRevocationRepositoryMockRecorder struct {
+	mock *MockRevocationRepository
+}
+
+// NewMockRevocationRepository creates a new mock instance.
+func NewMockRevocationRepository(ctrl *gomock.Controller) *MockRevocationRepository {
+	mock := &MockRevocationRepository{ctrl: ctrl}
+	mock.recorder = &MockRevocationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevocationRepository) EXPECT() *MockRevocationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// RevokeToken mocks base method.
+func (m *MockRevocationRepository) RevokeToken(ctx context.Context, jti string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, jti, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockRevocationRepositoryMockRecorder) RevokeToken(ctx, jti, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockRevocationRepository)(nil).RevokeToken), ctx, jti, ttl)
+}
+
+// RevokeUserTokensBefore mocks base method.
+func (m *MockRevocationRepository) RevokeUserTokensBefore(ctx context.Context, userID int, before time.Time, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserTokensBefore", ctx, userID, before, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserTokensBefore indicates an expected call of RevokeUserTokensBefore.
+func (mr *MockRevocationRepositoryMockRecorder) RevokeUserTokensBefore(ctx, userID, before, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokensBefore", reflect.TypeOf((*MockRevocationRepository)(nil).RevokeUserTokensBefore), ctx, userID, before, ttl)
+}
+
+// MockLoginAttemptGuard is a mock of LoginAttemptGuard interface.
+type MockLoginAttemptGuard struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAttemptGuardMockRecorder
+}
+
+// MockLoginAttemptGuardMockRecorder is the mock recorder for MockLoginAttemptGuard.
+type MockLoginAttemptGuardMockRecorder struct {
+	mock *MockLoginAttemptGuard
+}
+
+// NewMockLoginAttemptGuard creates a new mock instance.
+func NewMockLoginAttemptGuard(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+	mock := &MockLoginAttemptGuard{ctrl: ctrl}
+	mock.recorder = &MockLoginAttemptGuardMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAttemptGuard) EXPECT() *MockLoginAttemptGuardMockRecorder {
+	return m.recorder
+}
+
+// CheckAttempt mocks base method.
+func (m *MockLoginAttemptGuard) CheckAttempt(ctx context.Context, attempt model.LoginAttempt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAttempt", ctx, attempt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAttempt indicates an expected call of CheckAttempt.
+func (mr *MockLoginAttemptGuardMockRecorder) CheckAttempt(ctx, attempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAttempt", reflect.TypeOf((*MockLoginAttemptGuard)(nil).CheckAttempt), ctx, attempt)
+}
+
+// RecordFailure mocks base method.
+func (m *MockLoginAttemptGuard) RecordFailure(ctx context.Context, attempt model.LoginAttempt, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", ctx, attempt, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockLoginAttemptGuardMockRecorder) RecordFailure(ctx, attempt, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockLoginAttemptGuard)(nil).RecordFailure), ctx, attempt, user)
+}
+
+// RecordSuccess mocks base method.
+func (m *MockLoginAttemptGuard) RecordSuccess(ctx context.Context, attempt model.LoginAttempt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSuccess", ctx, attempt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSuccess indicates an expected call of RecordSuccess.
+func (mr *MockLoginAttemptGuardMockRecorder) RecordSuccess(ctx, attempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSuccess", reflect.TypeOf((*MockLoginAttemptGuard)(nil).RecordSuccess), ctx, attempt)
+}
+
+// UnlockAccount mocks base method.
+func (m *MockLoginAttemptGuard) UnlockAccount(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockAccount", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockAccount indicates an expected call of UnlockAccount.
+func (mr *MockLoginAttemptGuardMockRecorder) UnlockAccount(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAccount", reflect.TypeOf((*MockLoginAttemptGuard)(nil).UnlockAccount), ctx, token)
+}
+
+// MockMFAVerifier is a mock of MFAVerifier interface.
+type MockMFAVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFAVerifierMockRecorder
+}
+
+// MockMFAVerifierMockRecorder is the mock recorder for MockMFAVerifier.
+type MockMFAVerifierMockRecorder struct {
+	mock *MockMFAVerifier
+}
+
+// NewMockMFAVerifier creates a new mock instance.
+func NewMockMFAVerifier(ctrl *gomock.Controller) *MockMFAVerifier {
+	mock := &MockMFAVerifier{ctrl: ctrl}
+	mock.recorder = &MockMFAVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFAVerifier) EXPECT() *MockMFAVerifierMockRecorder {
+	return m.recorder
+}
+
+// ConfirmEnrollment mocks base method.
+func (m *MockMFAVerifier) ConfirmEnrollment(ctx context.Context, request model.MFACodeRequest) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEnrollment", ctx, request)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEnrollment indicates an expected call of ConfirmEnrollment.
+func (mr *MockMFAVerifierMockRecorder) ConfirmEnrollment(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEnrollment", reflect.TypeOf((*MockMFAVerifier)(nil).ConfirmEnrollment), ctx, request)
+}
+
+// Enroll mocks base method.
+func (m *MockMFAVerifier) Enroll(ctx context.Context, userID int) (*model.MFAEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enroll", ctx, userID)
+	ret0, _ := ret[0].(*model.MFAEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enroll indicates an expected call of Enroll.
+func (mr *MockMFAVerifierMockRecorder) Enroll(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enroll", reflect.TypeOf((*MockMFAVerifier)(nil).Enroll), ctx, userID)
+}
+
+// RequiresMFA mocks base method.
+func (m *MockMFAVerifier) RequiresMFA(ctx context.Context, user *model.User) (bool, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequiresMFA", ctx, user)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RequiresMFA indicates an expected call of RequiresMFA.
+func (mr *MockMFAVerifierMockRecorder) RequiresMFA(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequiresMFA", reflect.TypeOf((*MockMFAVerifier)(nil).RequiresMFA), ctx, user)
+}
+
+// VerifyCode mocks base method.
+func (m *MockMFAVerifier) VerifyCode(ctx context.Context, userID int, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyCode", ctx, userID, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyCode indicates an expected call of VerifyCode.
+func (mr *MockMFAVerifierMockRecorder) VerifyCode(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyCode", reflect.TypeOf((*MockMFAVerifier)(nil).VerifyCode), ctx, userID, code)
+}
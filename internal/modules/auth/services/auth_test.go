@@ -2,7 +2,11 @@ package services
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"errors"
+	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -11,6 +15,7 @@ import (
 	gomock "go.uber.org/mock/gomock"
 	"golang.org/x/crypto/bcrypt"
 
+	"booking-event/internal/infra/jwks"
 	"booking-event/internal/modules/auth/model"
 )
 
@@ -25,8 +30,12 @@ func TestAuthService_LoginByEmail(t *testing.T) {
 		email          string
 		password       string
 		mockUserRepo   func(ctrl *gomock.Controller) *MockUserRepository
+		mockTokenRepo  func(ctrl *gomock.Controller) *MockRefreshTokenRepository
+		mockLoginGuard func(ctrl *gomock.Controller) *MockLoginAttemptGuard
+		mockMFA        func(ctrl *gomock.Controller) *MockMFAVerifier
 		expectedUser   *model.User
 		expectedTokens *model.TokenPair
+		expectedMFA    *model.MFAChallenge
 		expectedError  error
 	}{
 		{
@@ -38,13 +47,36 @@ func TestAuthService_LoginByEmail(t *testing.T) {
 				mock.EXPECT().GetUserByEmail(gomock.Any(), "test@example.com").Return(&model.User{
 					ID:             1,
 					Email:          "test@example.com",
+					Role:           model.RoleOrganizer,
 					HashedPassword: string(hashedPassword),
 				}, nil)
 				return mock
 			},
+			mockTokenRepo: func(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+				mock := NewMockRefreshTokenRepository(ctrl)
+				mock.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, token *model.RefreshToken) error {
+					assert.Equal(t, 1, token.UserID)
+					assert.NotEmpty(t, token.JTI)
+					assert.NotEmpty(t, token.FamilyID)
+					return nil
+				})
+				return mock
+			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				mock := NewMockLoginAttemptGuard(ctrl)
+				mock.EXPECT().CheckAttempt(gomock.Any(), gomock.Any()).Return(nil)
+				mock.EXPECT().RecordSuccess(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			mockMFA: func(ctrl *gomock.Controller) *MockMFAVerifier {
+				mock := NewMockMFAVerifier(ctrl)
+				mock.EXPECT().RequiresMFA(gomock.Any(), gomock.Any()).Return(false, false, nil)
+				return mock
+			},
 			expectedUser: &model.User{
 				ID:             1,
 				Email:          "test@example.com",
+				Role:           model.RoleOrganizer,
 				HashedPassword: string(hashedPassword),
 			},
 			expectedTokens: &model.TokenPair{
@@ -53,18 +85,78 @@ func TestAuthService_LoginByEmail(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:     "MFA enabled",
+			email:    "test@example.com",
+			password: "correctpassword",
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				mock := NewMockUserRepository(ctrl)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "test@example.com").Return(&model.User{
+					ID:             1,
+					Email:          "test@example.com",
+					Role:           model.RoleOrganizer,
+					HashedPassword: string(hashedPassword),
+				}, nil)
+				return mock
+			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				mock := NewMockLoginAttemptGuard(ctrl)
+				// the failures are only cleared once the second factor is checked
+				mock.EXPECT().CheckAttempt(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			mockMFA: func(ctrl *gomock.Controller) *MockMFAVerifier {
+				mock := NewMockMFAVerifier(ctrl)
+				mock.EXPECT().RequiresMFA(gomock.Any(), gomock.Any()).Return(true, true, nil)
+				return mock
+			},
+			expectedMFA: &model.MFAChallenge{EnrollmentRequired: false},
+		},
+		{
+			name:     "MFA required but not enrolled",
+			email:    "test@example.com",
+			password: "correctpassword",
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				mock := NewMockUserRepository(ctrl)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "test@example.com").Return(&model.User{
+					ID:             1,
+					Email:          "test@example.com",
+					Role:           model.RoleAdmin,
+					HashedPassword: string(hashedPassword),
+				}, nil)
+				return mock
+			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				mock := NewMockLoginAttemptGuard(ctrl)
+				mock.EXPECT().CheckAttempt(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			mockMFA: func(ctrl *gomock.Controller) *MockMFAVerifier {
+				mock := NewMockMFAVerifier(ctrl)
+				mock.EXPECT().RequiresMFA(gomock.Any(), gomock.Any()).Return(false, true, nil)
+				return mock
+			},
+			expectedMFA: &model.MFAChallenge{EnrollmentRequired: true},
+		},
 		{
 			name:     "User not found",
 			email:    "nonexistent@example.com",
 			password: "anypassword",
 			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
 				mock := NewMockUserRepository(ctrl)
-				mock.EXPECT().GetUserByEmail(gomock.Any(), "nonexistent@example.com").Return(nil, errors.New("user not found"))
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "nonexistent@example.com").Return(nil, model.ErrUserNotFound)
+				return mock
+			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				mock := NewMockLoginAttemptGuard(ctrl)
+				mock.EXPECT().CheckAttempt(gomock.Any(), gomock.Any()).Return(nil)
+				mock.EXPECT().RecordFailure(gomock.Any(), gomock.Any(), nil).Return(nil)
 				return mock
 			},
 			expectedUser:   nil,
 			expectedTokens: nil,
-			expectedError:  errors.New("user not found"),
+			// unknown emails answer like wrong passwords
+			expectedError: model.ErrInvalidCredentials,
 		},
 		{
 			name:     "Incorrect password",
@@ -79,9 +171,54 @@ func TestAuthService_LoginByEmail(t *testing.T) {
 				}, nil)
 				return mock
 			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				mock := NewMockLoginAttemptGuard(ctrl)
+				mock.EXPECT().CheckAttempt(gomock.Any(), gomock.Any()).Return(nil)
+				mock.EXPECT().RecordFailure(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).Return(nil)
+				return mock
+			},
+			expectedUser:   nil,
+			expectedTokens: nil,
+			expectedError:  model.ErrInvalidCredentials,
+		},
+		{
+			name:     "Suspended user",
+			email:    "test@example.com",
+			password: "correctpassword",
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				mock := NewMockUserRepository(ctrl)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "test@example.com").Return(&model.User{
+					ID:             1,
+					Email:          "test@example.com",
+					Status:         model.UserStatusSuspended,
+					HashedPassword: string(hashedPassword),
+				}, nil)
+				return mock
+			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				mock := NewMockLoginAttemptGuard(ctrl)
+				mock.EXPECT().CheckAttempt(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			expectedUser:   nil,
+			expectedTokens: nil,
+			expectedError:  model.ErrUserSuspended,
+		},
+		{
+			name:     "Locked account",
+			email:    "test@example.com",
+			password: "correctpassword",
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				return NewMockUserRepository(ctrl)
+			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				mock := NewMockLoginAttemptGuard(ctrl)
+				mock.EXPECT().CheckAttempt(gomock.Any(), gomock.Any()).Return(&model.LoginThrottledError{RetryAfter: time.Minute})
+				return mock
+			},
 			expectedUser:   nil,
 			expectedTokens: nil,
-			expectedError:  errors.New("invalid password"),
+			expectedError:  model.ErrTooManyLoginAttempts,
 		},
 	}
 
@@ -91,23 +228,48 @@ func TestAuthService_LoginByEmail(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockUserRepo := tt.mockUserRepo(ctrl)
-			service := NewAuthService(mockUserRepo, AuthServiceConfig{
-				SecretKey:       "test_secret",
+			mockTokenRepo := NewMockRefreshTokenRepository(ctrl)
+			if tt.mockTokenRepo != nil {
+				mockTokenRepo = tt.mockTokenRepo(ctrl)
+			}
+			mockMFA := NewMockMFAVerifier(ctrl)
+			if tt.mockMFA != nil {
+				mockMFA = tt.mockMFA(ctrl)
+			}
+			service := NewAuthService(mockUserRepo, mockTokenRepo, nil, tt.mockLoginGuard(ctrl), mockMFA, testKeys, testKeys, uuidFn(), AuthServiceConfig{
 				AccessTokenExp:  time.Hour,
 				RefreshTokenExp: time.Hour * 24,
+				MFAChallengeExp: 5 * time.Minute,
 			})
 
-			user, tokens, err := service.LoginByEmail(context.Background(), tt.email, tt.password)
+			user, tokens, challenge, err := service.LoginByEmail(context.Background(), model.LoginAttempt{Email: tt.email, Password: tt.password, IP: "127.0.0.1"})
 			if tt.expectedError != nil {
-				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.ErrorIs(t, err, tt.expectedError)
 				assert.Nil(t, user)
 				assert.Nil(t, tokens)
+			} else if tt.expectedMFA != nil {
+				assert.NoError(t, err)
+				assert.Nil(t, tokens)
+				assert.Equal(t, tt.expectedMFA.EnrollmentRequired, challenge.EnrollmentRequired)
+
+				// the challenge is not an access token
+				_, err := service.VerifyJWTToken(context.Background(), challenge.Token)
+				assert.Error(t, err)
+				claims, err := service.parseMFAChallenge(context.Background(), challenge.Token)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, claims.UserID)
+				assert.Equal(t, tt.expectedMFA.EnrollmentRequired, claims.MFAEnroll)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expectedUser, user)
 				assert.NotNil(t, tokens)
 				assert.NotEmpty(t, tokens.AccessToken)
 				assert.NotEmpty(t, tokens.RefreshToken)
+
+				claims, err := service.VerifyJWTToken(context.Background(), tokens.AccessToken)
+				assert.NoError(t, err)
+				assert.Equal(t, model.RoleOrganizer, claims.Role)
+				assert.Equal(t, model.RoleOrganizer.Permissions(), claims.Permissions)
 			}
 		})
 	}
@@ -115,24 +277,27 @@ func TestAuthService_LoginByEmail(t *testing.T) {
 
 func TestAuthService_RefreshToken(t *testing.T) {
 	t.Parallel()
-	secret := "test_secret"
-	expiration := time.Hour
-	expirationTime := time.Now().Add(expiration)
-	claims := &model.TokenClaims{
-		UserID: 1,
-		Email:  "test@example.com",
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
-		},
+	signToken := func(tokenType model.TokenType) string {
+		tokenString, err := testKeys.SignToken(&model.TokenClaims{
+			UserID:   1,
+			Email:    "test@example.com",
+			Type:     tokenType,
+			FamilyID: "family-1",
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "jti-1",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		})
+		assert.NoError(t, err)
+		return tokenString
 	}
+	validTokenString := signToken(model.TokenTypeRefresh)
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	validTokenString, err := token.SignedString([]byte(secret))
-	assert.NoError(t, err)
 	tests := []struct {
 		name          string
 		refreshToken  string
 		mockUserRepo  func(ctrl *gomock.Controller) *MockUserRepository
+		mockTokenRepo func(ctrl *gomock.Controller) *MockRefreshTokenRepository
 		expectedError error
 	}{
 		{
@@ -140,12 +305,21 @@ func TestAuthService_RefreshToken(t *testing.T) {
 			refreshToken: validTokenString,
 			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
 				mock := NewMockUserRepository(ctrl)
-				mock.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(&model.User{
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{
 					ID:    1,
 					Email: "test@example.com",
 				}, nil)
 				return mock
 			},
+			mockTokenRepo: func(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+				mock := NewMockRefreshTokenRepository(ctrl)
+				mock.EXPECT().UseRefreshToken(gomock.Any(), "jti-1", gomock.Any()).DoAndReturn(func(ctx context.Context, jti string, next *model.RefreshToken) error {
+					assert.Equal(t, "family-1", next.FamilyID)
+					assert.NotEqual(t, "jti-1", next.JTI)
+					return nil
+				})
+				return mock
+			},
 			expectedError: nil,
 		},
 		{
@@ -154,7 +328,50 @@ func TestAuthService_RefreshToken(t *testing.T) {
 			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
 				return NewMockUserRepository(ctrl)
 			},
-			expectedError: errors.New("invalid refresh token"),
+			mockTokenRepo: func(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+				return NewMockRefreshTokenRepository(ctrl)
+			},
+			expectedError: model.ErrInvalidRefreshToken,
+		},
+		{
+			name:         "Access token used as refresh token",
+			refreshToken: signToken(model.TokenTypeAccess),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				return NewMockUserRepository(ctrl)
+			},
+			mockTokenRepo: func(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+				return NewMockRefreshTokenRepository(ctrl)
+			},
+			expectedError: model.ErrInvalidRefreshToken,
+		},
+		{
+			name:         "Suspended user",
+			refreshToken: validTokenString,
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				mock := NewMockUserRepository(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Email: "test@example.com", Status: model.UserStatusSuspended}, nil)
+				return mock
+			},
+			mockTokenRepo: func(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+				return NewMockRefreshTokenRepository(ctrl)
+			},
+			expectedError: model.ErrUserSuspended,
+		},
+		{
+			name:         "Reused refresh token revokes the family",
+			refreshToken: validTokenString,
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				mock := NewMockUserRepository(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Email: "test@example.com"}, nil)
+				return mock
+			},
+			mockTokenRepo: func(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+				mock := NewMockRefreshTokenRepository(ctrl)
+				mock.EXPECT().UseRefreshToken(gomock.Any(), "jti-1", gomock.Any()).Return(model.ErrRefreshTokenReused)
+				mock.EXPECT().RevokeTokenFamily(gomock.Any(), "family-1").Return(nil)
+				return mock
+			},
+			expectedError: model.ErrRefreshTokenReused,
 		},
 	}
 
@@ -163,22 +380,20 @@ func TestAuthService_RefreshToken(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockUserRepo := tt.mockUserRepo(ctrl)
-			service := NewAuthService(mockUserRepo, AuthServiceConfig{
-				SecretKey:       "test_secret",
+			service := NewAuthService(tt.mockUserRepo(ctrl), tt.mockTokenRepo(ctrl), nil, nil, nil, testKeys, testKeys, uuidFn(), AuthServiceConfig{
 				AccessTokenExp:  time.Hour,
 				RefreshTokenExp: time.Hour * 24,
 			})
 
 			tokens, err := service.RefreshToken(context.Background(), tt.refreshToken)
 			if tt.expectedError != nil {
-				assert.EqualError(t, err, tt.expectedError.Error())
+				assert.ErrorIs(t, err, tt.expectedError)
 				assert.Nil(t, tokens)
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, tokens)
 				assert.NotEmpty(t, tokens.AccessToken)
-				assert.Equal(t, tt.refreshToken, tokens.RefreshToken)
+				assert.NotEqual(t, tt.refreshToken, tokens.RefreshToken)
 			}
 		})
 	}
@@ -186,19 +401,38 @@ func TestAuthService_RefreshToken(t *testing.T) {
 
 func TestAuthService_VerifyJWTToken(t *testing.T) {
 	t.Parallel()
-	secret := "test_secret"
 	expiration := time.Hour
 	expirationTime := time.Now().Add(expiration)
 	claims := &model.TokenClaims{
 		UserID: 1,
 		Email:  "test@example.com",
+		Type:   model.TokenTypeAccess,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	validTokenString, err := token.SignedString([]byte(secret))
+	validTokenString, err := testKeys.SignToken(claims)
+	assert.NoError(t, err)
+
+	claims.Type = model.TokenTypeRefresh
+	refreshTokenString, err := testKeys.SignToken(claims)
+	assert.NoError(t, err)
+
+	claims.Type = model.TokenTypeAccess
+	_, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	otherKeys, err := jwks.NewKeySet("other", []jwks.SigningKey{{KID: "other", PrivateKey: otherPrivateKey}})
+	assert.NoError(t, err)
+	unknownKeyTokenString, err := otherKeys.SignToken(claims)
+	assert.NoError(t, err)
+
+	// a token signed with the public key as HMAC secret must not pass as signed by the key
+	publicKey, err := testKeys.PublicKey(context.Background(), "test")
+	assert.NoError(t, err)
+	hmacToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	hmacToken.Header["kid"] = "test"
+	hmacTokenString, err := hmacToken.SignedString([]byte(publicKey.(ed25519.PublicKey)))
 	assert.NoError(t, err)
 
 	tests := []struct {
@@ -213,8 +447,23 @@ func TestAuthService_VerifyJWTToken(t *testing.T) {
 		},
 		{
 			name:          "Invalid token",
-			token:         validTokenString + "invalid",
-			expectedError: errors.New("signature is invalid"),
+			token:         validTokenString[:len(validTokenString)-4] + "AAAA",
+			expectedError: errors.New("ed25519: verification error"),
+		},
+		{
+			name:          "Token signed with an unknown key",
+			token:         unknownKeyTokenString,
+			expectedError: jwks.ErrUnknownKey,
+		},
+		{
+			name:          "Token signed with HMAC",
+			token:         hmacTokenString,
+			expectedError: errors.New("unexpected signing method"),
+		},
+		{
+			name:          "Refresh token",
+			token:         refreshTokenString,
+			expectedError: errors.New("not an access token"),
 		},
 	}
 
@@ -223,13 +472,12 @@ func TestAuthService_VerifyJWTToken(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			service := NewAuthService(nil, AuthServiceConfig{
-				SecretKey:       secret,
+			service := NewAuthService(nil, nil, nil, nil, nil, testKeys, testKeys, uuidFn(), AuthServiceConfig{
 				AccessTokenExp:  expiration,
 				RefreshTokenExp: time.Hour * 24,
 			})
 
-			claims, err := service.VerifyJWTToken(tt.token)
+			claims, err := service.VerifyJWTToken(context.Background(), tt.token)
 			if tt.expectedError != nil {
 				assert.EqualError(t, err, tt.expectedError.Error())
 				assert.Nil(t, claims)
@@ -240,3 +488,266 @@ func TestAuthService_VerifyJWTToken(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthService_Logout(t *testing.T) {
+	t.Parallel()
+	signToken := func(userID int, tokenType model.TokenType, jti string) string {
+		tokenString, err := testKeys.SignToken(&model.TokenClaims{
+			UserID:   userID,
+			Type:     tokenType,
+			FamilyID: "family-1",
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        jti,
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		})
+		assert.NoError(t, err)
+		return tokenString
+	}
+
+	tests := []struct {
+		name               string
+		refreshToken       string
+		mockTokenRepo      func(ctrl *gomock.Controller) *MockRefreshTokenRepository
+		mockRevocationRepo func(ctrl *gomock.Controller) *MockRevocationRepository
+	}{
+		{
+			name:         "Logout with the refresh token of the session",
+			refreshToken: signToken(1, model.TokenTypeRefresh, "refresh-1"),
+			mockTokenRepo: func(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+				mock := NewMockRefreshTokenRepository(ctrl)
+				mock.EXPECT().RevokeTokenFamily(gomock.Any(), "family-1").Return(nil)
+				return mock
+			},
+			mockRevocationRepo: func(ctrl *gomock.Controller) *MockRevocationRepository {
+				mock := NewMockRevocationRepository(ctrl)
+				mock.EXPECT().RevokeToken(gomock.Any(), "access-1", gomock.Any()).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Logout without refresh token",
+			mockTokenRepo: func(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+				return NewMockRefreshTokenRepository(ctrl)
+			},
+			mockRevocationRepo: func(ctrl *gomock.Controller) *MockRevocationRepository {
+				mock := NewMockRevocationRepository(ctrl)
+				mock.EXPECT().RevokeToken(gomock.Any(), "access-1", gomock.Any()).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:         "Refresh token of another user is ignored",
+			refreshToken: signToken(2, model.TokenTypeRefresh, "refresh-2"),
+			mockTokenRepo: func(ctrl *gomock.Controller) *MockRefreshTokenRepository {
+				return NewMockRefreshTokenRepository(ctrl)
+			},
+			mockRevocationRepo: func(ctrl *gomock.Controller) *MockRevocationRepository {
+				mock := NewMockRevocationRepository(ctrl)
+				mock.EXPECT().RevokeToken(gomock.Any(), "access-1", gomock.Any()).Return(nil)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewAuthService(nil, tt.mockTokenRepo(ctrl), tt.mockRevocationRepo(ctrl), nil, nil, testKeys, testKeys, uuidFn(), AuthServiceConfig{
+				AccessTokenExp:  time.Hour,
+				RefreshTokenExp: time.Hour * 24,
+			})
+			err := service.Logout(context.Background(), signToken(1, model.TokenTypeAccess, "access-1"), tt.refreshToken)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthService_LogoutAll(t *testing.T) {
+	t.Parallel()
+	accessToken, err := testKeys.SignToken(&model.TokenClaims{
+		UserID: 1,
+		Type:   model.TokenTypeAccess,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "access-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+	assert.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTokenRepo := NewMockRefreshTokenRepository(ctrl)
+	mockTokenRepo.EXPECT().RevokeUserRefreshTokens(gomock.Any(), 1).Return(nil)
+	mockRevocationRepo := NewMockRevocationRepository(ctrl)
+	mockRevocationRepo.EXPECT().RevokeUserTokensBefore(gomock.Any(), 1, gomock.Any(), time.Hour*24).Return(nil)
+
+	service := NewAuthService(nil, mockTokenRepo, mockRevocationRepo, nil, nil, testKeys, testKeys, uuidFn(), AuthServiceConfig{
+		AccessTokenExp:  time.Hour,
+		RefreshTokenExp: time.Hour * 24,
+	})
+	assert.NoError(t, service.LogoutAll(context.Background(), accessToken))
+}
+
+func TestAuthService_CompleteMFALogin(t *testing.T) {
+	t.Parallel()
+	user := &model.User{ID: 1, Email: "test@example.com", Role: model.RoleAdmin}
+	signChallenge := func(tokenType model.TokenType, enroll bool) string {
+		tokenString, err := testKeys.SignToken(&model.TokenClaims{
+			UserID:    1,
+			Email:     "test@example.com",
+			Type:      tokenType,
+			MFAEnroll: enroll,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        "challenge-1",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(5 * time.Minute)),
+			},
+		})
+		assert.NoError(t, err)
+		return tokenString
+	}
+
+	tests := []struct {
+		name                  string
+		challengeToken        string
+		mockUserRepo          func(ctrl *gomock.Controller) *MockUserRepository
+		mockLoginGuard        func(ctrl *gomock.Controller) *MockLoginAttemptGuard
+		mockMFA               func(ctrl *gomock.Controller) *MockMFAVerifier
+		expectedRecoveryCodes []string
+		expectedError         error
+	}{
+		{
+			name:           "Valid code",
+			challengeToken: signChallenge(model.TokenTypeMFAChallenge, false),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				mock := NewMockUserRepository(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				return mock
+			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				mock := NewMockLoginAttemptGuard(ctrl)
+				mock.EXPECT().CheckAttempt(gomock.Any(), gomock.Any()).Return(nil)
+				mock.EXPECT().RecordSuccess(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			mockMFA: func(ctrl *gomock.Controller) *MockMFAVerifier {
+				mock := NewMockMFAVerifier(ctrl)
+				mock.EXPECT().VerifyCode(gomock.Any(), 1, "123456").Return(nil)
+				return mock
+			},
+		},
+		{
+			name:           "Code confirming the enrollment",
+			challengeToken: signChallenge(model.TokenTypeMFAChallenge, true),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				mock := NewMockUserRepository(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				return mock
+			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				mock := NewMockLoginAttemptGuard(ctrl)
+				mock.EXPECT().CheckAttempt(gomock.Any(), gomock.Any()).Return(nil)
+				mock.EXPECT().RecordSuccess(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			mockMFA: func(ctrl *gomock.Controller) *MockMFAVerifier {
+				mock := NewMockMFAVerifier(ctrl)
+				mock.EXPECT().ConfirmEnrollment(gomock.Any(), model.MFACodeRequest{Code: "123456", UserID: 1}).Return([]string{"AAAA-BBBB"}, nil)
+				return mock
+			},
+			expectedRecoveryCodes: []string{"AAAA-BBBB"},
+		},
+		{
+			name:           "Wrong code counts as a failed login",
+			challengeToken: signChallenge(model.TokenTypeMFAChallenge, false),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				mock := NewMockUserRepository(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				return mock
+			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				mock := NewMockLoginAttemptGuard(ctrl)
+				mock.EXPECT().CheckAttempt(gomock.Any(), gomock.Any()).Return(nil)
+				mock.EXPECT().RecordFailure(gomock.Any(), model.LoginAttempt{Email: "test@example.com", IP: "127.0.0.1"}, user).Return(nil)
+				return mock
+			},
+			mockMFA: func(ctrl *gomock.Controller) *MockMFAVerifier {
+				mock := NewMockMFAVerifier(ctrl)
+				mock.EXPECT().VerifyCode(gomock.Any(), 1, "123456").Return(model.ErrInvalidMFACode)
+				return mock
+			},
+			expectedError: model.ErrInvalidMFACode,
+		},
+		{
+			name:           "Refresh token is not a challenge",
+			challengeToken: signChallenge(model.TokenTypeRefresh, false),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepository {
+				return NewMockUserRepository(ctrl)
+			},
+			mockLoginGuard: func(ctrl *gomock.Controller) *MockLoginAttemptGuard {
+				return NewMockLoginAttemptGuard(ctrl)
+			},
+			mockMFA: func(ctrl *gomock.Controller) *MockMFAVerifier {
+				return NewMockMFAVerifier(ctrl)
+			},
+			expectedError: model.ErrInvalidMFAChallenge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTokenRepo := NewMockRefreshTokenRepository(ctrl)
+			if tt.expectedError == nil {
+				mockTokenRepo.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+			}
+			service := NewAuthService(tt.mockUserRepo(ctrl), mockTokenRepo, nil, tt.mockLoginGuard(ctrl), tt.mockMFA(ctrl), testKeys, testKeys, uuidFn(), AuthServiceConfig{
+				AccessTokenExp:  time.Hour,
+				RefreshTokenExp: time.Hour * 24,
+			})
+
+			loggedIn, tokens, recoveryCodes, err := service.CompleteMFALogin(context.Background(), model.MFALoginAttempt{
+				ChallengeToken: tt.challengeToken,
+				Code:           "123456",
+				IP:             "127.0.0.1",
+			})
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, tokens)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, user, loggedIn)
+				assert.NotEmpty(t, tokens.AccessToken)
+				assert.Equal(t, tt.expectedRecoveryCodes, recoveryCodes)
+			}
+		})
+	}
+}
+
+// testKeys stands in for the central-auth signing keys.
+var testKeys = newTestKeySet("test")
+
+func newTestKeySet(kid string) *jwks.KeySet {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	keys, err := jwks.NewKeySet(kid, []jwks.SigningKey{{KID: kid, PrivateKey: privateKey}})
+	if err != nil {
+		panic(err)
+	}
+	return keys
+}
+
+// uuidFn returns unique ids, enough to tell tokens apart.
+func uuidFn() func() string {
+	var counter int64
+	return func() string {
+		return strconv.FormatInt(atomic.AddInt64(&counter, 1), 10)
+	}
+}
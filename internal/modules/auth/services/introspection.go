@@ -0,0 +1,61 @@
+//go:generate mockgen -source=introspection.go -destination=introspection_mock.go -package=services
+package services
+
+import (
+	"context"
+	"strconv"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type AccessTokenVerifier interface {
+	VerifyJWTToken(ctx context.Context, token string) (*model.TokenClaims, error)
+}
+
+type AccessTokenRevocationChecker interface {
+	IsTokenRevoked(ctx context.Context, claims *model.TokenClaims) (bool, error)
+}
+
+// IntrospectionService answers the other servers asking central-auth whether an access token is still good, so
+// they see the revocations without access to the revocation store.
+type IntrospectionService struct {
+	verifier          AccessTokenVerifier
+	revocationChecker AccessTokenRevocationChecker
+}
+
+func NewIntrospectionService(verifier AccessTokenVerifier, revocationChecker AccessTokenRevocationChecker) *IntrospectionService {
+	return &IntrospectionService{verifier: verifier, revocationChecker: revocationChecker}
+}
+
+// Introspect describes an active access token. Any other token, including refresh tokens, is inactive.
+func (s *IntrospectionService) Introspect(ctx context.Context, token string) (*model.IntrospectionResponse, error) {
+	claims, err := s.verifier.VerifyJWTToken(ctx, token)
+	if err != nil {
+		return &model.IntrospectionResponse{Active: false}, nil
+	}
+	revoked, err := s.revocationChecker.IsTokenRevoked(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return &model.IntrospectionResponse{Active: false}, nil
+	}
+
+	response := &model.IntrospectionResponse{
+		Active:      true,
+		TokenType:   claims.Type,
+		Subject:     strconv.Itoa(claims.UserID),
+		UserID:      claims.UserID,
+		Email:       claims.Email,
+		Role:        claims.Role,
+		Permissions: claims.Permissions,
+		JTI:         claims.ID,
+	}
+	if claims.IssuedAt != nil {
+		response.IssuedAt = claims.IssuedAt.Unix()
+	}
+	if claims.ExpiresAt != nil {
+		response.ExpiresAt = claims.ExpiresAt.Unix()
+	}
+	return response, nil
+}
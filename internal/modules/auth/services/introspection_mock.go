@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: introspection.go
+//
+// Generated by this command:
+//
+//	mockgen -source=introspection.go -destination=introspection_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccessTokenVerifier is a mock of AccessTokenVerifier interface.
+type MockAccessTokenVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenVerifierMockRecorder
+}
+
+// MockAccessTokenVerifierMockRecorder is the mock recorder for MockAccessTokenVerifier.
+type MockAccessTokenVerifierMockRecorder struct {
+	mock *MockAccessTokenVerifier
+}
+
+// NewMockAccessTokenVerifier creates a new mock instance.
+func NewMockAccessTokenVerifier(ctrl *gomock.Controller) *MockAccessTokenVerifier {
+	mock := &MockAccessTokenVerifier{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenVerifier) EXPECT() *MockAccessTokenVerifierMockRecorder {
+	return m.recorder
+}
+
+// VerifyJWTToken mocks base method.
+func (m *MockAccessTokenVerifier) VerifyJWTToken(ctx context.Context, token string) (*model.TokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyJWTToken", ctx, token)
+	ret0, _ := ret[0].(*model.TokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyJWTToken indicates an expected call of VerifyJWTToken.
+func (mr *MockAccessTokenVerifierMockRecorder) VerifyJWTToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyJWTToken", reflect.TypeOf((*MockAccessTokenVerifier)(nil).VerifyJWTToken), ctx, token)
+}
+
+// MockAccessTokenRevocationChecker is a mock of AccessTokenRevocationChecker interface.
+type MockAccessTokenRevocationChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenRevocationCheckerMockRecorder
+}
+
+// MockAccessTokenRevocationCheckerMockRecorder is the mock recorder for MockAccessTokenRevocationChecker.
+type MockAccessTokenRevocationCheckerMockRecorder struct {
+	mock *MockAccessTokenRevocationChecker
+}
+
+// NewMockAccessTokenRevocationChecker creates a new mock instance.
+func NewMockAccessTokenRevocationChecker(ctrl *gomock.Controller) *MockAccessTokenRevocationChecker {
+	mock := &MockAccessTokenRevocationChecker{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenRevocationCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenRevocationChecker) EXPECT() *MockAccessTokenRevocationCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsTokenRevoked mocks base method.
+func (m *MockAccessTokenRevocationChecker) IsTokenRevoked(ctx context.Context, claims *model.TokenClaims) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTokenRevoked", ctx, claims)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTokenRevoked indicates an expected call of IsTokenRevoked.
+func (mr *MockAccessTokenRevocationCheckerMockRecorder) IsTokenRevoked(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTokenRevoked", reflect.TypeOf((*MockAccessTokenRevocationChecker)(nil).IsTokenRevoked), ctx, claims)
+}
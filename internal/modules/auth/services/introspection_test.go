@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+func TestIntrospectionService_Introspect(t *testing.T) {
+	t.Parallel()
+	issuedAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	claims := &model.TokenClaims{
+		UserID:      1,
+		Email:       "user@example.com",
+		Role:        model.RoleOrganizer,
+		Permissions: []model.Permission{model.PermissionEventCreate},
+		Type:        model.TokenTypeAccess,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "access-1",
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(15 * time.Minute)),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockVerifier     func(ctrl *gomock.Controller) *MockAccessTokenVerifier
+		mockRevocation   func(ctrl *gomock.Controller) *MockAccessTokenRevocationChecker
+		expectedResponse *model.IntrospectionResponse
+		expectedError    error
+	}{
+		{
+			name: "Active token",
+			mockVerifier: func(ctrl *gomock.Controller) *MockAccessTokenVerifier {
+				mock := NewMockAccessTokenVerifier(ctrl)
+				mock.EXPECT().VerifyJWTToken(gomock.Any(), "token").Return(claims, nil)
+				return mock
+			},
+			mockRevocation: func(ctrl *gomock.Controller) *MockAccessTokenRevocationChecker {
+				mock := NewMockAccessTokenRevocationChecker(ctrl)
+				mock.EXPECT().IsTokenRevoked(gomock.Any(), claims).Return(false, nil)
+				return mock
+			},
+			expectedResponse: &model.IntrospectionResponse{
+				Active:      true,
+				TokenType:   model.TokenTypeAccess,
+				Subject:     "1",
+				UserID:      1,
+				Email:       "user@example.com",
+				Role:        model.RoleOrganizer,
+				Permissions: []model.Permission{model.PermissionEventCreate},
+				JTI:         "access-1",
+				IssuedAt:    issuedAt.Unix(),
+				ExpiresAt:   issuedAt.Add(15 * time.Minute).Unix(),
+			},
+		},
+		{
+			name: "Invalid token",
+			mockVerifier: func(ctrl *gomock.Controller) *MockAccessTokenVerifier {
+				mock := NewMockAccessTokenVerifier(ctrl)
+				mock.EXPECT().VerifyJWTToken(gomock.Any(), "token").Return(nil, errors.New("not an access token"))
+				return mock
+			},
+			mockRevocation: func(ctrl *gomock.Controller) *MockAccessTokenRevocationChecker {
+				return NewMockAccessTokenRevocationChecker(ctrl)
+			},
+			expectedResponse: &model.IntrospectionResponse{Active: false},
+		},
+		{
+			name: "Revoked token",
+			mockVerifier: func(ctrl *gomock.Controller) *MockAccessTokenVerifier {
+				mock := NewMockAccessTokenVerifier(ctrl)
+				mock.EXPECT().VerifyJWTToken(gomock.Any(), "token").Return(claims, nil)
+				return mock
+			},
+			mockRevocation: func(ctrl *gomock.Controller) *MockAccessTokenRevocationChecker {
+				mock := NewMockAccessTokenRevocationChecker(ctrl)
+				mock.EXPECT().IsTokenRevoked(gomock.Any(), claims).Return(true, nil)
+				return mock
+			},
+			expectedResponse: &model.IntrospectionResponse{Active: false},
+		},
+		{
+			name: "Revocation store error",
+			mockVerifier: func(ctrl *gomock.Controller) *MockAccessTokenVerifier {
+				mock := NewMockAccessTokenVerifier(ctrl)
+				mock.EXPECT().VerifyJWTToken(gomock.Any(), "token").Return(claims, nil)
+				return mock
+			},
+			mockRevocation: func(ctrl *gomock.Controller) *MockAccessTokenRevocationChecker {
+				mock := NewMockAccessTokenRevocationChecker(ctrl)
+				mock.EXPECT().IsTokenRevoked(gomock.Any(), claims).Return(false, assert.AnError)
+				return mock
+			},
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewIntrospectionService(tt.mockVerifier(ctrl), tt.mockRevocation(ctrl))
+			response, err := service.Introspect(context.Background(), "token")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResponse, response)
+		})
+	}
+}
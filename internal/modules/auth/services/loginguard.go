@@ -0,0 +1,196 @@
+//go:generate mockgen -source=loginguard.go -destination=loginguard_mock.go -package=services
+package services
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type LoginAttemptRepository interface {
+	RecordIPAttempt(ctx context.Context, ip string, at time.Time, window time.Duration) (int64, error)
+	RecordAccountFailure(ctx context.Context, email string, at time.Time, window time.Duration) (int64, error)
+	IncrementLockoutLevel(ctx context.Context, email string, levelTTL time.Duration) (int64, error)
+	LockAccount(ctx context.Context, email string, until time.Time, ttl time.Duration) error
+	GetAccountLockedUntil(ctx context.Context, email string) (time.Time, error)
+	ClearAccountFailures(ctx context.Context, email string) error
+	UnlockAccount(ctx context.Context, email string) error
+	SaveUnlockToken(ctx context.Context, tokenHash string, email string, ttl time.Duration) error
+	ConsumeUnlockToken(ctx context.Context, tokenHash string) (string, error)
+}
+
+type LoginAuditRepository interface {
+	CreateLoginAudit(ctx context.Context, audit *model.LoginAudit) error
+}
+
+type AccountLockNotifier interface {
+	EnqueueAccountLockedEmail(ctx context.Context, task model.SendAccountLockedEmailTask) error
+}
+
+type LoginGuardConfig struct {
+	IPWindow           time.Duration
+	IPMaxAttempts      int
+	AccountWindow      time.Duration
+	AccountMaxFailures int
+	// the first lockout lasts LockoutBase, each following one within LockoutMax doubles up to LockoutMax
+	LockoutBase time.Duration
+	LockoutMax  time.Duration
+	UnlockURL   string // the token is appended as the token query parameter
+}
+
+// LoginGuard slows down password guessing. Every IP gets a budget of attempts per sliding window and every account
+// gets locked after too many failures, the lockouts getting longer while the attacks go on. The accounts are
+// tracked by email so unknown emails get locked too and the answers do not tell which ones are registered.
+type LoginGuard struct {
+	attemptRepo LoginAttemptRepository
+	auditRepo   LoginAuditRepository
+	notifier    AccountLockNotifier
+	nowFn       func() time.Time
+	cfg         LoginGuardConfig
+}
+
+func NewLoginGuard(
+	attemptRepo LoginAttemptRepository,
+	auditRepo LoginAuditRepository,
+	notifier AccountLockNotifier,
+	nowFn func() time.Time,
+	cfg LoginGuardConfig,
+) *LoginGuard {
+	return &LoginGuard{
+		attemptRepo: attemptRepo,
+		auditRepo:   auditRepo,
+		notifier:    notifier,
+		nowFn:       nowFn,
+		cfg:         cfg,
+	}
+}
+
+// CheckAttempt counts the attempt against the IP budget and rejects it when the IP is over budget or the account
+// is locked, before the password is checked.
+func (g *LoginGuard) CheckAttempt(ctx context.Context, attempt model.LoginAttempt) error {
+	now := g.nowFn()
+	email := normalizeEmail(attempt.Email)
+
+	lockedUntil, err := g.attemptRepo.GetAccountLockedUntil(ctx, email)
+	if err != nil {
+		return err
+	}
+	if lockedUntil.After(now) {
+		g.audit(ctx, attempt, nil, model.LoginOutcomeLocked)
+		return &model.LoginThrottledError{RetryAfter: lockedUntil.Sub(now)}
+	}
+
+	attempts, err := g.attemptRepo.RecordIPAttempt(ctx, attempt.IP, now, g.cfg.IPWindow)
+	if err != nil {
+		return err
+	}
+	if attempts > int64(g.cfg.IPMaxAttempts) {
+		g.audit(ctx, attempt, nil, model.LoginOutcomeRateLimited)
+		return &model.LoginThrottledError{RetryAfter: g.cfg.IPWindow}
+	}
+	return nil
+}
+
+// RecordFailure audits a failed attempt and locks the account once it failed too many times, user is nil when the
+// email is not registered.
+func (g *LoginGuard) RecordFailure(ctx context.Context, attempt model.LoginAttempt, user *model.User) error {
+	now := g.nowFn()
+	email := normalizeEmail(attempt.Email)
+	g.audit(ctx, attempt, user, model.LoginOutcomeInvalidCredentials)
+
+	failures, err := g.attemptRepo.RecordAccountFailure(ctx, email, now, g.cfg.AccountWindow)
+	if err != nil {
+		return err
+	}
+	if failures < int64(g.cfg.AccountMaxFailures) {
+		return nil
+	}
+
+	level, err := g.attemptRepo.IncrementLockoutLevel(ctx, email, g.cfg.LockoutMax*2)
+	if err != nil {
+		return err
+	}
+	duration := g.cfg.LockoutBase
+	for i := int64(1); i < level && duration < g.cfg.LockoutMax; i++ {
+		duration *= 2
+	}
+	if duration > g.cfg.LockoutMax {
+		duration = g.cfg.LockoutMax
+	}
+	lockedUntil := now.Add(duration)
+	if err := g.attemptRepo.LockAccount(ctx, email, lockedUntil, duration); err != nil {
+		return err
+	}
+	g.audit(ctx, attempt, user, model.LoginOutcomeLockout)
+
+	if user == nil {
+		return nil
+	}
+	return g.sendUnlockEmail(ctx, user, email, lockedUntil, duration)
+}
+
+// RecordSuccess resets the failures and the lockout level of the account.
+func (g *LoginGuard) RecordSuccess(ctx context.Context, attempt model.LoginAttempt) error {
+	return g.attemptRepo.ClearAccountFailures(ctx, normalizeEmail(attempt.Email))
+}
+
+// UnlockAccount redeems the token of an unlock email.
+func (g *LoginGuard) UnlockAccount(ctx context.Context, token string) error {
+	email, err := g.attemptRepo.ConsumeUnlockToken(ctx, hashOpaqueToken(token))
+	if err != nil {
+		return err
+	}
+	return g.attemptRepo.UnlockAccount(ctx, email)
+}
+
+func (g *LoginGuard) sendUnlockEmail(ctx context.Context, user *model.User, email string, lockedUntil time.Time, ttl time.Duration) error {
+	token, err := generateOpaqueToken()
+	if err != nil {
+		return err
+	}
+	if err := g.attemptRepo.SaveUnlockToken(ctx, hashOpaqueToken(token), email, ttl); err != nil {
+		return err
+	}
+
+	link, err := url.Parse(g.cfg.UnlockURL)
+	if err != nil {
+		return err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return g.notifier.EnqueueAccountLockedEmail(ctx, model.SendAccountLockedEmailTask{
+		Email:       user.Email,
+		UnlockLink:  link.String(),
+		LockedUntil: lockedUntil,
+	})
+}
+
+// audit is best effort, a missing audit row must not turn a rejected login into a server error.
+func (g *LoginGuard) audit(ctx context.Context, attempt model.LoginAttempt, user *model.User, outcome model.LoginOutcome) {
+	email := attempt.Email
+	if len(email) > 255 {
+		email = email[:255]
+	}
+	audit := &model.LoginAudit{
+		Email:     email,
+		IP:        attempt.IP,
+		UserAgent: attempt.UserAgent,
+		Outcome:   outcome,
+	}
+	if user != nil {
+		audit.UserID = &user.ID
+	}
+	if err := g.auditRepo.CreateLoginAudit(ctx, audit); err != nil {
+		log.Println("error auditing login attempt", attempt.Email, outcome, err)
+	}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,247 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: loginguard.go
+//
+// Generated by this command:
+//
+//	mockgen -source=loginguard.go -destination=loginguard_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginAttemptRepository is a mock of LoginAttemptRepository interface.
+type MockLoginAttemptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAttemptRepositoryMockRecorder
+}
+
+// MockLoginAttemptRepositoryMockRecorder is the mock recorder for MockLoginAttemptRepository.
+type MockLoginAttemptRepositoryMockRecorder struct {
+	mock *MockLoginAttemptRepository
+}
+
+// NewMockLoginAttemptRepository creates a new mock instance.
+func NewMockLoginAttemptRepository(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+	mock := &MockLoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginAttemptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAttemptRepository) EXPECT() *MockLoginAttemptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClearAccountFailures mocks base method.
+func (m *MockLoginAttemptRepository) ClearAccountFailures(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearAccountFailures", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearAccountFailures indicates an expected call of ClearAccountFailures.
+func (mr *MockLoginAttemptRepositoryMockRecorder) ClearAccountFailures(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearAccountFailures", reflect.TypeOf((*MockLoginAttemptRepository)(nil).ClearAccountFailures), ctx, email)
+}
+
+// ConsumeUnlockToken mocks base method.
+func (m *MockLoginAttemptRepository) ConsumeUnlockToken(ctx context.Context, tokenHash string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeUnlockToken", ctx, tokenHash)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeUnlockToken indicates an expected call of ConsumeUnlockToken.
+func (mr *MockLoginAttemptRepositoryMockRecorder) ConsumeUnlockToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeUnlockToken", reflect.TypeOf((*MockLoginAttemptRepository)(nil).ConsumeUnlockToken), ctx, tokenHash)
+}
+
+// GetAccountLockedUntil mocks base method.
+func (m *MockLoginAttemptRepository) GetAccountLockedUntil(ctx context.Context, email string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountLockedUntil", ctx, email)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountLockedUntil indicates an expected call of GetAccountLockedUntil.
+func (mr *MockLoginAttemptRepositoryMockRecorder) GetAccountLockedUntil(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountLockedUntil", reflect.TypeOf((*MockLoginAttemptRepository)(nil).GetAccountLockedUntil), ctx, email)
+}
+
+// IncrementLockoutLevel mocks base method.
+func (m *MockLoginAttemptRepository) IncrementLockoutLevel(ctx context.Context, email string, levelTTL time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementLockoutLevel", ctx, email, levelTTL)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementLockoutLevel indicates an expected call of IncrementLockoutLevel.
+func (mr *MockLoginAttemptRepositoryMockRecorder) IncrementLockoutLevel(ctx, email, levelTTL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementLockoutLevel", reflect.TypeOf((*MockLoginAttemptRepository)(nil).IncrementLockoutLevel), ctx, email, levelTTL)
+}
+
+// LockAccount mocks base method.
+func (m *MockLoginAttemptRepository) LockAccount(ctx context.Context, email string, until time.Time, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAccount", ctx, email, until, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAccount indicates an expected call of LockAccount.
+func (mr *MockLoginAttemptRepositoryMockRecorder) LockAccount(ctx, email, until, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccount", reflect.TypeOf((*MockLoginAttemptRepository)(nil).LockAccount), ctx, email, until, ttl)
+}
+
+// RecordAccountFailure mocks base method.
+func (m *MockLoginAttemptRepository) RecordAccountFailure(ctx context.Context, email string, at time.Time, window time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAccountFailure", ctx, email, at, window)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordAccountFailure indicates an expected call of RecordAccountFailure.
+func (mr *MockLoginAttemptRepositoryMockRecorder) RecordAccountFailure(ctx, email, at, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAccountFailure", reflect.TypeOf((*MockLoginAttemptRepository)(nil).RecordAccountFailure), ctx, email, at, window)
+}
+
+// RecordIPAttempt mocks base method.
+func (m *MockLoginAttemptRepository) RecordIPAttempt(ctx context.Context, ip string, at time.Time, window time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordIPAttempt", ctx, ip, at, window)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordIPAttempt indicates an expected call of RecordIPAttempt.
+func (mr *MockLoginAttemptRepositoryMockRecorder) RecordIPAttempt(ctx, ip, at, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordIPAttempt", reflect.TypeOf((*MockLoginAttemptRepository)(nil).RecordIPAttempt), ctx, ip, at, window)
+}
+
+// SaveUnlockToken mocks base method.
+func (m *MockLoginAttemptRepository) SaveUnlockToken(ctx context.Context, tokenHash, email string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveUnlockToken", ctx, tokenHash, email, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveUnlockToken indicates an expected call of SaveUnlockToken.
+func (mr *MockLoginAttemptRepositoryMockRecorder) SaveUnlockToken(ctx, tokenHash, email, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUnlockToken", reflect.TypeOf((*MockLoginAttemptRepository)(nil).SaveUnlockToken), ctx, tokenHash, email, ttl)
+}
+
+// UnlockAccount mocks base method.
+func (m *MockLoginAttemptRepository) UnlockAccount(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockAccount", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockAccount indicates an expected call of UnlockAccount.
+func (mr *MockLoginAttemptRepositoryMockRecorder) UnlockAccount(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAccount", reflect.TypeOf((*MockLoginAttemptRepository)(nil).UnlockAccount), ctx, email)
+}
+
+// MockLoginAuditRepository is a mock of LoginAuditRepository interface.
+type MockLoginAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginAuditRepositoryMockRecorder
+}
+
+// MockLoginAuditRepositoryMockRecorder is the mock recorder for MockLoginAuditRepository.
+type MockLoginAuditRepositoryMockRecorder struct {
+	mock *MockLoginAuditRepository
+}
+
+// NewMockLoginAuditRepository creates a new mock instance.
+func NewMockLoginAuditRepository(ctrl *gomock.Controller) *MockLoginAuditRepository {
+	mock := &MockLoginAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockLoginAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginAuditRepository) EXPECT() *MockLoginAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateLoginAudit mocks base method.
+func (m *MockLoginAuditRepository) CreateLoginAudit(ctx context.Context, audit *model.LoginAudit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoginAudit", ctx, audit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLoginAudit indicates an expected call of CreateLoginAudit.
+func (mr *MockLoginAuditRepositoryMockRecorder) CreateLoginAudit(ctx, audit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoginAudit", reflect.TypeOf((*MockLoginAuditRepository)(nil).CreateLoginAudit), ctx, audit)
+}
+
+// MockAccountLockNotifier is a mock of AccountLockNotifier interface.
+type MockAccountLockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountLockNotifierMockRecorder
+}
+
+// MockAccountLockNotifierMockRecorder is the mock recorder for MockAccountLockNotifier.
+type MockAccountLockNotifierMockRecorder struct {
+	mock *MockAccountLockNotifier
+}
+
+// NewMockAccountLockNotifier creates a new mock instance.
+func NewMockAccountLockNotifier(ctrl *gomock.Controller) *MockAccountLockNotifier {
+	mock := &MockAccountLockNotifier{ctrl: ctrl}
+	mock.recorder = &MockAccountLockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountLockNotifier) EXPECT() *MockAccountLockNotifierMockRecorder {
+	return m.recorder
+}
+
+// EnqueueAccountLockedEmail mocks base method.
+func (m *MockAccountLockNotifier) EnqueueAccountLockedEmail(ctx context.Context, task model.SendAccountLockedEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueAccountLockedEmail", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueAccountLockedEmail indicates an expected call of EnqueueAccountLockedEmail.
+func (mr *MockAccountLockNotifierMockRecorder) EnqueueAccountLockedEmail(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueAccountLockedEmail", reflect.TypeOf((*MockAccountLockNotifier)(nil).EnqueueAccountLockedEmail), ctx, task)
+}
@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+var loginGuardConfig = LoginGuardConfig{
+	IPWindow:           15 * time.Minute,
+	IPMaxAttempts:      50,
+	AccountWindow:      15 * time.Minute,
+	AccountMaxFailures: 5,
+	LockoutBase:        15 * time.Minute,
+	LockoutMax:         time.Hour,
+	UnlockURL:          "http://localhost:8083/api/v1/unlock-account",
+}
+
+func TestLoginGuard_CheckAttempt(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Second)
+	attempt := model.LoginAttempt{Email: " Test@Example.com", Password: "password", IP: "10.0.0.1"}
+
+	tests := []struct {
+		name               string
+		mockAttemptRepo    func(ctrl *gomock.Controller) *MockLoginAttemptRepository
+		mockAuditRepo      func(ctrl *gomock.Controller) *MockLoginAuditRepository
+		expectedRetryAfter time.Duration
+	}{
+		{
+			name: "Allowed attempt",
+			mockAttemptRepo: func(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+				mock := NewMockLoginAttemptRepository(ctrl)
+				mock.EXPECT().GetAccountLockedUntil(gomock.Any(), "test@example.com").Return(time.Time{}, nil)
+				mock.EXPECT().RecordIPAttempt(gomock.Any(), "10.0.0.1", now, 15*time.Minute).Return(int64(50), nil)
+				return mock
+			},
+			mockAuditRepo: func(ctrl *gomock.Controller) *MockLoginAuditRepository {
+				return NewMockLoginAuditRepository(ctrl)
+			},
+		},
+		{
+			name: "Locked account",
+			mockAttemptRepo: func(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+				mock := NewMockLoginAttemptRepository(ctrl)
+				mock.EXPECT().GetAccountLockedUntil(gomock.Any(), "test@example.com").Return(now.Add(10*time.Minute), nil)
+				return mock
+			},
+			mockAuditRepo: func(ctrl *gomock.Controller) *MockLoginAuditRepository {
+				mock := NewMockLoginAuditRepository(ctrl)
+				mock.EXPECT().CreateLoginAudit(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, audit *model.LoginAudit) error {
+					assert.Equal(t, model.LoginOutcomeLocked, audit.Outcome)
+					return nil
+				})
+				return mock
+			},
+			expectedRetryAfter: 10 * time.Minute,
+		},
+		{
+			name: "IP over budget",
+			mockAttemptRepo: func(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+				mock := NewMockLoginAttemptRepository(ctrl)
+				mock.EXPECT().GetAccountLockedUntil(gomock.Any(), "test@example.com").Return(time.Time{}, nil)
+				mock.EXPECT().RecordIPAttempt(gomock.Any(), "10.0.0.1", now, 15*time.Minute).Return(int64(51), nil)
+				return mock
+			},
+			mockAuditRepo: func(ctrl *gomock.Controller) *MockLoginAuditRepository {
+				mock := NewMockLoginAuditRepository(ctrl)
+				mock.EXPECT().CreateLoginAudit(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, audit *model.LoginAudit) error {
+					assert.Equal(t, model.LoginOutcomeRateLimited, audit.Outcome)
+					assert.Equal(t, "10.0.0.1", audit.IP)
+					return nil
+				})
+				return mock
+			},
+			expectedRetryAfter: 15 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			guard := NewLoginGuard(tt.mockAttemptRepo(ctrl), tt.mockAuditRepo(ctrl), NewMockAccountLockNotifier(ctrl), func() time.Time { return now }, loginGuardConfig)
+			err := guard.CheckAttempt(context.Background(), attempt)
+			if tt.expectedRetryAfter == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, model.ErrTooManyLoginAttempts)
+			throttledErr, ok := err.(*model.LoginThrottledError)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedRetryAfter, throttledErr.RetryAfter)
+		})
+	}
+}
+
+func TestLoginGuard_RecordFailure(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Second)
+	attempt := model.LoginAttempt{Email: "test@example.com", Password: "wrongpassword", IP: "10.0.0.1"}
+	user := &model.User{ID: 1, Email: "test@example.com"}
+
+	tests := []struct {
+		name            string
+		user            *model.User
+		mockAttemptRepo func(ctrl *gomock.Controller) *MockLoginAttemptRepository
+		mockNotifier    func(ctrl *gomock.Controller) *MockAccountLockNotifier
+	}{
+		{
+			name: "Below the threshold",
+			user: user,
+			mockAttemptRepo: func(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+				mock := NewMockLoginAttemptRepository(ctrl)
+				mock.EXPECT().RecordAccountFailure(gomock.Any(), "test@example.com", now, 15*time.Minute).Return(int64(4), nil)
+				return mock
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockAccountLockNotifier {
+				return NewMockAccountLockNotifier(ctrl)
+			},
+		},
+		{
+			name: "First lockout sends the unlock email",
+			user: user,
+			mockAttemptRepo: func(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+				mock := NewMockLoginAttemptRepository(ctrl)
+				mock.EXPECT().RecordAccountFailure(gomock.Any(), "test@example.com", now, 15*time.Minute).Return(int64(5), nil)
+				mock.EXPECT().IncrementLockoutLevel(gomock.Any(), "test@example.com", 2*time.Hour).Return(int64(1), nil)
+				mock.EXPECT().LockAccount(gomock.Any(), "test@example.com", now.Add(15*time.Minute), 15*time.Minute).Return(nil)
+				mock.EXPECT().SaveUnlockToken(gomock.Any(), gomock.Any(), "test@example.com", 15*time.Minute).Return(nil)
+				return mock
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockAccountLockNotifier {
+				mock := NewMockAccountLockNotifier(ctrl)
+				mock.EXPECT().EnqueueAccountLockedEmail(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, task model.SendAccountLockedEmailTask) error {
+					assert.Equal(t, "test@example.com", task.Email)
+					assert.Equal(t, now.Add(15*time.Minute), task.LockedUntil)
+					link, err := url.Parse(task.UnlockLink)
+					assert.NoError(t, err)
+					assert.NotEmpty(t, link.Query().Get("token"))
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "Repeated lockouts grow up to the max",
+			user: user,
+			mockAttemptRepo: func(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+				mock := NewMockLoginAttemptRepository(ctrl)
+				mock.EXPECT().RecordAccountFailure(gomock.Any(), "test@example.com", now, 15*time.Minute).Return(int64(5), nil)
+				mock.EXPECT().IncrementLockoutLevel(gomock.Any(), "test@example.com", 2*time.Hour).Return(int64(4), nil)
+				mock.EXPECT().LockAccount(gomock.Any(), "test@example.com", now.Add(time.Hour), time.Hour).Return(nil)
+				mock.EXPECT().SaveUnlockToken(gomock.Any(), gomock.Any(), "test@example.com", time.Hour).Return(nil)
+				return mock
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockAccountLockNotifier {
+				mock := NewMockAccountLockNotifier(ctrl)
+				mock.EXPECT().EnqueueAccountLockedEmail(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Unknown email is locked without email",
+			user: nil,
+			mockAttemptRepo: func(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+				mock := NewMockLoginAttemptRepository(ctrl)
+				mock.EXPECT().RecordAccountFailure(gomock.Any(), "test@example.com", now, 15*time.Minute).Return(int64(5), nil)
+				mock.EXPECT().IncrementLockoutLevel(gomock.Any(), "test@example.com", 2*time.Hour).Return(int64(2), nil)
+				mock.EXPECT().LockAccount(gomock.Any(), "test@example.com", now.Add(30*time.Minute), 30*time.Minute).Return(nil)
+				return mock
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockAccountLockNotifier {
+				return NewMockAccountLockNotifier(ctrl)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			auditRepo := NewMockLoginAuditRepository(ctrl)
+			auditRepo.EXPECT().CreateLoginAudit(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, audit *model.LoginAudit) error {
+				if tt.user != nil {
+					assert.Equal(t, tt.user.ID, *audit.UserID)
+				} else {
+					assert.Nil(t, audit.UserID)
+				}
+				return nil
+			}).MinTimes(1)
+
+			guard := NewLoginGuard(tt.mockAttemptRepo(ctrl), auditRepo, tt.mockNotifier(ctrl), func() time.Time { return now }, loginGuardConfig)
+			assert.NoError(t, guard.RecordFailure(context.Background(), attempt, tt.user))
+		})
+	}
+}
+
+func TestLoginGuard_UnlockAccount(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		mockAttemptRepo func(ctrl *gomock.Controller) *MockLoginAttemptRepository
+		expectedError   error
+	}{
+		{
+			name: "Valid token",
+			mockAttemptRepo: func(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+				mock := NewMockLoginAttemptRepository(ctrl)
+				mock.EXPECT().ConsumeUnlockToken(gomock.Any(), hashOpaqueToken("unlock-token")).Return("test@example.com", nil)
+				mock.EXPECT().UnlockAccount(gomock.Any(), "test@example.com").Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Used or expired token",
+			mockAttemptRepo: func(ctrl *gomock.Controller) *MockLoginAttemptRepository {
+				mock := NewMockLoginAttemptRepository(ctrl)
+				mock.EXPECT().ConsumeUnlockToken(gomock.Any(), hashOpaqueToken("unlock-token")).Return("", model.ErrInvalidUnlockToken)
+				return mock
+			},
+			expectedError: model.ErrInvalidUnlockToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			guard := NewLoginGuard(tt.mockAttemptRepo(ctrl), NewMockLoginAuditRepository(ctrl), NewMockAccountLockNotifier(ctrl), time.Now, loginGuardConfig)
+			err := guard.UnlockAccount(context.Background(), "unlock-token")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
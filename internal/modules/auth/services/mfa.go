@@ -0,0 +1,167 @@
+//go:generate mockgen -source=mfa.go -destination=mfa_mock.go -package=services
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"booking-event/internal/infra/totp"
+	"booking-event/internal/modules/auth/model"
+)
+
+type UserRepositoryForMFA interface {
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+}
+
+type MFARepository interface {
+	GetUserMFA(ctx context.Context, userID int) (*model.UserMFA, error)
+	SavePendingMFA(ctx context.Context, userID int, secret string) error
+	EnableMFA(ctx context.Context, userID int, step int64, recoveryCodeHashes []string) error
+	UseTOTPStep(ctx context.Context, userID int, step int64) error
+	UseRecoveryCode(ctx context.Context, userID int, codeHash string) error
+	DeleteMFA(ctx context.Context, userID int) error
+}
+
+type MFAConfig struct {
+	Issuer        string // shown by the authenticator apps next to the account
+	RequiredRoles []model.UserRole
+	RecoveryCodes int
+}
+
+// MFAService manages the TOTP second factor of the users. The recovery codes handed out when the enrollment is
+// confirmed each replace one TOTP code once, only their hashes are stored.
+type MFAService struct {
+	userRepo UserRepositoryForMFA
+	mfaRepo  MFARepository
+	nowFn    func() time.Time
+	cfg      MFAConfig
+}
+
+func NewMFAService(userRepo UserRepositoryForMFA, mfaRepo MFARepository, nowFn func() time.Time, cfg MFAConfig) *MFAService {
+	return &MFAService{
+		userRepo: userRepo,
+		mfaRepo:  mfaRepo,
+		nowFn:    nowFn,
+		cfg:      cfg,
+	}
+}
+
+// RequiresMFA tells whether the user enabled MFA and whether the role of the user requires it.
+func (s *MFAService) RequiresMFA(ctx context.Context, user *model.User) (bool, bool, error) {
+	required := s.roleRequiresMFA(user.Role)
+	mfa, err := s.mfaRepo.GetUserMFA(ctx, user.ID)
+	if errors.Is(err, model.ErrMFANotEnrolled) {
+		return false, required, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+	return mfa.Enabled(), required, nil
+}
+
+// Enroll starts an enrollment with a new secret, it replaces a pending enrollment which was never confirmed.
+func (s *MFAService) Enroll(ctx context.Context, userID int) (*model.MFAEnrollment, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfaRepo.SavePendingMFA(ctx, user.ID, secret); err != nil {
+		return nil, err
+	}
+	return &model.MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(s.cfg.Issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment enables MFA once the code proves the app holds the secret and returns the recovery codes,
+// they are never shown again.
+func (s *MFAService) ConfirmEnrollment(ctx context.Context, request model.MFACodeRequest) ([]string, error) {
+	mfa, err := s.mfaRepo.GetUserMFA(ctx, request.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if mfa.Enabled() {
+		return nil, model.ErrMFAAlreadyEnabled
+	}
+	step, ok := totp.Validate(mfa.Secret, request.Code, s.nowFn())
+	if !ok {
+		return nil, model.ErrInvalidMFACode
+	}
+
+	codes := make([]string, s.cfg.RecoveryCodes)
+	hashes := make([]string, s.cfg.RecoveryCodes)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+		hashes[i] = hashOpaqueToken(normalizeRecoveryCode(code))
+	}
+	if err := s.mfaRepo.EnableMFA(ctx, request.UserID, step, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// VerifyCode accepts a TOTP code not used before or an unused recovery code.
+func (s *MFAService) VerifyCode(ctx context.Context, userID int, code string) error {
+	mfa, err := s.mfaRepo.GetUserMFA(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !mfa.Enabled() {
+		return model.ErrMFANotEnrolled
+	}
+	if step, ok := totp.Validate(mfa.Secret, code, s.nowFn()); ok {
+		return s.mfaRepo.UseTOTPStep(ctx, userID, step)
+	}
+	return s.mfaRepo.UseRecoveryCode(ctx, userID, hashOpaqueToken(normalizeRecoveryCode(code)))
+}
+
+// Disable removes the second factor after checking a code, users of a role requiring MFA can not disable it.
+func (s *MFAService) Disable(ctx context.Context, request model.MFACodeRequest) error {
+	user, err := s.userRepo.GetUserByID(ctx, request.UserID)
+	if err != nil {
+		return err
+	}
+	if s.roleRequiresMFA(user.Role) {
+		return model.ErrMFARequired
+	}
+	if err := s.VerifyCode(ctx, user.ID, request.Code); err != nil {
+		return err
+	}
+	return s.mfaRepo.DeleteMFA(ctx, user.ID)
+}
+
+func (s *MFAService) roleRequiresMFA(role model.UserRole) bool {
+	for _, required := range s.cfg.RequiredRoles {
+		if role == required {
+			return true
+		}
+	}
+	return false
+}
+
+// generateRecoveryCode returns 40 random bits as XXXX-XXXX.
+func generateRecoveryCode() (string, error) {
+	buf := make([]byte, 5)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	code := base32.StdEncoding.EncodeToString(buf)
+	return code[:4] + "-" + code[4:], nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
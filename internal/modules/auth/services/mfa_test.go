@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/infra/totp"
+	"booking-event/internal/modules/auth/model"
+)
+
+var mfaConfig = MFAConfig{
+	Issuer:        "Booking Event",
+	RequiredRoles: []model.UserRole{model.RoleAdmin, model.RoleOrganizer},
+	RecoveryCodes: 10,
+}
+
+func TestMFAService_Enroll(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var storedSecret string
+	userRepo := NewMockUserRepositoryForMFA(ctrl)
+	userRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Email: "admin@example.com", Role: model.RoleAdmin}, nil)
+	mfaRepo := NewMockMFARepository(ctrl)
+	mfaRepo.EXPECT().SavePendingMFA(gomock.Any(), 1, gomock.Any()).DoAndReturn(func(ctx context.Context, userID int, secret string) error {
+		storedSecret = secret
+		return nil
+	})
+
+	service := NewMFAService(userRepo, mfaRepo, time.Now, mfaConfig)
+	enrollment, err := service.Enroll(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, storedSecret, enrollment.Secret)
+	uri, err := url.Parse(enrollment.ProvisioningURI)
+	assert.NoError(t, err)
+	assert.Equal(t, storedSecret, uri.Query().Get("secret"))
+}
+
+func TestMFAService_ConfirmEnrollment(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+	code, err := totp.Code(secret, totp.Step(now))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		code          string
+		mockMFARepo   func(ctrl *gomock.Controller) *MockMFARepository
+		expectedError error
+	}{
+		{
+			name: "Valid first code",
+			code: code,
+			mockMFARepo: func(ctrl *gomock.Controller) *MockMFARepository {
+				mock := NewMockMFARepository(ctrl)
+				mock.EXPECT().GetUserMFA(gomock.Any(), 1).Return(&model.UserMFA{UserID: 1, Secret: secret}, nil)
+				mock.EXPECT().EnableMFA(gomock.Any(), 1, totp.Step(now), gomock.Any()).DoAndReturn(func(ctx context.Context, userID int, step int64, hashes []string) error {
+					assert.Len(t, hashes, 10)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "Wrong code",
+			code: "000000",
+			mockMFARepo: func(ctrl *gomock.Controller) *MockMFARepository {
+				mock := NewMockMFARepository(ctrl)
+				mock.EXPECT().GetUserMFA(gomock.Any(), 1).Return(&model.UserMFA{UserID: 1, Secret: secret}, nil)
+				return mock
+			},
+			expectedError: model.ErrInvalidMFACode,
+		},
+		{
+			name: "Already enabled",
+			code: code,
+			mockMFARepo: func(ctrl *gomock.Controller) *MockMFARepository {
+				mock := NewMockMFARepository(ctrl)
+				mock.EXPECT().GetUserMFA(gomock.Any(), 1).Return(&model.UserMFA{UserID: 1, Secret: secret, EnabledAt: &now}, nil)
+				return mock
+			},
+			expectedError: model.ErrMFAAlreadyEnabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewMFAService(NewMockUserRepositoryForMFA(ctrl), tt.mockMFARepo(ctrl), func() time.Time { return now }, mfaConfig)
+			recoveryCodes, err := service.ConfirmEnrollment(context.Background(), model.MFACodeRequest{Code: tt.code, UserID: 1})
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, recoveryCodes, 10)
+			}
+		})
+	}
+}
+
+func TestMFAService_VerifyCode(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+	code, err := totp.Code(secret, totp.Step(now))
+	assert.NoError(t, err)
+	enabled := &model.UserMFA{UserID: 1, Secret: secret, EnabledAt: &now}
+
+	tests := []struct {
+		name          string
+		code          string
+		mockMFARepo   func(ctrl *gomock.Controller) *MockMFARepository
+		expectedError error
+	}{
+		{
+			name: "TOTP code",
+			code: code,
+			mockMFARepo: func(ctrl *gomock.Controller) *MockMFARepository {
+				mock := NewMockMFARepository(ctrl)
+				mock.EXPECT().GetUserMFA(gomock.Any(), 1).Return(enabled, nil)
+				mock.EXPECT().UseTOTPStep(gomock.Any(), 1, totp.Step(now)).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Replayed TOTP code",
+			code: code,
+			mockMFARepo: func(ctrl *gomock.Controller) *MockMFARepository {
+				mock := NewMockMFARepository(ctrl)
+				mock.EXPECT().GetUserMFA(gomock.Any(), 1).Return(enabled, nil)
+				mock.EXPECT().UseTOTPStep(gomock.Any(), 1, totp.Step(now)).Return(model.ErrInvalidMFACode)
+				return mock
+			},
+			expectedError: model.ErrInvalidMFACode,
+		},
+		{
+			name: "Recovery code typed in lower case",
+			code: "abcd-efgh",
+			mockMFARepo: func(ctrl *gomock.Controller) *MockMFARepository {
+				mock := NewMockMFARepository(ctrl)
+				mock.EXPECT().GetUserMFA(gomock.Any(), 1).Return(enabled, nil)
+				mock.EXPECT().UseRecoveryCode(gomock.Any(), 1, hashOpaqueToken("ABCDEFGH")).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Pending enrollment",
+			code: code,
+			mockMFARepo: func(ctrl *gomock.Controller) *MockMFARepository {
+				mock := NewMockMFARepository(ctrl)
+				mock.EXPECT().GetUserMFA(gomock.Any(), 1).Return(&model.UserMFA{UserID: 1, Secret: secret}, nil)
+				return mock
+			},
+			expectedError: model.ErrMFANotEnrolled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewMFAService(NewMockUserRepositoryForMFA(ctrl), tt.mockMFARepo(ctrl), func() time.Time { return now }, mfaConfig)
+			err := service.VerifyCode(context.Background(), 1, tt.code)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMFAService_Disable(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userRepo := NewMockUserRepositoryForMFA(ctrl)
+	userRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Role: model.RoleOrganizer}, nil)
+
+	service := NewMFAService(userRepo, NewMockMFARepository(ctrl), time.Now, mfaConfig)
+	err := service.Disable(context.Background(), model.MFACodeRequest{Code: "123456", UserID: 1})
+	assert.ErrorIs(t, err, model.ErrMFARequired)
+}
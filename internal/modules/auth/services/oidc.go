@@ -0,0 +1,168 @@
+//go:generate mockgen -source=oidc.go -destination=oidc_mock.go -package=services
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-event/internal/infra/oidc"
+	"booking-event/internal/modules/auth/model"
+)
+
+type IdentityProvider interface {
+	AuthCodeURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*oidc.Claims, error)
+}
+
+type OIDCStateRepository interface {
+	SaveOIDCState(ctx context.Context, state string, pending model.OIDCState, ttl time.Duration) error
+	ConsumeOIDCState(ctx context.Context, state string) (*model.OIDCState, error)
+}
+
+type IdentityRepository interface {
+	GetUserIDByIdentity(ctx context.Context, provider string, subject string) (int, error)
+	LinkIdentity(ctx context.Context, identity *model.UserIdentity) error
+	CreateUserWithIdentity(ctx context.Context, user *model.User, identity *model.UserIdentity) error
+}
+
+type UserRepositoryForOIDC interface {
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+}
+
+type LoginStarter interface {
+	StartLogin(ctx context.Context, user *model.User) (*model.TokenPair, *model.MFAChallenge, error)
+}
+
+type OIDCConfig struct {
+	StateTTL time.Duration // time left to the user to log in at the provider
+}
+
+// OIDCService logs users in with external identity providers using the authorization code flow with PKCE. The
+// identities are linked to the users by their verified email, users logging in for the first time are created.
+type OIDCService struct {
+	providers    map[string]IdentityProvider
+	stateRepo    OIDCStateRepository
+	identityRepo IdentityRepository
+	userRepo     UserRepositoryForOIDC
+	loginStarter LoginStarter
+	cfg          OIDCConfig
+}
+
+func NewOIDCService(
+	providers map[string]IdentityProvider,
+	stateRepo OIDCStateRepository,
+	identityRepo IdentityRepository,
+	userRepo UserRepositoryForOIDC,
+	loginStarter LoginStarter,
+	cfg OIDCConfig,
+) *OIDCService {
+	return &OIDCService{
+		providers:    providers,
+		stateRepo:    stateRepo,
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		loginStarter: loginStarter,
+		cfg:          cfg,
+	}
+}
+
+// AuthorizationURL starts a login with the provider and returns the URL the user is redirected to.
+func (s *OIDCService) AuthorizationURL(ctx context.Context, providerName string) (string, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return "", model.ErrUnknownIdentityProvider
+	}
+	state, err := generateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := generateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	verifier, err := oidc.NewCodeVerifier()
+	if err != nil {
+		return "", err
+	}
+	if err := s.stateRepo.SaveOIDCState(ctx, state, model.OIDCState{
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+	}, s.cfg.StateTTL); err != nil {
+		return "", err
+	}
+	return provider.AuthCodeURL(ctx, state, nonce, oidc.CodeChallenge(verifier))
+}
+
+// Callback completes the login when the provider redirects the user back. Like a password login it returns the
+// tokens, or a challenge when the user needs a second factor.
+func (s *OIDCService) Callback(ctx context.Context, request model.OIDCCallbackRequest) (*model.User, *model.TokenPair, *model.MFAChallenge, error) {
+	provider, ok := s.providers[request.Provider]
+	if !ok {
+		return nil, nil, nil, model.ErrUnknownIdentityProvider
+	}
+	pending, err := s.stateRepo.ConsumeOIDCState(ctx, request.State)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if pending.Provider != request.Provider {
+		return nil, nil, nil, model.ErrInvalidOIDCState
+	}
+
+	claims, err := provider.Exchange(ctx, request.Code, pending.CodeVerifier, pending.Nonce)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %v", model.ErrOIDCLoginFailed, err)
+	}
+	user, err := s.resolveUser(ctx, request.Provider, claims)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tokenPair, challenge, err := s.loginStarter.StartLogin(ctx, user)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return user, tokenPair, challenge, nil
+}
+
+// resolveUser finds the user of the identity, linking it by email the first time.
+func (s *OIDCService) resolveUser(ctx context.Context, providerName string, claims *oidc.Claims) (*model.User, error) {
+	userID, err := s.identityRepo.GetUserIDByIdentity(ctx, providerName, claims.Subject)
+	if err == nil {
+		return s.userRepo.GetUserByID(ctx, userID)
+	}
+	if !errors.Is(err, model.ErrIdentityNotFound) {
+		return nil, err
+	}
+
+	// an unverified email could be anyone's, linking it would hand over the account
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, model.ErrOIDCEmailNotVerified
+	}
+	identity := &model.UserIdentity{Provider: providerName, Subject: claims.Subject, Email: claims.Email}
+	user, err := s.userRepo.GetUserByEmail(ctx, claims.Email)
+	if errors.Is(err, model.ErrUserNotFound) {
+		user = &model.User{
+			Email:  claims.Email,
+			Role:   model.RoleUser,
+			Status: model.UserStatusActive,
+		}
+		if err := s.identityRepo.CreateUserWithIdentity(ctx, user, identity); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	identity.UserID = user.ID
+	if err := s.identityRepo.LinkIdentity(ctx, identity); err != nil {
+		return nil, err
+	}
+	// the link may have activated the user
+	return s.userRepo.GetUserByID(ctx, user.ID)
+}
@@ -0,0 +1,283 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oidc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=oidc.go -destination=oidc_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	oidc "booking-event/internal/infra/oidc"
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdentityProvider is a mock of IdentityProvider interface.
+type MockIdentityProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityProviderMockRecorder
+}
+
+// MockIdentityProviderMockRecorder is the mock recorder for MockIdentityProvider.
+type MockIdentityProviderMockRecorder struct {
+	mock *MockIdentityProvider
+}
+
+// NewMockIdentityProvider creates a new mock instance.
+func NewMockIdentityProvider(ctrl *gomock.Controller) *MockIdentityProvider {
+	mock := &MockIdentityProvider{ctrl: ctrl}
+	mock.recorder = &MockIdentityProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityProvider) EXPECT() *MockIdentityProviderMockRecorder {
+	return m.recorder
+}
+
+// AuthCodeURL mocks base method.
+func (m *MockIdentityProvider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthCodeURL", ctx, state, nonce, codeChallenge)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthCodeURL indicates an expected call of AuthCodeURL.
+func (mr *MockIdentityProviderMockRecorder) AuthCodeURL(ctx, state, nonce, codeChallenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthCodeURL", reflect.TypeOf((*MockIdentityProvider)(nil).AuthCodeURL), ctx, state, nonce, codeChallenge)
+}
+
+// Exchange mocks base method.
+func (m *MockIdentityProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*oidc.Claims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exchange", ctx, code, codeVerifier, nonce)
+	ret0, _ := ret[0].(*oidc.Claims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exchange indicates an expected call of Exchange.
+func (mr *MockIdentityProviderMockRecorder) Exchange(ctx, code, codeVerifier, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exchange", reflect.TypeOf((*MockIdentityProvider)(nil).Exchange), ctx, code, codeVerifier, nonce)
+}
+
+// MockOIDCStateRepository is a mock of OIDCStateRepository interface.
+type MockOIDCStateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCStateRepositoryMockRecorder
+}
+
+// MockOIDCStateRepositoryMockRecorder is the mock recorder for MockOIDCStateRepository.
+type MockOIDCStateRepositoryMockRecorder struct {
+	mock *MockOIDCStateRepository
+}
+
+// NewMockOIDCStateRepository creates a new mock instance.
+func NewMockOIDCStateRepository(ctrl *gomock.Controller) *MockOIDCStateRepository {
+	mock := &MockOIDCStateRepository{ctrl: ctrl}
+	mock.recorder = &MockOIDCStateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCStateRepository) EXPECT() *MockOIDCStateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConsumeOIDCState mocks base method.
+func (m *MockOIDCStateRepository) ConsumeOIDCState(ctx context.Context, state string) (*model.OIDCState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeOIDCState", ctx, state)
+	ret0, _ := ret[0].(*model.OIDCState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeOIDCState indicates an expected call of ConsumeOIDCState.
+func (mr *MockOIDCStateRepositoryMockRecorder) ConsumeOIDCState(ctx, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeOIDCState", reflect.TypeOf((*MockOIDCStateRepository)(nil).ConsumeOIDCState), ctx, state)
+}
+
+// SaveOIDCState mocks base method.
+func (m *MockOIDCStateRepository) SaveOIDCState(ctx context.Context, state string, pending model.OIDCState, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOIDCState", ctx, state, pending, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveOIDCState indicates an expected call of SaveOIDCState.
+func (mr *MockOIDCStateRepositoryMockRecorder) SaveOIDCState(ctx, state, pending, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOIDCState", reflect.TypeOf((*MockOIDCStateRepository)(nil).SaveOIDCState), ctx, state, pending, ttl)
+}
+
+// MockIdentityRepository is a mock of IdentityRepository interface.
+type MockIdentityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityRepositoryMockRecorder
+}
+
+// MockIdentityRepositoryMockRecorder is the mock recorder for MockIdentityRepository.
+type MockIdentityRepositoryMockRecorder struct {
+	mock *MockIdentityRepository
+}
+
+// NewMockIdentityRepository creates a new mock instance.
+func NewMockIdentityRepository(ctrl *gomock.Controller) *MockIdentityRepository {
+	mock := &MockIdentityRepository{ctrl: ctrl}
+	mock.recorder = &MockIdentityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityRepository) EXPECT() *MockIdentityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateUserWithIdentity mocks base method.
+func (m *MockIdentityRepository) CreateUserWithIdentity(ctx context.Context, user *model.User, identity *model.UserIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserWithIdentity", ctx, user, identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserWithIdentity indicates an expected call of CreateUserWithIdentity.
+func (mr *MockIdentityRepositoryMockRecorder) CreateUserWithIdentity(ctx, user, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserWithIdentity", reflect.TypeOf((*MockIdentityRepository)(nil).CreateUserWithIdentity), ctx, user, identity)
+}
+
+// GetUserIDByIdentity mocks base method.
+func (m *MockIdentityRepository) GetUserIDByIdentity(ctx context.Context, provider, subject string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByIdentity", ctx, provider, subject)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByIdentity indicates an expected call of GetUserIDByIdentity.
+func (mr *MockIdentityRepositoryMockRecorder) GetUserIDByIdentity(ctx, provider, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByIdentity", reflect.TypeOf((*MockIdentityRepository)(nil).GetUserIDByIdentity), ctx, provider, subject)
+}
+
+// LinkIdentity mocks base method.
+func (m *MockIdentityRepository) LinkIdentity(ctx context.Context, identity *model.UserIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkIdentity", ctx, identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkIdentity indicates an expected call of LinkIdentity.
+func (mr *MockIdentityRepositoryMockRecorder) LinkIdentity(ctx, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkIdentity", reflect.TypeOf((*MockIdentityRepository)(nil).LinkIdentity), ctx, identity)
+}
+
+// MockUserRepositoryForOIDC is a mock of UserRepositoryForOIDC interface.
+type MockUserRepositoryForOIDC struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryForOIDCMockRecorder
+}
+
+// MockUserRepositoryForOIDCMockRecorder is the mock recorder for MockUserRepositoryForOIDC.
+type MockUserRepositoryForOIDCMockRecorder struct {
+	mock *MockUserRepositoryForOIDC
+}
+
+// NewMockUserRepositoryForOIDC creates a new mock instance.
+func NewMockUserRepositoryForOIDC(ctrl *gomock.Controller) *MockUserRepositoryForOIDC {
+	mock := &MockUserRepositoryForOIDC{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryForOIDCMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepositoryForOIDC) EXPECT() *MockUserRepositoryForOIDCMockRecorder {
+	return m.recorder
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockUserRepositoryForOIDC) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockUserRepositoryForOIDCMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUserRepositoryForOIDC)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserRepositoryForOIDC) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryForOIDCMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepositoryForOIDC)(nil).GetUserByID), ctx, id)
+}
+
+// MockLoginStarter is a mock of LoginStarter interface.
+type MockLoginStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginStarterMockRecorder
+}
+
+// MockLoginStarterMockRecorder is the mock recorder for MockLoginStarter.
+type MockLoginStarterMockRecorder struct {
+	mock *MockLoginStarter
+}
+
+// NewMockLoginStarter creates a new mock instance.
+func NewMockLoginStarter(ctrl *gomock.Controller) *MockLoginStarter {
+	mock := &MockLoginStarter{ctrl: ctrl}
+	mock.recorder = &MockLoginStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginStarter) EXPECT() *MockLoginStarterMockRecorder {
+	return m.recorder
+}
+
+// StartLogin mocks base method.
+func (m *MockLoginStarter) StartLogin(ctx context.Context, user *model.User) (*model.TokenPair, *model.MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLogin", ctx, user)
+	ret0, _ := ret[0].(*model.TokenPair)
+	ret1, _ := ret[1].(*model.MFAChallenge)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StartLogin indicates an expected call of StartLogin.
+func (mr *MockLoginStarterMockRecorder) StartLogin(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLogin", reflect.TypeOf((*MockLoginStarter)(nil).StartLogin), ctx, user)
+}
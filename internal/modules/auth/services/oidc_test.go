@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/infra/oidc"
+	"booking-event/internal/infra/oidc/oidctest"
+	"booking-event/internal/modules/auth/model"
+)
+
+// statesInMemory backs the state repository mock with a map so the flow can go through the real provider client.
+func statesInMemory(ctrl *gomock.Controller) *MockOIDCStateRepository {
+	states := make(map[string]model.OIDCState)
+	mock := NewMockOIDCStateRepository(ctrl)
+	mock.EXPECT().SaveOIDCState(gomock.Any(), gomock.Any(), gomock.Any(), 10*time.Minute).DoAndReturn(func(ctx context.Context, state string, pending model.OIDCState, ttl time.Duration) error {
+		states[state] = pending
+		return nil
+	}).AnyTimes()
+	mock.EXPECT().ConsumeOIDCState(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, state string) (*model.OIDCState, error) {
+		pending, ok := states[state]
+		if !ok {
+			return nil, model.ErrInvalidOIDCState
+		}
+		delete(states, state)
+		return &pending, nil
+	}).AnyTimes()
+	return mock
+}
+
+func TestOIDCService_Callback(t *testing.T) {
+	t.Parallel()
+	existing := &model.User{ID: 7, Email: "user@example.com", Role: model.RoleUser, Status: model.UserStatusActive}
+	tokenPair := &model.TokenPair{AccessToken: "access", RefreshToken: "refresh"}
+
+	tests := []struct {
+		name             string
+		providerUser     oidctest.User
+		mockIdentityRepo func(ctrl *gomock.Controller) *MockIdentityRepository
+		mockUserRepo     func(ctrl *gomock.Controller) *MockUserRepositoryForOIDC
+		expectedUserID   int
+		expectedError    error
+	}{
+		{
+			name:         "Known identity",
+			providerUser: oidctest.User{Subject: "subject-1", Email: "user@example.com", EmailVerified: true},
+			mockIdentityRepo: func(ctrl *gomock.Controller) *MockIdentityRepository {
+				mock := NewMockIdentityRepository(ctrl)
+				mock.EXPECT().GetUserIDByIdentity(gomock.Any(), "mock", "subject-1").Return(7, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForOIDC {
+				mock := NewMockUserRepositoryForOIDC(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 7).Return(existing, nil)
+				return mock
+			},
+			expectedUserID: 7,
+		},
+		{
+			name:         "Existing user linked by email",
+			providerUser: oidctest.User{Subject: "subject-1", Email: "user@example.com", EmailVerified: true},
+			mockIdentityRepo: func(ctrl *gomock.Controller) *MockIdentityRepository {
+				mock := NewMockIdentityRepository(ctrl)
+				mock.EXPECT().GetUserIDByIdentity(gomock.Any(), "mock", "subject-1").Return(0, model.ErrIdentityNotFound)
+				mock.EXPECT().LinkIdentity(gomock.Any(), &model.UserIdentity{Provider: "mock", Subject: "subject-1", UserID: 7, Email: "user@example.com"}).Return(nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForOIDC {
+				mock := NewMockUserRepositoryForOIDC(ctrl)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "user@example.com").Return(existing, nil)
+				mock.EXPECT().GetUserByID(gomock.Any(), 7).Return(existing, nil)
+				return mock
+			},
+			expectedUserID: 7,
+		},
+		{
+			name:         "New user",
+			providerUser: oidctest.User{Subject: "subject-2", Email: "new@example.com", EmailVerified: true},
+			mockIdentityRepo: func(ctrl *gomock.Controller) *MockIdentityRepository {
+				mock := NewMockIdentityRepository(ctrl)
+				mock.EXPECT().GetUserIDByIdentity(gomock.Any(), "mock", "subject-2").Return(0, model.ErrIdentityNotFound)
+				mock.EXPECT().CreateUserWithIdentity(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, user *model.User, identity *model.UserIdentity) error {
+					assert.Equal(t, "new@example.com", user.Email)
+					assert.Equal(t, model.RoleUser, user.Role)
+					assert.Equal(t, model.UserStatusActive, user.Status)
+					assert.Equal(t, "subject-2", identity.Subject)
+					user.ID = 8
+					return nil
+				})
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForOIDC {
+				mock := NewMockUserRepositoryForOIDC(ctrl)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(nil, model.ErrUserNotFound)
+				return mock
+			},
+			expectedUserID: 8,
+		},
+		{
+			name:         "Unverified email",
+			providerUser: oidctest.User{Subject: "subject-3", Email: "user@example.com", EmailVerified: false},
+			mockIdentityRepo: func(ctrl *gomock.Controller) *MockIdentityRepository {
+				mock := NewMockIdentityRepository(ctrl)
+				mock.EXPECT().GetUserIDByIdentity(gomock.Any(), "mock", "subject-3").Return(0, model.ErrIdentityNotFound)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForOIDC {
+				return NewMockUserRepositoryForOIDC(ctrl)
+			},
+			expectedError: model.ErrOIDCEmailNotVerified,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			server := oidctest.NewServer(tt.providerUser)
+			defer server.Close()
+
+			loginStarter := NewMockLoginStarter(ctrl)
+			if tt.expectedError == nil {
+				loginStarter.EXPECT().StartLogin(gomock.Any(), gomock.Any()).Return(tokenPair, nil, nil)
+			}
+			providers := map[string]IdentityProvider{
+				"mock": oidc.NewProvider(server.Config("http://localhost:8083/api/v1/oauth/mock/callback"), time.Now),
+			}
+			service := NewOIDCService(providers, statesInMemory(ctrl), tt.mockIdentityRepo(ctrl), tt.mockUserRepo(ctrl), loginStarter, OIDCConfig{StateTTL: 10 * time.Minute})
+
+			authURL, err := service.AuthorizationURL(context.Background(), "mock")
+			assert.NoError(t, err)
+			code, state, err := server.Authorize(authURL)
+			assert.NoError(t, err)
+
+			user, tokens, challenge, err := service.Callback(context.Background(), model.OIDCCallbackRequest{Provider: "mock", Code: code, State: state})
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Nil(t, challenge)
+			assert.Equal(t, tt.expectedUserID, user.ID)
+			assert.Equal(t, tokenPair, tokens)
+
+			// the state can not be replayed
+			_, _, _, err = service.Callback(context.Background(), model.OIDCCallbackRequest{Provider: "mock", Code: code, State: state})
+			assert.ErrorIs(t, err, model.ErrInvalidOIDCState)
+		})
+	}
+}
+
+func TestOIDCService_AuthorizationURL(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := NewOIDCService(map[string]IdentityProvider{}, NewMockOIDCStateRepository(ctrl), NewMockIdentityRepository(ctrl), NewMockUserRepositoryForOIDC(ctrl), NewMockLoginStarter(ctrl), OIDCConfig{StateTTL: 10 * time.Minute})
+	_, err := service.AuthorizationURL(context.Background(), "unknown")
+	assert.ErrorIs(t, err, model.ErrUnknownIdentityProvider)
+}
@@ -0,0 +1,152 @@
+//go:generate mockgen -source=password.go -destination=password_mock.go -package=services
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type UserRepositoryForPassword interface {
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+	UpdatePassword(ctx context.Context, id int, hashedPassword string) error
+}
+
+type PasswordResetRepository interface {
+	CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error
+	ResetPassword(ctx context.Context, tokenHash string, hashedPassword string) (int, error)
+}
+
+type PasswordResetNotifier interface {
+	EnqueuePasswordResetEmail(ctx context.Context, task model.SendPasswordResetEmailTask) error
+}
+
+type SessionRevoker interface {
+	RevokeUserSessions(ctx context.Context, userID int) error
+}
+
+type PasswordConfig struct {
+	ResetURL      string // the token is appended as the token query parameter
+	ResetTokenExp time.Duration
+}
+
+// PasswordService changes passwords, either knowing the current one or through a reset link sent by email. Every
+// session of the user is revoked after a change.
+type PasswordService struct {
+	userRepo       UserRepositoryForPassword
+	resetRepo      PasswordResetRepository
+	notifier       PasswordResetNotifier
+	sessionRevoker SessionRevoker
+	nowFn          func() time.Time
+	cfg            PasswordConfig
+}
+
+func NewPasswordService(
+	userRepo UserRepositoryForPassword,
+	resetRepo PasswordResetRepository,
+	notifier PasswordResetNotifier,
+	sessionRevoker SessionRevoker,
+	nowFn func() time.Time,
+	cfg PasswordConfig,
+) *PasswordService {
+	return &PasswordService{
+		userRepo:       userRepo,
+		resetRepo:      resetRepo,
+		notifier:       notifier,
+		sessionRevoker: sessionRevoker,
+		nowFn:          nowFn,
+		cfg:            cfg,
+	}
+}
+
+// ForgotPassword emails a reset link. Unknown emails are ignored so the endpoint does not tell which addresses are
+// registered.
+func (s *PasswordService) ForgotPassword(ctx context.Context, email string) error {
+	user, err := s.userRepo.GetUserByEmail(ctx, email)
+	if errors.Is(err, model.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := generateOpaqueToken()
+	if err != nil {
+		return err
+	}
+	expiresAt := s.nowFn().Add(s.cfg.ResetTokenExp)
+	if err := s.resetRepo.CreatePasswordResetToken(ctx, &model.PasswordResetToken{
+		TokenHash: hashOpaqueToken(token),
+		UserID:    user.ID,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		return err
+	}
+
+	link, err := url.Parse(s.cfg.ResetURL)
+	if err != nil {
+		return err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return s.notifier.EnqueuePasswordResetEmail(ctx, model.SendPasswordResetEmailTask{
+		Email:     user.Email,
+		Locale:    user.Locale,
+		Timezone:  user.Timezone,
+		Link:      link.String(),
+		ExpiresAt: expiresAt,
+	})
+}
+
+// ResetPassword redeems a reset token and sets the new password.
+func (s *PasswordService) ResetPassword(ctx context.Context, params model.ResetPasswordRequest) error {
+	hashedPassword, err := hashPassword(params.NewPassword)
+	if err != nil {
+		return err
+	}
+	userID, err := s.resetRepo.ResetPassword(ctx, hashOpaqueToken(params.Token), hashedPassword)
+	if err != nil {
+		return err
+	}
+	return s.sessionRevoker.RevokeUserSessions(ctx, userID)
+}
+
+// ChangePassword sets a new password for a user proving they know the current one.
+func (s *PasswordService) ChangePassword(ctx context.Context, params model.ChangePasswordRequest) error {
+	user, err := s.userRepo.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		return err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(params.CurrentPassword)) != nil {
+		return model.ErrInvalidPassword
+	}
+	hashedPassword, err := hashPassword(params.NewPassword)
+	if err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		return err
+	}
+	return s.sessionRevoker.RevokeUserSessions(ctx, user.ID)
+}
+
+// hashPassword hashes a new password. bcrypt only reads 72 bytes, the requests limit the characters so a password
+// of multi-byte characters can still be too long.
+func hashPassword(password string) (string, error) {
+	if len(password) > 72 {
+		return "", model.ErrPasswordTooLong
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashedPassword), nil
+}
@@ -0,0 +1,211 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: password.go
+//
+// Generated by this command:
+//
+//	mockgen -source=password.go -destination=password_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRepositoryForPassword is a mock of UserRepositoryForPassword interface.
+type MockUserRepositoryForPassword struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryForPasswordMockRecorder
+}
+
+// MockUserRepositoryForPasswordMockRecorder is the mock recorder for MockUserRepositoryForPassword.
+type MockUserRepositoryForPasswordMockRecorder struct {
+	mock *MockUserRepositoryForPassword
+}
+
+// NewMockUserRepositoryForPassword creates a new mock instance.
+func NewMockUserRepositoryForPassword(ctrl *gomock.Controller) *MockUserRepositoryForPassword {
+	mock := &MockUserRepositoryForPassword{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryForPasswordMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepositoryForPassword) EXPECT() *MockUserRepositoryForPasswordMockRecorder {
+	return m.recorder
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockUserRepositoryForPassword) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockUserRepositoryForPasswordMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUserRepositoryForPassword)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserRepositoryForPassword) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryForPasswordMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepositoryForPassword)(nil).GetUserByID), ctx, id)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockUserRepositoryForPassword) UpdatePassword(ctx context.Context, id int, hashedPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, id, hashedPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUserRepositoryForPasswordMockRecorder) UpdatePassword(ctx, id, hashedPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepositoryForPassword)(nil).UpdatePassword), ctx, id, hashedPassword)
+}
+
+// MockPasswordResetRepository is a mock of PasswordResetRepository interface.
+type MockPasswordResetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetRepositoryMockRecorder
+}
+
+// MockPasswordResetRepositoryMockRecorder is the mock recorder for MockPasswordResetRepository.
+type MockPasswordResetRepositoryMockRecorder struct {
+	mock *MockPasswordResetRepository
+}
+
+// NewMockPasswordResetRepository creates a new mock instance.
+func NewMockPasswordResetRepository(ctrl *gomock.Controller) *MockPasswordResetRepository {
+	mock := &MockPasswordResetRepository{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetRepository) EXPECT() *MockPasswordResetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePasswordResetToken mocks base method.
+func (m *MockPasswordResetRepository) CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordResetToken indicates an expected call of CreatePasswordResetToken.
+func (mr *MockPasswordResetRepositoryMockRecorder) CreatePasswordResetToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetToken", reflect.TypeOf((*MockPasswordResetRepository)(nil).CreatePasswordResetToken), ctx, token)
+}
+
+// ResetPassword mocks base method.
+func (m *MockPasswordResetRepository) ResetPassword(ctx context.Context, tokenHash, hashedPassword string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, tokenHash, hashedPassword)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockPasswordResetRepositoryMockRecorder) ResetPassword(ctx, tokenHash, hashedPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockPasswordResetRepository)(nil).ResetPassword), ctx, tokenHash, hashedPassword)
+}
+
+// MockPasswordResetNotifier is a mock of PasswordResetNotifier interface.
+type MockPasswordResetNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetNotifierMockRecorder
+}
+
+// MockPasswordResetNotifierMockRecorder is the mock recorder for MockPasswordResetNotifier.
+type MockPasswordResetNotifierMockRecorder struct {
+	mock *MockPasswordResetNotifier
+}
+
+// NewMockPasswordResetNotifier creates a new mock instance.
+func NewMockPasswordResetNotifier(ctrl *gomock.Controller) *MockPasswordResetNotifier {
+	mock := &MockPasswordResetNotifier{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetNotifier) EXPECT() *MockPasswordResetNotifierMockRecorder {
+	return m.recorder
+}
+
+// EnqueuePasswordResetEmail mocks base method.
+func (m *MockPasswordResetNotifier) EnqueuePasswordResetEmail(ctx context.Context, task model.SendPasswordResetEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueuePasswordResetEmail", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueuePasswordResetEmail indicates an expected call of EnqueuePasswordResetEmail.
+func (mr *MockPasswordResetNotifierMockRecorder) EnqueuePasswordResetEmail(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueuePasswordResetEmail", reflect.TypeOf((*MockPasswordResetNotifier)(nil).EnqueuePasswordResetEmail), ctx, task)
+}
+
+// MockSessionRevoker is a mock of SessionRevoker interface.
+type MockSessionRevoker struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRevokerMockRecorder
+}
+
+// MockSessionRevokerMockRecorder is the mock recorder for MockSessionRevoker.
+type MockSessionRevokerMockRecorder struct {
+	mock *MockSessionRevoker
+}
+
+// NewMockSessionRevoker creates a new mock instance.
+func NewMockSessionRevoker(ctrl *gomock.Controller) *MockSessionRevoker {
+	mock := &MockSessionRevoker{ctrl: ctrl}
+	mock.recorder = &MockSessionRevokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRevoker) EXPECT() *MockSessionRevokerMockRecorder {
+	return m.recorder
+}
+
+// RevokeUserSessions mocks base method.
+func (m *MockSessionRevoker) RevokeUserSessions(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserSessions indicates an expected call of RevokeUserSessions.
+func (mr *MockSessionRevokerMockRecorder) RevokeUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessions", reflect.TypeOf((*MockSessionRevoker)(nil).RevokeUserSessions), ctx, userID)
+}
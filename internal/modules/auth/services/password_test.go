@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+var passwordConfig = PasswordConfig{
+	ResetURL:      "http://localhost:3000/reset-password",
+	ResetTokenExp: time.Hour,
+}
+
+func TestPasswordService_ForgotPassword(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Second)
+
+	t.Run("Registered email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var storedHash string
+		userRepo := NewMockUserRepositoryForPassword(ctrl)
+		userRepo.EXPECT().GetUserByEmail(gomock.Any(), "test@example.com").Return(&model.User{ID: 1, Email: "test@example.com", Locale: "vi", Timezone: "Asia/Ho_Chi_Minh"}, nil)
+		resetRepo := NewMockPasswordResetRepository(ctrl)
+		resetRepo.EXPECT().CreatePasswordResetToken(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, token *model.PasswordResetToken) error {
+			assert.Equal(t, 1, token.UserID)
+			assert.Equal(t, now.Add(time.Hour), token.ExpiresAt)
+			storedHash = token.TokenHash
+			return nil
+		})
+		notifier := NewMockPasswordResetNotifier(ctrl)
+		notifier.EXPECT().EnqueuePasswordResetEmail(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, task model.SendPasswordResetEmailTask) error {
+			assert.Equal(t, "test@example.com", task.Email)
+			assert.Equal(t, "vi", task.Locale)
+			assert.Equal(t, "Asia/Ho_Chi_Minh", task.Timezone)
+			link, err := url.Parse(task.Link)
+			assert.NoError(t, err)
+			token := link.Query().Get("token")
+			assert.NotEmpty(t, token)
+			// only the hash is stored, the token itself is only in the email
+			assert.NotEqual(t, token, storedHash)
+			assert.Equal(t, hashOpaqueToken(token), storedHash)
+			return nil
+		})
+
+		service := NewPasswordService(userRepo, resetRepo, notifier, NewMockSessionRevoker(ctrl), func() time.Time { return now }, passwordConfig)
+		assert.NoError(t, service.ForgotPassword(context.Background(), "test@example.com"))
+	})
+
+	t.Run("Unknown email", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		userRepo := NewMockUserRepositoryForPassword(ctrl)
+		userRepo.EXPECT().GetUserByEmail(gomock.Any(), "unknown@example.com").Return(nil, model.ErrUserNotFound)
+
+		service := NewPasswordService(userRepo, NewMockPasswordResetRepository(ctrl), NewMockPasswordResetNotifier(ctrl), NewMockSessionRevoker(ctrl), time.Now, passwordConfig)
+		assert.NoError(t, service.ForgotPassword(context.Background(), "unknown@example.com"))
+	})
+}
+
+func TestPasswordService_ResetPassword(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name               string
+		newPassword        string
+		mockResetRepo      func(ctrl *gomock.Controller) *MockPasswordResetRepository
+		mockSessionRevoker func(ctrl *gomock.Controller) *MockSessionRevoker
+		expectedError      error
+	}{
+		{
+			name: "Successful reset",
+			mockResetRepo: func(ctrl *gomock.Controller) *MockPasswordResetRepository {
+				mock := NewMockPasswordResetRepository(ctrl)
+				mock.EXPECT().ResetPassword(gomock.Any(), hashOpaqueToken("reset-token"), gomock.Any()).DoAndReturn(func(ctx context.Context, tokenHash string, hashedPassword string) (int, error) {
+					assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte("newpassword")))
+					return 1, nil
+				})
+				return mock
+			},
+			mockSessionRevoker: func(ctrl *gomock.Controller) *MockSessionRevoker {
+				mock := NewMockSessionRevoker(ctrl)
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), 1).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Used or expired token",
+			mockResetRepo: func(ctrl *gomock.Controller) *MockPasswordResetRepository {
+				mock := NewMockPasswordResetRepository(ctrl)
+				mock.EXPECT().ResetPassword(gomock.Any(), hashOpaqueToken("reset-token"), gomock.Any()).Return(0, model.ErrInvalidResetToken)
+				return mock
+			},
+			mockSessionRevoker: func(ctrl *gomock.Controller) *MockSessionRevoker {
+				return NewMockSessionRevoker(ctrl)
+			},
+			expectedError: model.ErrInvalidResetToken,
+		},
+		{
+			// 40 characters pass the binding but take 80 bytes
+			name:        "Password over 72 bytes",
+			newPassword: strings.Repeat("é", 40),
+			mockResetRepo: func(ctrl *gomock.Controller) *MockPasswordResetRepository {
+				return NewMockPasswordResetRepository(ctrl)
+			},
+			mockSessionRevoker: func(ctrl *gomock.Controller) *MockSessionRevoker {
+				return NewMockSessionRevoker(ctrl)
+			},
+			expectedError: model.ErrPasswordTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			newPassword := tt.newPassword
+			if newPassword == "" {
+				newPassword = "newpassword"
+			}
+			service := NewPasswordService(NewMockUserRepositoryForPassword(ctrl), tt.mockResetRepo(ctrl), NewMockPasswordResetNotifier(ctrl), tt.mockSessionRevoker(ctrl), time.Now, passwordConfig)
+			err := service.ResetPassword(context.Background(), model.ResetPasswordRequest{Token: "reset-token", NewPassword: newPassword})
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPasswordService_ChangePassword(t *testing.T) {
+	t.Parallel()
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("currentpassword"), bcrypt.DefaultCost)
+	assert.NoError(t, err)
+	user := &model.User{ID: 1, Email: "test@example.com", HashedPassword: string(hashedPassword)}
+
+	tests := []struct {
+		name               string
+		currentPassword    string
+		mockUserRepo       func(ctrl *gomock.Controller) *MockUserRepositoryForPassword
+		mockSessionRevoker func(ctrl *gomock.Controller) *MockSessionRevoker
+		expectedError      error
+	}{
+		{
+			name:            "Successful change",
+			currentPassword: "currentpassword",
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForPassword {
+				mock := NewMockUserRepositoryForPassword(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				mock.EXPECT().UpdatePassword(gomock.Any(), 1, gomock.Any()).Return(nil)
+				return mock
+			},
+			mockSessionRevoker: func(ctrl *gomock.Controller) *MockSessionRevoker {
+				mock := NewMockSessionRevoker(ctrl)
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), 1).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:            "Wrong current password",
+			currentPassword: "wrongpassword",
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForPassword {
+				mock := NewMockUserRepositoryForPassword(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				return mock
+			},
+			mockSessionRevoker: func(ctrl *gomock.Controller) *MockSessionRevoker {
+				return NewMockSessionRevoker(ctrl)
+			},
+			expectedError: model.ErrInvalidPassword,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewPasswordService(tt.mockUserRepo(ctrl), NewMockPasswordResetRepository(ctrl), NewMockPasswordResetNotifier(ctrl), tt.mockSessionRevoker(ctrl), time.Now, passwordConfig)
+			err := service.ChangePassword(context.Background(), model.ChangePasswordRequest{
+				CurrentPassword: tt.currentPassword,
+				NewPassword:     "newpassword",
+				UserID:          1,
+			})
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+//go:generate mockgen -source=profile.go -destination=profile_mock.go -package=services
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/crypto/bcrypt"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type UserRepositoryForProfile interface {
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	UpdateProfile(ctx context.Context, user *model.User) error
+	SetPendingEmail(ctx context.Context, id int, email string) error
+	ConfirmEmailChange(ctx context.Context, id int, email string) error
+}
+
+type EmailChangeNotifier interface {
+	EnqueueEmailChangeEmail(ctx context.Context, task model.SendEmailChangeEmailTask) error
+}
+
+type ProfileConfig struct {
+	EmailChangeURL      string // the token is appended as the token query parameter
+	EmailChangeTokenExp time.Duration
+}
+
+// ProfileService lets the users read and edit their own account. A new email address only replaces the current
+// one once the user opened the link sent to it.
+type ProfileService struct {
+	userRepo UserRepositoryForProfile
+	notifier EmailChangeNotifier
+	signer   TokenSigner
+	keys     PublicKeyResolver
+	nowFn    func() time.Time
+	cfg      ProfileConfig
+}
+
+func NewProfileService(userRepo UserRepositoryForProfile, notifier EmailChangeNotifier, signer TokenSigner, keys PublicKeyResolver, nowFn func() time.Time, cfg ProfileConfig) *ProfileService {
+	return &ProfileService{
+		userRepo: userRepo,
+		notifier: notifier,
+		signer:   signer,
+		keys:     keys,
+		nowFn:    nowFn,
+		cfg:      cfg,
+	}
+}
+
+func (s *ProfileService) GetProfile(ctx context.Context, userID int) (*model.User, error) {
+	return s.userRepo.GetUserByID(ctx, userID)
+}
+
+func (s *ProfileService) UpdateProfile(ctx context.Context, params model.UpdateProfileRequest) (*model.User, error) {
+	user, err := s.userRepo.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.nowFn()
+	if params.DisplayName != nil {
+		user.DisplayName = strings.TrimSpace(*params.DisplayName)
+	}
+	if params.Phone != nil {
+		user.Phone = *params.Phone
+	}
+	if params.Birthdate != nil {
+		birthdate, err := model.ParseBirthdate(*params.Birthdate)
+		if err != nil {
+			return nil, err
+		}
+		if birthdate != nil && !birthdate.Before(now) {
+			return nil, model.ErrInvalidBirthdate
+		}
+		user.Birthdate = birthdate
+	}
+	if params.Locale != nil {
+		user.Locale = *params.Locale
+	}
+	if params.Timezone != nil {
+		user.Timezone = *params.Timezone
+	}
+	// the time of the consent is kept as the proof of it
+	if params.MarketingConsent != nil && *params.MarketingConsent != user.MarketingConsent {
+		user.MarketingConsent = *params.MarketingConsent
+		user.MarketingConsentAt = &now
+	}
+	if err := s.userRepo.UpdateProfile(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// RequestEmailChange sends a confirmation link to the new address. The current password is asked so a stolen
+// session is not enough to take the account over, users who only log in with an identity provider have none.
+func (s *ProfileService) RequestEmailChange(ctx context.Context, params model.ChangeEmailRequest) error {
+	user, err := s.userRepo.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		return err
+	}
+	if user.HashedPassword != "" {
+		if params.CurrentPassword == "" {
+			return model.ErrCurrentPasswordNeeded
+		}
+		if bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(params.CurrentPassword)) != nil {
+			return model.ErrInvalidPassword
+		}
+	}
+	email := normalizeEmail(params.Email)
+	if email == normalizeEmail(user.Email) {
+		return model.ErrEmailUnchanged
+	}
+	_, err = s.userRepo.GetUserByEmail(ctx, email)
+	if err == nil {
+		return model.ErrEmailAlreadyRegistered
+	}
+	if !errors.Is(err, model.ErrUserNotFound) {
+		return err
+	}
+
+	if err := s.userRepo.SetPendingEmail(ctx, user.ID, email); err != nil {
+		return err
+	}
+	return s.sendEmailChangeEmail(ctx, user.ID, email)
+}
+
+// VerifyEmailChange redeems the link sent to the new address. Only the last requested address can be confirmed.
+func (s *ProfileService) VerifyEmailChange(ctx context.Context, token string) error {
+	claims := &model.EmailVerificationClaims{}
+	if err := parseSignedToken(ctx, s.keys, token, claims); err != nil || claims.Purpose != model.TokenPurposeEmailChange {
+		return model.ErrInvalidEmailChange
+	}
+	return s.userRepo.ConfirmEmailChange(ctx, claims.UserID, claims.Email)
+}
+
+func (s *ProfileService) sendEmailChangeEmail(ctx context.Context, userID int, email string) error {
+	now := s.nowFn()
+	expiresAt := now.Add(s.cfg.EmailChangeTokenExp)
+	token, err := s.signer.SignToken(&model.EmailVerificationClaims{
+		UserID:  userID,
+		Email:   email,
+		Purpose: model.TokenPurposeEmailChange,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	link, err := url.Parse(s.cfg.EmailChangeURL)
+	if err != nil {
+		return err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return s.notifier.EnqueueEmailChangeEmail(ctx, model.SendEmailChangeEmailTask{
+		Email:     email,
+		Link:      link.String(),
+		ExpiresAt: expiresAt,
+	})
+}
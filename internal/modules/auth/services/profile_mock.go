@@ -0,0 +1,150 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: profile.go
+//
+// Generated by this command:
+//
+//	mockgen -source=profile.go -destination=profile_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRepositoryForProfile is a mock of UserRepositoryForProfile interface.
+type MockUserRepositoryForProfile struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryForProfileMockRecorder
+}
+
+// MockUserRepositoryForProfileMockRecorder is the mock recorder for MockUserRepositoryForProfile.
+type MockUserRepositoryForProfileMockRecorder struct {
+	mock *MockUserRepositoryForProfile
+}
+
+// NewMockUserRepositoryForProfile creates a new mock instance.
+func NewMockUserRepositoryForProfile(ctrl *gomock.Controller) *MockUserRepositoryForProfile {
+	mock := &MockUserRepositoryForProfile{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryForProfileMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepositoryForProfile) EXPECT() *MockUserRepositoryForProfileMockRecorder {
+	return m.recorder
+}
+
+// ConfirmEmailChange mocks base method.
+func (m *MockUserRepositoryForProfile) ConfirmEmailChange(ctx context.Context, id int, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChange", ctx, id, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmEmailChange indicates an expected call of ConfirmEmailChange.
+func (mr *MockUserRepositoryForProfileMockRecorder) ConfirmEmailChange(ctx, id, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChange", reflect.TypeOf((*MockUserRepositoryForProfile)(nil).ConfirmEmailChange), ctx, id, email)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockUserRepositoryForProfile) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockUserRepositoryForProfileMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUserRepositoryForProfile)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserRepositoryForProfile) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryForProfileMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepositoryForProfile)(nil).GetUserByID), ctx, id)
+}
+
+// SetPendingEmail mocks base method.
+func (m *MockUserRepositoryForProfile) SetPendingEmail(ctx context.Context, id int, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPendingEmail", ctx, id, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPendingEmail indicates an expected call of SetPendingEmail.
+func (mr *MockUserRepositoryForProfileMockRecorder) SetPendingEmail(ctx, id, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPendingEmail", reflect.TypeOf((*MockUserRepositoryForProfile)(nil).SetPendingEmail), ctx, id, email)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockUserRepositoryForProfile) UpdateProfile(ctx context.Context, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUserRepositoryForProfileMockRecorder) UpdateProfile(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUserRepositoryForProfile)(nil).UpdateProfile), ctx, user)
+}
+
+// MockEmailChangeNotifier is a mock of EmailChangeNotifier interface.
+type MockEmailChangeNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailChangeNotifierMockRecorder
+}
+
+// MockEmailChangeNotifierMockRecorder is the mock recorder for MockEmailChangeNotifier.
+type MockEmailChangeNotifierMockRecorder struct {
+	mock *MockEmailChangeNotifier
+}
+
+// NewMockEmailChangeNotifier creates a new mock instance.
+func NewMockEmailChangeNotifier(ctrl *gomock.Controller) *MockEmailChangeNotifier {
+	mock := &MockEmailChangeNotifier{ctrl: ctrl}
+	mock.recorder = &MockEmailChangeNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailChangeNotifier) EXPECT() *MockEmailChangeNotifierMockRecorder {
+	return m.recorder
+}
+
+// EnqueueEmailChangeEmail mocks base method.
+func (m *MockEmailChangeNotifier) EnqueueEmailChangeEmail(ctx context.Context, task model.SendEmailChangeEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueEmailChangeEmail", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueEmailChangeEmail indicates an expected call of EnqueueEmailChangeEmail.
+func (mr *MockEmailChangeNotifierMockRecorder) EnqueueEmailChangeEmail(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueEmailChangeEmail", reflect.TypeOf((*MockEmailChangeNotifier)(nil).EnqueueEmailChangeEmail), ctx, task)
+}
@@ -0,0 +1,336 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+var profileConfig = ProfileConfig{
+	EmailChangeURL:      "http://localhost:8083/api/v1/verify-email-change",
+	EmailChangeTokenExp: time.Hour,
+}
+
+func TestProfileService_UpdateProfile(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	consentAt := now.Add(-24 * time.Hour)
+	tests := []struct {
+		name          string
+		user          model.User
+		request       model.UpdateProfileRequest
+		expectedError error
+		expectUpdate  bool
+		check         func(t *testing.T, user *model.User)
+	}{
+		{
+			name: "Set fields are changed",
+			user: model.User{ID: 1, DisplayName: "Old", Phone: "+84901234567", Locale: "en", Timezone: "UTC"},
+			request: model.UpdateProfileRequest{
+				UserID:      1,
+				DisplayName: util.ToPtr("  New Name "),
+				Birthdate:   util.ToPtr("1990-05-17"),
+				Timezone:    util.ToPtr("Asia/Ho_Chi_Minh"),
+			},
+			expectUpdate: true,
+			check: func(t *testing.T, user *model.User) {
+				assert.Equal(t, "New Name", user.DisplayName)
+				assert.Equal(t, "+84901234567", user.Phone)
+				assert.Equal(t, time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC), *user.Birthdate)
+				assert.Equal(t, "en", user.Locale)
+				assert.Equal(t, "Asia/Ho_Chi_Minh", user.Timezone)
+			},
+		},
+		{
+			name:         "Empty phone and birthdate are cleared",
+			user:         model.User{ID: 1, Phone: "+84901234567", Birthdate: util.ToPtr(time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC))},
+			request:      model.UpdateProfileRequest{UserID: 1, Phone: util.ToPtr(""), Birthdate: util.ToPtr("")},
+			expectUpdate: true,
+			check: func(t *testing.T, user *model.User) {
+				assert.Empty(t, user.Phone)
+				assert.Nil(t, user.Birthdate)
+			},
+		},
+		{
+			name:         "Consent records its time",
+			user:         model.User{ID: 1},
+			request:      model.UpdateProfileRequest{UserID: 1, MarketingConsent: util.ToPtr(true)},
+			expectUpdate: true,
+			check: func(t *testing.T, user *model.User) {
+				assert.True(t, user.MarketingConsent)
+				assert.Equal(t, now, *user.MarketingConsentAt)
+			},
+		},
+		{
+			name:         "Unchanged consent keeps its time",
+			user:         model.User{ID: 1, MarketingConsent: true, MarketingConsentAt: &consentAt},
+			request:      model.UpdateProfileRequest{UserID: 1, MarketingConsent: util.ToPtr(true)},
+			expectUpdate: true,
+			check: func(t *testing.T, user *model.User) {
+				assert.Equal(t, consentAt, *user.MarketingConsentAt)
+			},
+		},
+		{
+			name:          "Birthdate in the future",
+			user:          model.User{ID: 1},
+			request:       model.UpdateProfileRequest{UserID: 1, Birthdate: util.ToPtr("2030-01-01")},
+			expectedError: model.ErrInvalidBirthdate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := NewMockUserRepositoryForProfile(ctrl)
+			user := tt.user
+			userRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&user, nil)
+			if tt.expectUpdate {
+				userRepo.EXPECT().UpdateProfile(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			service := NewProfileService(userRepo, NewMockEmailChangeNotifier(ctrl), testKeys, testKeys, func() time.Time { return now }, profileConfig)
+			updated, err := service.UpdateProfile(context.Background(), tt.request)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, updated)
+			} else {
+				assert.NoError(t, err)
+				tt.check(t, updated)
+			}
+		})
+	}
+}
+
+func TestProfileService_RequestEmailChange(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	user := model.User{ID: 1, Email: "old@example.com", HashedPassword: string(hashedPassword)}
+
+	tests := []struct {
+		name          string
+		user          model.User
+		request       model.ChangeEmailRequest
+		mockUserRepo  func(t *testing.T, ctrl *gomock.Controller, user *model.User) *MockUserRepositoryForProfile
+		mockNotifier  func(t *testing.T, ctrl *gomock.Controller) *MockEmailChangeNotifier
+		expectedError error
+	}{
+		{
+			name:    "Link sent to the new address",
+			user:    user,
+			request: model.ChangeEmailRequest{UserID: 1, Email: " New@Example.com", CurrentPassword: "password123"},
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller, user *model.User) *MockUserRepositoryForProfile {
+				mock := NewMockUserRepositoryForProfile(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(nil, model.ErrUserNotFound)
+				mock.EXPECT().SetPendingEmail(gomock.Any(), 1, "new@example.com").Return(nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockEmailChangeNotifier {
+				mock := NewMockEmailChangeNotifier(ctrl)
+				mock.EXPECT().EnqueueEmailChangeEmail(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, task model.SendEmailChangeEmailTask) error {
+					assert.Equal(t, "new@example.com", task.Email)
+					assert.Equal(t, now.Add(time.Hour), task.ExpiresAt)
+					link, err := url.Parse(task.Link)
+					assert.NoError(t, err)
+					assert.Equal(t, "/api/v1/verify-email-change", link.Path)
+
+					claims := &model.EmailVerificationClaims{}
+					_, err = jwt.ParseWithClaims(link.Query().Get("token"), claims, func(token *jwt.Token) (interface{}, error) {
+						return testKeys.PublicKey(ctx, "test")
+					}, jwt.WithoutClaimsValidation())
+					assert.NoError(t, err)
+					assert.Equal(t, 1, claims.UserID)
+					assert.Equal(t, "new@example.com", claims.Email)
+					assert.Equal(t, model.TokenPurposeEmailChange, claims.Purpose)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:    "Users without password need none",
+			user:    model.User{ID: 1, Email: "old@example.com"},
+			request: model.ChangeEmailRequest{UserID: 1, Email: "new@example.com"},
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller, user *model.User) *MockUserRepositoryForProfile {
+				mock := NewMockUserRepositoryForProfile(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(nil, model.ErrUserNotFound)
+				mock.EXPECT().SetPendingEmail(gomock.Any(), 1, "new@example.com").Return(nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockEmailChangeNotifier {
+				mock := NewMockEmailChangeNotifier(ctrl)
+				mock.EXPECT().EnqueueEmailChangeEmail(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:    "Missing current password",
+			user:    user,
+			request: model.ChangeEmailRequest{UserID: 1, Email: "new@example.com"},
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller, user *model.User) *MockUserRepositoryForProfile {
+				mock := NewMockUserRepositoryForProfile(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockEmailChangeNotifier {
+				return NewMockEmailChangeNotifier(ctrl)
+			},
+			expectedError: model.ErrCurrentPasswordNeeded,
+		},
+		{
+			name:    "Wrong current password",
+			user:    user,
+			request: model.ChangeEmailRequest{UserID: 1, Email: "new@example.com", CurrentPassword: "wrong"},
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller, user *model.User) *MockUserRepositoryForProfile {
+				mock := NewMockUserRepositoryForProfile(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockEmailChangeNotifier {
+				return NewMockEmailChangeNotifier(ctrl)
+			},
+			expectedError: model.ErrInvalidPassword,
+		},
+		{
+			name:    "Same address",
+			user:    user,
+			request: model.ChangeEmailRequest{UserID: 1, Email: "OLD@example.com", CurrentPassword: "password123"},
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller, user *model.User) *MockUserRepositoryForProfile {
+				mock := NewMockUserRepositoryForProfile(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockEmailChangeNotifier {
+				return NewMockEmailChangeNotifier(ctrl)
+			},
+			expectedError: model.ErrEmailUnchanged,
+		},
+		{
+			name:    "Address used by another account",
+			user:    user,
+			request: model.ChangeEmailRequest{UserID: 1, Email: "taken@example.com", CurrentPassword: "password123"},
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller, user *model.User) *MockUserRepositoryForProfile {
+				mock := NewMockUserRepositoryForProfile(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(user, nil)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "taken@example.com").Return(&model.User{ID: 2}, nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockEmailChangeNotifier {
+				return NewMockEmailChangeNotifier(ctrl)
+			},
+			expectedError: model.ErrEmailAlreadyRegistered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			user := tt.user
+			service := NewProfileService(tt.mockUserRepo(t, ctrl, &user), tt.mockNotifier(t, ctrl), testKeys, testKeys, func() time.Time { return now }, profileConfig)
+			err := service.RequestEmailChange(context.Background(), tt.request)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProfileService_VerifyEmailChange(t *testing.T) {
+	t.Parallel()
+	validClaims := model.EmailVerificationClaims{
+		UserID:  1,
+		Email:   "new@example.com",
+		Purpose: model.TokenPurposeEmailChange,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	expiredClaims := validClaims
+	expiredClaims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	verificationClaims := validClaims
+	verificationClaims.Purpose = model.TokenPurposeEmailVerification
+
+	tests := []struct {
+		name          string
+		token         string
+		mockUserRepo  func(ctrl *gomock.Controller) *MockUserRepositoryForProfile
+		expectedError error
+	}{
+		{
+			name:  "Successful change",
+			token: signVerificationToken(t, validClaims, testKeys),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForProfile {
+				mock := NewMockUserRepositoryForProfile(ctrl)
+				mock.EXPECT().ConfirmEmailChange(gomock.Any(), 1, "new@example.com").Return(nil)
+				return mock
+			},
+		},
+		{
+			name:  "Replaced by a later request",
+			token: signVerificationToken(t, validClaims, testKeys),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForProfile {
+				mock := NewMockUserRepositoryForProfile(ctrl)
+				mock.EXPECT().ConfirmEmailChange(gomock.Any(), 1, "new@example.com").Return(model.ErrInvalidEmailChange)
+				return mock
+			},
+			expectedError: model.ErrInvalidEmailChange,
+		},
+		{
+			name:  "Expired token",
+			token: signVerificationToken(t, expiredClaims, testKeys),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForProfile {
+				return NewMockUserRepositoryForProfile(ctrl)
+			},
+			expectedError: model.ErrInvalidEmailChange,
+		},
+		{
+			name:  "Registration token",
+			token: signVerificationToken(t, verificationClaims, testKeys),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForProfile {
+				return NewMockUserRepositoryForProfile(ctrl)
+			},
+			expectedError: model.ErrInvalidEmailChange,
+		},
+		{
+			name:  "Wrong signature",
+			token: signVerificationToken(t, validClaims, newTestKeySet("other")),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForProfile {
+				return NewMockUserRepositoryForProfile(ctrl)
+			},
+			expectedError: model.ErrInvalidEmailChange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewProfileService(tt.mockUserRepo(ctrl), NewMockEmailChangeNotifier(ctrl), testKeys, testKeys, time.Now, profileConfig)
+			err := service.VerifyEmailChange(context.Background(), tt.token)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+//go:generate mockgen -source=registration.go -destination=registration_mock.go -package=services
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type UserRepositoryForRegistration interface {
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+	CreateUser(ctx context.Context, user *model.User) error
+	ActivateUser(ctx context.Context, id int) error
+}
+
+type VerificationNotifier interface {
+	EnqueueVerificationEmail(ctx context.Context, task model.SendVerificationEmailTask) error
+	EnqueueAlreadyRegisteredEmail(ctx context.Context, task model.SendAlreadyRegisteredEmailTask) error
+}
+
+type RegistrationConfig struct {
+	VerificationURL      string // the token is appended as the token query parameter
+	VerificationTokenExp time.Duration
+}
+
+// RegistrationService signs up users and activates them once they prove they own their email address. The links
+// are signed with the keys of the access tokens, their purpose claim keeps them from being used as one.
+type RegistrationService struct {
+	userRepo UserRepositoryForRegistration
+	notifier VerificationNotifier
+	signer   TokenSigner
+	keys     PublicKeyResolver
+	nowFn    func() time.Time
+	cfg      RegistrationConfig
+}
+
+func NewRegistrationService(
+	userRepo UserRepositoryForRegistration,
+	notifier VerificationNotifier,
+	signer TokenSigner,
+	keys PublicKeyResolver,
+	nowFn func() time.Time,
+	cfg RegistrationConfig,
+) *RegistrationService {
+	return &RegistrationService{
+		userRepo: userRepo,
+		notifier: notifier,
+		signer:   signer,
+		keys:     keys,
+		nowFn:    nowFn,
+		cfg:      cfg,
+	}
+}
+
+// Register creates an unverified user and sends the verification link to its email address. An email already
+// registered gets the same answer, its owner is told by email instead so the endpoint does not reveal the accounts.
+func (s *RegistrationService) Register(ctx context.Context, params model.RegisterRequest) (*model.RegisterResponse, error) {
+	// the address is stored lower-cased, so a registered one typed in another case is still recognized as taken
+	email := normalizeEmail(params.Email)
+	hashedPassword, err := hashPassword(params.Password)
+	if err != nil {
+		return nil, err
+	}
+	user := &model.User{
+		Email:          email,
+		Role:           model.RoleUser,
+		Status:         model.UserStatusUnverified,
+		HashedPassword: hashedPassword,
+	}
+	response := &model.RegisterResponse{Email: email, Status: model.UserStatusUnverified}
+	err = s.userRepo.CreateUser(ctx, user)
+	if errors.Is(err, model.ErrEmailAlreadyRegistered) {
+		if err := s.notifyAlreadyRegistered(ctx, email); err != nil {
+			log.Println("error notifying an already registered email", err)
+		}
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the account exists at this point, the user can ask for another link if this one is lost
+	if err := s.sendVerificationEmail(ctx, user); err != nil {
+		log.Println("error sending verification email", user.ID, err)
+	}
+	return response, nil
+}
+
+// notifyAlreadyRegistered emails the owner of a registered address used to sign up again. An account never verified
+// gets a new verification link instead, its owner most likely lost the first one.
+func (s *RegistrationService) notifyAlreadyRegistered(ctx context.Context, email string) error {
+	user, err := s.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if user.Status == model.UserStatusUnverified {
+		return s.sendVerificationEmail(ctx, user)
+	}
+	return s.notifier.EnqueueAlreadyRegisteredEmail(ctx, model.SendAlreadyRegisteredEmailTask{
+		Email:    user.Email,
+		Locale:   user.Locale,
+		Timezone: user.Timezone,
+	})
+}
+
+// ResendVerification sends a new verification link. Unknown and already verified emails are ignored so the
+// endpoint does not tell which addresses are registered.
+func (s *RegistrationService) ResendVerification(ctx context.Context, email string) error {
+	user, err := s.userRepo.GetUserByEmail(ctx, email)
+	if errors.Is(err, model.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if user.Status != model.UserStatusUnverified {
+		return nil
+	}
+	return s.sendVerificationEmail(ctx, user)
+}
+
+// VerifyEmail redeems a verification token and activates its user.
+func (s *RegistrationService) VerifyEmail(ctx context.Context, token string) error {
+	claims := &model.EmailVerificationClaims{}
+	if err := parseSignedToken(ctx, s.keys, token, claims); err != nil || claims.Purpose != model.TokenPurposeEmailVerification {
+		return model.ErrInvalidVerificationToken
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, claims.UserID)
+	if errors.Is(err, model.ErrUserNotFound) {
+		return model.ErrInvalidVerificationToken
+	}
+	if err != nil {
+		return err
+	}
+	// a link sent to a previous address of the user must not verify the current one
+	if user.Email != claims.Email {
+		return model.ErrInvalidVerificationToken
+	}
+	if user.Status != model.UserStatusUnverified {
+		return nil
+	}
+	return s.userRepo.ActivateUser(ctx, user.ID)
+}
+
+func (s *RegistrationService) sendVerificationEmail(ctx context.Context, user *model.User) error {
+	now := s.nowFn()
+	expiresAt := now.Add(s.cfg.VerificationTokenExp)
+	token, err := s.signer.SignToken(&model.EmailVerificationClaims{
+		UserID:  user.ID,
+		Email:   user.Email,
+		Purpose: model.TokenPurposeEmailVerification,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	link, err := url.Parse(s.cfg.VerificationURL)
+	if err != nil {
+		return err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return s.notifier.EnqueueVerificationEmail(ctx, model.SendVerificationEmailTask{
+		Email:     user.Email,
+		Link:      link.String(),
+		ExpiresAt: expiresAt,
+	})
+}
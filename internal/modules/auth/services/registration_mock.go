@@ -0,0 +1,150 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: registration.go
+//
+// Generated by this command:
+//
+//	mockgen -source=registration.go -destination=registration_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRepositoryForRegistration is a mock of UserRepositoryForRegistration interface.
+type MockUserRepositoryForRegistration struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryForRegistrationMockRecorder
+}
+
+// MockUserRepositoryForRegistrationMockRecorder is the mock recorder for MockUserRepositoryForRegistration.
+type MockUserRepositoryForRegistrationMockRecorder struct {
+	mock *MockUserRepositoryForRegistration
+}
+
+// NewMockUserRepositoryForRegistration creates a new mock instance.
+func NewMockUserRepositoryForRegistration(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+	mock := &MockUserRepositoryForRegistration{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryForRegistrationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepositoryForRegistration) EXPECT() *MockUserRepositoryForRegistrationMockRecorder {
+	return m.recorder
+}
+
+// ActivateUser mocks base method.
+func (m *MockUserRepositoryForRegistration) ActivateUser(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateUser indicates an expected call of ActivateUser.
+func (mr *MockUserRepositoryForRegistrationMockRecorder) ActivateUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateUser", reflect.TypeOf((*MockUserRepositoryForRegistration)(nil).ActivateUser), ctx, id)
+}
+
+// CreateUser mocks base method.
+func (m *MockUserRepositoryForRegistration) CreateUser(ctx context.Context, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockUserRepositoryForRegistrationMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepositoryForRegistration)(nil).CreateUser), ctx, user)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockUserRepositoryForRegistration) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockUserRepositoryForRegistrationMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUserRepositoryForRegistration)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserRepositoryForRegistration) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryForRegistrationMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepositoryForRegistration)(nil).GetUserByID), ctx, id)
+}
+
+// MockVerificationNotifier is a mock of VerificationNotifier interface.
+type MockVerificationNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerificationNotifierMockRecorder
+}
+
+// MockVerificationNotifierMockRecorder is the mock recorder for MockVerificationNotifier.
+type MockVerificationNotifierMockRecorder struct {
+	mock *MockVerificationNotifier
+}
+
+// NewMockVerificationNotifier creates a new mock instance.
+func NewMockVerificationNotifier(ctrl *gomock.Controller) *MockVerificationNotifier {
+	mock := &MockVerificationNotifier{ctrl: ctrl}
+	mock.recorder = &MockVerificationNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerificationNotifier) EXPECT() *MockVerificationNotifierMockRecorder {
+	return m.recorder
+}
+
+// EnqueueAlreadyRegisteredEmail mocks base method.
+func (m *MockVerificationNotifier) EnqueueAlreadyRegisteredEmail(ctx context.Context, task model.SendAlreadyRegisteredEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueAlreadyRegisteredEmail", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueAlreadyRegisteredEmail indicates an expected call of EnqueueAlreadyRegisteredEmail.
+func (mr *MockVerificationNotifierMockRecorder) EnqueueAlreadyRegisteredEmail(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueAlreadyRegisteredEmail", reflect.TypeOf((*MockVerificationNotifier)(nil).EnqueueAlreadyRegisteredEmail), ctx, task)
+}
+
+// EnqueueVerificationEmail mocks base method.
+func (m *MockVerificationNotifier) EnqueueVerificationEmail(ctx context.Context, task model.SendVerificationEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueVerificationEmail", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueVerificationEmail indicates an expected call of EnqueueVerificationEmail.
+func (mr *MockVerificationNotifierMockRecorder) EnqueueVerificationEmail(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueVerificationEmail", reflect.TypeOf((*MockVerificationNotifier)(nil).EnqueueVerificationEmail), ctx, task)
+}
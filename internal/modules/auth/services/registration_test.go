@@ -0,0 +1,357 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+
+	"booking-event/internal/infra/jwks"
+	"booking-event/internal/modules/auth/model"
+)
+
+var registrationConfig = RegistrationConfig{
+	VerificationURL:      "http://localhost:8083/api/v1/verify-email",
+	VerificationTokenExp: time.Hour,
+}
+
+func signVerificationToken(t *testing.T, claims model.EmailVerificationClaims, keys *jwks.KeySet) string {
+	token, err := keys.SignToken(&claims)
+	assert.NoError(t, err)
+	return token
+}
+
+func TestRegistrationService_Register(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Second)
+	tests := []struct {
+		name          string
+		email         string
+		password      string
+		mockUserRepo  func(t *testing.T, ctrl *gomock.Controller) *MockUserRepositoryForRegistration
+		mockNotifier  func(t *testing.T, ctrl *gomock.Controller) *MockVerificationNotifier
+		expectedError error
+	}{
+		{
+			name: "Successful registration",
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, user *model.User) error {
+					assert.Equal(t, model.UserStatusUnverified, user.Status)
+					assert.Equal(t, model.RoleUser, user.Role)
+					assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte("password123")))
+					user.ID = 7
+					return nil
+				})
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockVerificationNotifier {
+				mock := NewMockVerificationNotifier(ctrl)
+				mock.EXPECT().EnqueueVerificationEmail(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, task model.SendVerificationEmailTask) error {
+					assert.Equal(t, "new@example.com", task.Email)
+					assert.Equal(t, now.Add(time.Hour), task.ExpiresAt)
+					link, err := url.Parse(task.Link)
+					assert.NoError(t, err)
+					assert.Equal(t, "/api/v1/verify-email", link.Path)
+
+					claims := &model.EmailVerificationClaims{}
+					assert.NoError(t, parseSignedToken(ctx, testKeys, link.Query().Get("token"), claims))
+					assert.Equal(t, 7, claims.UserID)
+					assert.Equal(t, model.TokenPurposeEmailVerification, claims.Purpose)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "Email already registered",
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(model.ErrEmailAlreadyRegistered)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(&model.User{ID: 3, Email: "new@example.com", Status: model.UserStatusActive, Locale: "vi", Timezone: "Asia/Ho_Chi_Minh"}, nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockVerificationNotifier {
+				mock := NewMockVerificationNotifier(ctrl)
+				mock.EXPECT().EnqueueAlreadyRegisteredEmail(gomock.Any(), model.SendAlreadyRegisteredEmailTask{Email: "new@example.com", Locale: "vi", Timezone: "Asia/Ho_Chi_Minh"}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:  "Email normalized before the lookup and the insert",
+			email: " New@Example.COM ",
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, user *model.User) error {
+					assert.Equal(t, "new@example.com", user.Email)
+					return model.ErrEmailAlreadyRegistered
+				})
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(&model.User{ID: 3, Email: "new@example.com", Status: model.UserStatusActive}, nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockVerificationNotifier {
+				mock := NewMockVerificationNotifier(ctrl)
+				mock.EXPECT().EnqueueAlreadyRegisteredEmail(gomock.Any(), model.SendAlreadyRegisteredEmailTask{Email: "new@example.com"}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Email registered but never verified",
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(model.ErrEmailAlreadyRegistered)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(&model.User{ID: 3, Email: "new@example.com", Status: model.UserStatusUnverified}, nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockVerificationNotifier {
+				mock := NewMockVerificationNotifier(ctrl)
+				mock.EXPECT().EnqueueVerificationEmail(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, task model.SendVerificationEmailTask) error {
+					assert.Equal(t, "new@example.com", task.Email)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name: "Same answer when the notice can not be queued",
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(model.ErrEmailAlreadyRegistered)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(&model.User{ID: 3, Email: "new@example.com", Status: model.UserStatusActive}, nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockVerificationNotifier {
+				mock := NewMockVerificationNotifier(ctrl)
+				mock.EXPECT().EnqueueAlreadyRegisteredEmail(gomock.Any(), gomock.Any()).Return(assert.AnError)
+				return mock
+			},
+		},
+		{
+			name:     "Password over 72 bytes",
+			password: strings.Repeat("é", 40),
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				return NewMockUserRepositoryForRegistration(ctrl)
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockVerificationNotifier {
+				return NewMockVerificationNotifier(ctrl)
+			},
+			expectedError: model.ErrPasswordTooLong,
+		},
+		{
+			name: "Database error",
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(assert.AnError)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockVerificationNotifier {
+				return NewMockVerificationNotifier(ctrl)
+			},
+			expectedError: assert.AnError,
+		},
+		{
+			name: "Registration kept when the email can not be queued",
+			mockUserRepo: func(t *testing.T, ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			mockNotifier: func(t *testing.T, ctrl *gomock.Controller) *MockVerificationNotifier {
+				mock := NewMockVerificationNotifier(ctrl)
+				mock.EXPECT().EnqueueVerificationEmail(gomock.Any(), gomock.Any()).Return(assert.AnError)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			email, password := tt.email, tt.password
+			if email == "" {
+				email = "new@example.com"
+			}
+			if password == "" {
+				password = "password123"
+			}
+			service := NewRegistrationService(tt.mockUserRepo(t, ctrl), tt.mockNotifier(t, ctrl), testKeys, testKeys, func() time.Time { return now }, registrationConfig)
+			response, err := service.Register(context.Background(), model.RegisterRequest{Email: email, Password: password})
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, response)
+			} else {
+				// a registered email gets the answer of a new one
+				assert.NoError(t, err)
+				assert.Equal(t, &model.RegisterResponse{Email: "new@example.com", Status: model.UserStatusUnverified}, response)
+			}
+		})
+	}
+}
+
+func TestRegistrationService_VerifyEmail(t *testing.T) {
+	t.Parallel()
+	validClaims := model.EmailVerificationClaims{
+		UserID:  7,
+		Email:   "new@example.com",
+		Purpose: model.TokenPurposeEmailVerification,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+	expiredClaims := validClaims
+	expiredClaims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	otherPurposeClaims := validClaims
+	otherPurposeClaims.Purpose = "password_reset"
+	// the links used to be signed with a shared secret, those must no longer pass
+	legacyToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &validClaims)
+	legacyToken.Header["kid"] = "test"
+	hmacToken, err := legacyToken.SignedString([]byte("secret_key"))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		token         string
+		mockUserRepo  func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration
+		expectedError error
+	}{
+		{
+			name:  "Successful verification",
+			token: signVerificationToken(t, validClaims, testKeys),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 7).Return(&model.User{ID: 7, Email: "new@example.com", Status: model.UserStatusUnverified}, nil)
+				mock.EXPECT().ActivateUser(gomock.Any(), 7).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:  "Already verified",
+			token: signVerificationToken(t, validClaims, testKeys),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 7).Return(&model.User{ID: 7, Email: "new@example.com", Status: model.UserStatusActive}, nil)
+				return mock
+			},
+		},
+		{
+			name:  "Email changed since the link was sent",
+			token: signVerificationToken(t, validClaims, testKeys),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 7).Return(&model.User{ID: 7, Email: "other@example.com", Status: model.UserStatusUnverified}, nil)
+				return mock
+			},
+			expectedError: model.ErrInvalidVerificationToken,
+		},
+		{
+			name:  "Expired token",
+			token: signVerificationToken(t, expiredClaims, testKeys),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				return NewMockUserRepositoryForRegistration(ctrl)
+			},
+			expectedError: model.ErrInvalidVerificationToken,
+		},
+		{
+			name:  "Token issued for another purpose",
+			token: signVerificationToken(t, otherPurposeClaims, testKeys),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				return NewMockUserRepositoryForRegistration(ctrl)
+			},
+			expectedError: model.ErrInvalidVerificationToken,
+		},
+		{
+			name:  "Token signed with another key",
+			token: signVerificationToken(t, validClaims, newTestKeySet("other")),
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				return NewMockUserRepositoryForRegistration(ctrl)
+			},
+			expectedError: model.ErrInvalidVerificationToken,
+		},
+		{
+			name:  "Token signed with a shared secret",
+			token: hmacToken,
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				return NewMockUserRepositoryForRegistration(ctrl)
+			},
+			expectedError: model.ErrInvalidVerificationToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewRegistrationService(tt.mockUserRepo(ctrl), NewMockVerificationNotifier(ctrl), testKeys, testKeys, time.Now, registrationConfig)
+			err := service.VerifyEmail(context.Background(), tt.token)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRegistrationService_ResendVerification(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		mockUserRepo func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration
+		mockNotifier func(ctrl *gomock.Controller) *MockVerificationNotifier
+	}{
+		{
+			name: "Unverified user",
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(&model.User{ID: 7, Email: "new@example.com", Status: model.UserStatusUnverified}, nil)
+				return mock
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockVerificationNotifier {
+				mock := NewMockVerificationNotifier(ctrl)
+				mock.EXPECT().EnqueueVerificationEmail(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Already verified user",
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(&model.User{ID: 7, Email: "new@example.com", Status: model.UserStatusActive}, nil)
+				return mock
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockVerificationNotifier {
+				return NewMockVerificationNotifier(ctrl)
+			},
+		},
+		{
+			name: "Unknown email",
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRegistration {
+				mock := NewMockUserRepositoryForRegistration(ctrl)
+				mock.EXPECT().GetUserByEmail(gomock.Any(), "new@example.com").Return(nil, model.ErrUserNotFound)
+				return mock
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockVerificationNotifier {
+				return NewMockVerificationNotifier(ctrl)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewRegistrationService(tt.mockUserRepo(ctrl), tt.mockNotifier(ctrl), testKeys, testKeys, time.Now, registrationConfig)
+			assert.NoError(t, service.ResendVerification(context.Background(), "new@example.com"))
+		})
+	}
+}
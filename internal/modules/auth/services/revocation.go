@@ -0,0 +1,57 @@
+//go:generate mockgen -source=revocation.go -destination=revocation_mock.go -package=services
+package services
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+type RevocationRepositoryForChecker interface {
+	IsTokenRevoked(ctx context.Context, jti string, userID int, issuedAt time.Time) (bool, error)
+}
+
+type RevocationCheckerConfig struct {
+	CacheTTL  time.Duration
+	CacheSize int
+}
+
+// RevocationChecker tells whether an access token was revoked. The answers are cached in process for a short
+// time to keep redis off the hot path, a revocation takes up to CacheTTL to be seen by every server.
+type RevocationChecker struct {
+	revocationRepo RevocationRepositoryForChecker
+	nowFn          func() time.Time
+	cfg            RevocationCheckerConfig
+	cache          *util.TTLCache[bool]
+}
+
+func NewRevocationChecker(revocationRepo RevocationRepositoryForChecker, nowFn func() time.Time, cfg RevocationCheckerConfig) *RevocationChecker {
+	return &RevocationChecker{
+		revocationRepo: revocationRepo,
+		nowFn:          nowFn,
+		cfg:            cfg,
+		cache:          util.NewTTLCache[bool](cfg.CacheSize),
+	}
+}
+
+func (c *RevocationChecker) IsTokenRevoked(ctx context.Context, claims *model.TokenClaims) (bool, error) {
+	issuedAt := claims.IssuedAtTime()
+	key := strconv.Itoa(claims.UserID) + ":" + claims.ID
+	now := c.nowFn()
+
+	if revoked, ok := c.cache.Get(key, now); ok {
+		return revoked, nil
+	}
+
+	revoked, err := c.revocationRepo.IsTokenRevoked(ctx, claims.ID, claims.UserID, issuedAt)
+	if err != nil {
+		return false, err
+	}
+	if c.cfg.CacheTTL > 0 {
+		c.cache.Set(key, revoked, now.Add(c.cfg.CacheTTL), now)
+	}
+	return revoked, nil
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: revocation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=revocation.go -destination=revocation_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRevocationRepositoryForChecker is a mock of RevocationRepositoryForChecker interface.
+type MockRevocationRepositoryForChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevocationRepositoryForCheckerMockRecorder
+}
+
+// MockRevocationRepositoryForCheckerMockRecorder is the mock recorder for MockRevocationRepositoryForChecker.
+type MockRevocationRepositoryForCheckerMockRecorder struct {
+	mock *MockRevocationRepositoryForChecker
+}
+
+// NewMockRevocationRepositoryForChecker creates a new mock instance.
+func NewMockRevocationRepositoryForChecker(ctrl *gomock.Controller) *MockRevocationRepositoryForChecker {
+	mock := &MockRevocationRepositoryForChecker{ctrl: ctrl}
+	mock.recorder = &MockRevocationRepositoryForCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevocationRepositoryForChecker) EXPECT() *MockRevocationRepositoryForCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsTokenRevoked mocks base method.
+func (m *MockRevocationRepositoryForChecker) IsTokenRevoked(ctx context.Context, jti string, userID int, issuedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTokenRevoked", ctx, jti, userID, issuedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTokenRevoked indicates an expected call of IsTokenRevoked.
+func (mr *MockRevocationRepositoryForCheckerMockRecorder) IsTokenRevoked(ctx, jti, userID, issuedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTokenRevoked", reflect.TypeOf((*MockRevocationRepositoryForChecker)(nil).IsTokenRevoked), ctx, jti, userID, issuedAt)
+}
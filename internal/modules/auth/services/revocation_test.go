@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+func TestRevocationChecker_IsTokenRevoked(t *testing.T) {
+	t.Parallel()
+	issuedAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	claims := &model.TokenClaims{
+		UserID: 1,
+		Type:   model.TokenTypeAccess,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       "access-1",
+			IssuedAt: jwt.NewNumericDate(issuedAt),
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockRevocationRepositoryForChecker(ctrl)
+	gomock.InOrder(
+		mockRepo.EXPECT().IsTokenRevoked(gomock.Any(), "access-1", 1, issuedAt).Return(false, nil),
+		mockRepo.EXPECT().IsTokenRevoked(gomock.Any(), "access-1", 1, issuedAt).Return(true, nil),
+	)
+
+	now := issuedAt
+	checker := NewRevocationChecker(mockRepo, func() time.Time { return now }, RevocationCheckerConfig{CacheTTL: 5 * time.Second, CacheSize: 10})
+
+	revoked, err := checker.IsTokenRevoked(context.Background(), claims)
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+
+	// served from the cache, the repository is not asked again
+	now = now.Add(4 * time.Second)
+	revoked, err = checker.IsTokenRevoked(context.Background(), claims)
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+
+	// the cached answer expired, the revocation is seen
+	now = now.Add(2 * time.Second)
+	revoked, err = checker.IsTokenRevoked(context.Background(), claims)
+	assert.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestRevocationChecker_RepositoryError(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockRevocationRepositoryForChecker(ctrl)
+	mockRepo.EXPECT().IsTokenRevoked(gomock.Any(), "access-1", 1, time.Time{}).Return(false, assert.AnError).Times(2)
+
+	checker := NewRevocationChecker(mockRepo, time.Now, RevocationCheckerConfig{CacheTTL: time.Minute, CacheSize: 10})
+	claims := &model.TokenClaims{UserID: 1, RegisteredClaims: jwt.RegisteredClaims{ID: "access-1"}}
+
+	// errors are not cached
+	for i := 0; i < 2; i++ {
+		_, err := checker.IsTokenRevoked(context.Background(), claims)
+		assert.ErrorIs(t, err, assert.AnError)
+	}
+}
+
+func TestRevocationChecker_MillisecondIssueTime(t *testing.T) {
+	t.Parallel()
+	service := NewAuthService(nil, nil, nil, nil, nil, testKeys, testKeys, func() string { return "access-1" }, AuthServiceConfig{AccessTokenExp: time.Hour, RefreshTokenExp: time.Hour})
+	before := time.Now().Truncate(time.Millisecond)
+	tokens, _, err := service.generateTokenPair(&model.User{ID: 1, Role: model.RoleUser}, "family-1")
+	assert.NoError(t, err)
+	claims, err := NewTokenVerifier(testKeys).VerifyJWTToken(context.Background(), tokens.AccessToken)
+	assert.NoError(t, err)
+	// iat keeps the second only, iat_ms tells a cutoff set earlier in the same second from one set after
+	issuedAt := claims.IssuedAtTime()
+	assert.Equal(t, claims.IssuedAt.Unix(), issuedAt.Unix())
+	assert.False(t, issuedAt.Before(before))
+	assert.True(t, issuedAt.Before(before.Add(time.Minute)))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := NewMockRevocationRepositoryForChecker(ctrl)
+	mockRepo.EXPECT().IsTokenRevoked(gomock.Any(), "access-1", 1, gomock.Any()).DoAndReturn(func(ctx context.Context, jti string, userID int, at time.Time) (bool, error) {
+		assert.True(t, at.Equal(issuedAt))
+		return false, nil
+	})
+	checker := NewRevocationChecker(mockRepo, time.Now, RevocationCheckerConfig{CacheTTL: time.Minute, CacheSize: 10})
+	revoked, err := checker.IsTokenRevoked(context.Background(), claims)
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+}
+
+func TestTokenClaims_IssuedAtTime(t *testing.T) {
+	t.Parallel()
+	issuedAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	// the tokens signed before iat_ms fall back to the second of iat
+	claims := &model.TokenClaims{RegisteredClaims: jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(issuedAt)}}
+	assert.True(t, claims.IssuedAtTime().Equal(issuedAt))
+	assert.True(t, (&model.TokenClaims{}).IssuedAtTime().IsZero())
+}
@@ -0,0 +1,66 @@
+//go:generate mockgen -source=role.go -destination=role_mock.go -package=services
+package services
+
+import (
+	"context"
+	"time"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type UserRepositoryForRole interface {
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+	UpdateUserRole(ctx context.Context, id int, role model.UserRole) error
+}
+
+type AccessTokenRevoker interface {
+	RevokeUserTokensBefore(ctx context.Context, userID int, before time.Time, ttl time.Duration) error
+}
+
+type RoleServiceConfig struct {
+	AccessTokenExp time.Duration
+}
+
+// RoleService assigns roles, the permissions of a role are granted through the access tokens issued afterwards.
+type RoleService struct {
+	userRepo       UserRepositoryForRole
+	revocationRepo AccessTokenRevoker
+	nowFn          func() time.Time
+	cfg            RoleServiceConfig
+}
+
+func NewRoleService(userRepo UserRepositoryForRole, revocationRepo AccessTokenRevoker, nowFn func() time.Time, cfg RoleServiceConfig) *RoleService {
+	return &RoleService{
+		userRepo:       userRepo,
+		revocationRepo: revocationRepo,
+		nowFn:          nowFn,
+		cfg:            cfg,
+	}
+}
+
+// AssignRole changes the role of a user. The access tokens issued before are revoked so the old permissions stop
+// working right away, the refresh tokens are kept and the next refresh picks up the new role.
+func (s *RoleService) AssignRole(ctx context.Context, params model.AssignRoleRequest) (*model.User, error) {
+	if !params.Role.Valid() {
+		return nil, model.ErrUnknownRole
+	}
+	// keeps the last admin from locking everyone out of the admin routes
+	if params.UserID == params.ExecutorID {
+		return nil, model.ErrCannotChangeOwnRole
+	}
+	user, err := s.userRepo.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == params.Role {
+		return user, nil
+	}
+	if err := s.userRepo.UpdateUserRole(ctx, user.ID, params.Role); err != nil {
+		return nil, err
+	}
+	if err := s.revocationRepo.RevokeUserTokensBefore(ctx, user.ID, s.nowFn(), s.cfg.AccessTokenExp); err != nil {
+		return nil, err
+	}
+	user.Role = params.Role
+	return user, nil
+}
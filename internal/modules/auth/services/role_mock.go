@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: role.go
+//
+// Generated by this command:
+//
+//	mockgen -source=role.go -destination=role_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRepositoryForRole is a mock of UserRepositoryForRole interface.
+type MockUserRepositoryForRole struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryForRoleMockRecorder
+}
+
+// MockUserRepositoryForRoleMockRecorder is the mock recorder for MockUserRepositoryForRole.
+type MockUserRepositoryForRoleMockRecorder struct {
+	mock *MockUserRepositoryForRole
+}
+
+// NewMockUserRepositoryForRole creates a new mock instance.
+func NewMockUserRepositoryForRole(ctrl *gomock.Controller) *MockUserRepositoryForRole {
+	mock := &MockUserRepositoryForRole{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryForRoleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepositoryForRole) EXPECT() *MockUserRepositoryForRoleMockRecorder {
+	return m.recorder
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserRepositoryForRole) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryForRoleMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepositoryForRole)(nil).GetUserByID), ctx, id)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockUserRepositoryForRole) UpdateUserRole(ctx context.Context, id int, role model.UserRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", ctx, id, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockUserRepositoryForRoleMockRecorder) UpdateUserRole(ctx, id, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockUserRepositoryForRole)(nil).UpdateUserRole), ctx, id, role)
+}
+
+// MockAccessTokenRevoker is a mock of AccessTokenRevoker interface.
+type MockAccessTokenRevoker struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenRevokerMockRecorder
+}
+
+// MockAccessTokenRevokerMockRecorder is the mock recorder for MockAccessTokenRevoker.
+type MockAccessTokenRevokerMockRecorder struct {
+	mock *MockAccessTokenRevoker
+}
+
+// NewMockAccessTokenRevoker creates a new mock instance.
+func NewMockAccessTokenRevoker(ctrl *gomock.Controller) *MockAccessTokenRevoker {
+	mock := &MockAccessTokenRevoker{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenRevokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenRevoker) EXPECT() *MockAccessTokenRevokerMockRecorder {
+	return m.recorder
+}
+
+// RevokeUserTokensBefore mocks base method.
+func (m *MockAccessTokenRevoker) RevokeUserTokensBefore(ctx context.Context, userID int, before time.Time, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserTokensBefore", ctx, userID, before, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserTokensBefore indicates an expected call of RevokeUserTokensBefore.
+func (mr *MockAccessTokenRevokerMockRecorder) RevokeUserTokensBefore(ctx, userID, before, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokensBefore", reflect.TypeOf((*MockAccessTokenRevoker)(nil).RevokeUserTokensBefore), ctx, userID, before, ttl)
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+func TestRoleService_AssignRole(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	tests := []struct {
+		name               string
+		params             model.AssignRoleRequest
+		mockUserRepo       func(ctrl *gomock.Controller) *MockUserRepositoryForRole
+		mockRevocationRepo func(ctrl *gomock.Controller) *MockAccessTokenRevoker
+		expectedError      error
+	}{
+		{
+			name:   "Promote a user to organizer",
+			params: model.AssignRoleRequest{UserID: 2, Role: model.RoleOrganizer, ExecutorID: 1},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRole {
+				mock := NewMockUserRepositoryForRole(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 2).Return(&model.User{ID: 2, Role: model.RoleUser}, nil)
+				mock.EXPECT().UpdateUserRole(gomock.Any(), 2, model.RoleOrganizer).Return(nil)
+				return mock
+			},
+			mockRevocationRepo: func(ctrl *gomock.Controller) *MockAccessTokenRevoker {
+				mock := NewMockAccessTokenRevoker(ctrl)
+				mock.EXPECT().RevokeUserTokensBefore(gomock.Any(), 2, now, 15*time.Minute).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "Role unchanged",
+			params: model.AssignRoleRequest{UserID: 2, Role: model.RoleOrganizer, ExecutorID: 1},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRole {
+				mock := NewMockUserRepositoryForRole(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 2).Return(&model.User{ID: 2, Role: model.RoleOrganizer}, nil)
+				return mock
+			},
+			mockRevocationRepo: func(ctrl *gomock.Controller) *MockAccessTokenRevoker {
+				return NewMockAccessTokenRevoker(ctrl)
+			},
+		},
+		{
+			name:   "Unknown role",
+			params: model.AssignRoleRequest{UserID: 2, Role: "superuser", ExecutorID: 1},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRole {
+				return NewMockUserRepositoryForRole(ctrl)
+			},
+			mockRevocationRepo: func(ctrl *gomock.Controller) *MockAccessTokenRevoker {
+				return NewMockAccessTokenRevoker(ctrl)
+			},
+			expectedError: model.ErrUnknownRole,
+		},
+		{
+			name:   "Own role",
+			params: model.AssignRoleRequest{UserID: 1, Role: model.RoleUser, ExecutorID: 1},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRole {
+				return NewMockUserRepositoryForRole(ctrl)
+			},
+			mockRevocationRepo: func(ctrl *gomock.Controller) *MockAccessTokenRevoker {
+				return NewMockAccessTokenRevoker(ctrl)
+			},
+			expectedError: model.ErrCannotChangeOwnRole,
+		},
+		{
+			name:   "User not found",
+			params: model.AssignRoleRequest{UserID: 2, Role: model.RoleOrganizer, ExecutorID: 1},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForRole {
+				mock := NewMockUserRepositoryForRole(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 2).Return(nil, model.ErrUserNotFound)
+				return mock
+			},
+			mockRevocationRepo: func(ctrl *gomock.Controller) *MockAccessTokenRevoker {
+				return NewMockAccessTokenRevoker(ctrl)
+			},
+			expectedError: model.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewRoleService(tt.mockUserRepo(ctrl), tt.mockRevocationRepo(ctrl), func() time.Time { return now }, RoleServiceConfig{
+				AccessTokenExp: 15 * time.Minute,
+			})
+			user, err := service.AssignRole(context.Background(), tt.params)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, user)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.params.Role, user.Role)
+			}
+		})
+	}
+}
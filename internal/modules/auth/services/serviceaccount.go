@@ -0,0 +1,193 @@
+//go:generate mockgen -source=serviceaccount.go -destination=serviceaccount_mock.go -package=services
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+// apiKeyPrefix marks the keys so they are recognized in logs and by secret scanners.
+const apiKeyPrefix = "bek_"
+
+type ServiceAccountRepository interface {
+	CreateServiceAccount(ctx context.Context, account *model.ServiceAccount) error
+	GetServiceAccount(ctx context.Context, id int) (*model.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context) ([]model.ServiceAccount, error)
+	CreateAPIKey(ctx context.Context, key *model.APIKey) error
+	GetAPIKey(ctx context.Context, serviceAccountID int, keyID int) (*model.APIKey, error)
+	ListAPIKeys(ctx context.Context, serviceAccountID int) ([]model.APIKey, error)
+	GetAPIKeyOwnerByHash(ctx context.Context, keyHash string) (*model.APIKeyOwner, error)
+	TouchAPIKey(ctx context.Context, keyID int, usedAt time.Time, interval time.Duration) error
+	RotateAPIKey(ctx context.Context, oldKeyID int, graceEnd time.Time, key *model.APIKey) error
+	RevokeAPIKey(ctx context.Context, serviceAccountID int, keyID int) error
+}
+
+type UserRepositoryForServiceAccount interface {
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+}
+
+type ServiceAccountConfig struct {
+	RotationGrace    time.Duration // how long a rotated key keeps working
+	LastUsedInterval time.Duration // precision of the last use of the keys
+}
+
+// ServiceAccountService manages the service accounts and their API keys, and authenticates the requests made
+// with the keys.
+type ServiceAccountService struct {
+	userRepo    UserRepositoryForServiceAccount
+	accountRepo ServiceAccountRepository
+	nowFn       func() time.Time
+	cfg         ServiceAccountConfig
+}
+
+func NewServiceAccountService(userRepo UserRepositoryForServiceAccount, accountRepo ServiceAccountRepository, nowFn func() time.Time, cfg ServiceAccountConfig) *ServiceAccountService {
+	return &ServiceAccountService{
+		userRepo:    userRepo,
+		accountRepo: accountRepo,
+		nowFn:       nowFn,
+		cfg:         cfg,
+	}
+}
+
+func (s *ServiceAccountService) CreateServiceAccount(ctx context.Context, params model.CreateServiceAccountRequest) (*model.ServiceAccount, error) {
+	if _, err := s.userRepo.GetUserByID(ctx, params.OwnerID); err != nil {
+		return nil, err
+	}
+	account := &model.ServiceAccount{
+		Name:      params.Name,
+		OwnerID:   params.OwnerID,
+		CreatedBy: params.ExecutorID,
+	}
+	if err := s.accountRepo.CreateServiceAccount(ctx, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+func (s *ServiceAccountService) ListServiceAccounts(ctx context.Context) ([]model.ServiceAccount, error) {
+	return s.accountRepo.ListServiceAccounts(ctx)
+}
+
+func (s *ServiceAccountService) ListAPIKeys(ctx context.Context, serviceAccountID int) ([]model.APIKey, error) {
+	if _, err := s.accountRepo.GetServiceAccount(ctx, serviceAccountID); err != nil {
+		return nil, err
+	}
+	return s.accountRepo.ListAPIKeys(ctx, serviceAccountID)
+}
+
+// CreateAPIKey issues a key, the scopes must be permissions the owner holds. The key is only returned here.
+func (s *ServiceAccountService) CreateAPIKey(ctx context.Context, params model.CreateAPIKeyRequest) (*model.CreatedAPIKey, error) {
+	account, err := s.accountRepo.GetServiceAccount(ctx, params.ServiceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := s.userRepo.GetUserByID(ctx, account.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	granted := owner.Role.Permissions()
+	for _, scope := range params.Scopes {
+		if !slices.Contains(granted, scope) {
+			return nil, model.ErrInvalidAPIKeyScope
+		}
+	}
+	if params.ExpiresAt != nil && !params.ExpiresAt.After(s.nowFn()) {
+		return nil, model.ErrInvalidAPIKeyExpiry
+	}
+
+	key, created, err := newAPIKey(account.ID, params.Scopes, params.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.accountRepo.CreateAPIKey(ctx, created); err != nil {
+		return nil, err
+	}
+	return &model.CreatedAPIKey{APIKey: *created, Key: key}, nil
+}
+
+// RotateAPIKey replaces a key by a new one with the same scopes and expiry, the old key keeps working for the
+// rotation grace period.
+func (s *ServiceAccountService) RotateAPIKey(ctx context.Context, params model.APIKeyRequest) (*model.CreatedAPIKey, error) {
+	old, err := s.accountRepo.GetAPIKey(ctx, params.ServiceAccountID, params.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	now := s.nowFn()
+	if !old.Usable(now) {
+		return nil, model.ErrAPIKeyNotFound
+	}
+
+	key, created, err := newAPIKey(old.ServiceAccountID, old.Scopes, old.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.accountRepo.RotateAPIKey(ctx, old.ID, now.Add(s.cfg.RotationGrace), created); err != nil {
+		return nil, err
+	}
+	return &model.CreatedAPIKey{APIKey: *created, Key: key}, nil
+}
+
+func (s *ServiceAccountService) RevokeAPIKey(ctx context.Context, params model.APIKeyRequest) error {
+	return s.accountRepo.RevokeAPIKey(ctx, params.ServiceAccountID, params.KeyID)
+}
+
+// VerifyAPIKey authenticates a request made with an API key. The scopes are checked against the current role of
+// the owner, so a demoted owner takes the permissions away from its keys too.
+func (s *ServiceAccountService) VerifyAPIKey(ctx context.Context, key string) (*model.APIKeyPrincipal, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, model.ErrInvalidAPIKey
+	}
+	apiKey, err := s.accountRepo.GetAPIKeyOwnerByHash(ctx, hashOpaqueToken(key))
+	if errors.Is(err, model.ErrAPIKeyNotFound) {
+		return nil, model.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := s.nowFn()
+	if !apiKey.Usable(now) || apiKey.OwnerStatus != model.UserStatusActive {
+		return nil, model.ErrInvalidAPIKey
+	}
+
+	granted := apiKey.OwnerRole.Permissions()
+	permissions := make([]model.Permission, 0, len(apiKey.Scopes))
+	for _, scope := range apiKey.Scopes {
+		if slices.Contains(granted, scope) {
+			permissions = append(permissions, scope)
+		}
+	}
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= s.cfg.LastUsedInterval {
+		// the last use is informative, failing to record it must not fail the request
+		if err := s.accountRepo.TouchAPIKey(ctx, apiKey.ID, now, s.cfg.LastUsedInterval); err != nil {
+			log.Println("error recording the use of the api key", apiKey.ID, err)
+		}
+	}
+	return &model.APIKeyPrincipal{
+		ServiceAccountID: apiKey.ServiceAccountID,
+		APIKeyID:         apiKey.ID,
+		OwnerID:          apiKey.OwnerID,
+		Permissions:      permissions,
+	}, nil
+}
+
+// newAPIKey generates a key and the record storing its hash.
+func newAPIKey(serviceAccountID int, scopes []model.Permission, expiresAt *time.Time) (string, *model.APIKey, error) {
+	token, err := generateOpaqueToken()
+	if err != nil {
+		return "", nil, err
+	}
+	key := apiKeyPrefix + token
+	return key, &model.APIKey{
+		ServiceAccountID: serviceAccountID,
+		KeyHash:          hashOpaqueToken(key),
+		Prefix:           key[:len(apiKeyPrefix)+8],
+		Scopes:           append(make([]model.Permission, 0, len(scopes)), scopes...),
+		ExpiresAt:        expiresAt,
+	}, nil
+}
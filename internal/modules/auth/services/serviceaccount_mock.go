@@ -0,0 +1,225 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: serviceaccount.go
+//
+// Generated by this command:
+//
+//	mockgen -source=serviceaccount.go -destination=serviceaccount_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockServiceAccountRepository is a mock of ServiceAccountRepository interface.
+type MockServiceAccountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceAccountRepositoryMockRecorder
+}
+
+// MockServiceAccountRepositoryMockRecorder is the mock recorder for MockServiceAccountRepository.
+type MockServiceAccountRepositoryMockRecorder struct {
+	mock *MockServiceAccountRepository
+}
+
+// NewMockServiceAccountRepository creates a new mock instance.
+func NewMockServiceAccountRepository(ctrl *gomock.Controller) *MockServiceAccountRepository {
+	mock := &MockServiceAccountRepository{ctrl: ctrl}
+	mock.recorder = &MockServiceAccountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceAccountRepository) EXPECT() *MockServiceAccountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockServiceAccountRepository) CreateAPIKey(ctx context.Context, key *model.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockServiceAccountRepositoryMockRecorder) CreateAPIKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockServiceAccountRepository)(nil).CreateAPIKey), ctx, key)
+}
+
+// CreateServiceAccount mocks base method.
+func (m *MockServiceAccountRepository) CreateServiceAccount(ctx context.Context, account *model.ServiceAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceAccount", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceAccount indicates an expected call of CreateServiceAccount.
+func (mr *MockServiceAccountRepositoryMockRecorder) CreateServiceAccount(ctx, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccount", reflect.TypeOf((*MockServiceAccountRepository)(nil).CreateServiceAccount), ctx, account)
+}
+
+// GetAPIKey mocks base method.
+func (m *MockServiceAccountRepository) GetAPIKey(ctx context.Context, serviceAccountID, keyID int) (*model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", ctx, serviceAccountID, keyID)
+	ret0, _ := ret[0].(*model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockServiceAccountRepositoryMockRecorder) GetAPIKey(ctx, serviceAccountID, keyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockServiceAccountRepository)(nil).GetAPIKey), ctx, serviceAccountID, keyID)
+}
+
+// GetAPIKeyOwnerByHash mocks base method.
+func (m *MockServiceAccountRepository) GetAPIKeyOwnerByHash(ctx context.Context, keyHash string) (*model.APIKeyOwner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeyOwnerByHash", ctx, keyHash)
+	ret0, _ := ret[0].(*model.APIKeyOwner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeyOwnerByHash indicates an expected call of GetAPIKeyOwnerByHash.
+func (mr *MockServiceAccountRepositoryMockRecorder) GetAPIKeyOwnerByHash(ctx, keyHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeyOwnerByHash", reflect.TypeOf((*MockServiceAccountRepository)(nil).GetAPIKeyOwnerByHash), ctx, keyHash)
+}
+
+// GetServiceAccount mocks base method.
+func (m *MockServiceAccountRepository) GetServiceAccount(ctx context.Context, id int) (*model.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceAccount", ctx, id)
+	ret0, _ := ret[0].(*model.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceAccount indicates an expected call of GetServiceAccount.
+func (mr *MockServiceAccountRepositoryMockRecorder) GetServiceAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccount", reflect.TypeOf((*MockServiceAccountRepository)(nil).GetServiceAccount), ctx, id)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockServiceAccountRepository) ListAPIKeys(ctx context.Context, serviceAccountID int) ([]model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, serviceAccountID)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockServiceAccountRepositoryMockRecorder) ListAPIKeys(ctx, serviceAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockServiceAccountRepository)(nil).ListAPIKeys), ctx, serviceAccountID)
+}
+
+// ListServiceAccounts mocks base method.
+func (m *MockServiceAccountRepository) ListServiceAccounts(ctx context.Context) ([]model.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceAccounts", ctx)
+	ret0, _ := ret[0].([]model.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceAccounts indicates an expected call of ListServiceAccounts.
+func (mr *MockServiceAccountRepositoryMockRecorder) ListServiceAccounts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccounts", reflect.TypeOf((*MockServiceAccountRepository)(nil).ListServiceAccounts), ctx)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockServiceAccountRepository) RevokeAPIKey(ctx context.Context, serviceAccountID, keyID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, serviceAccountID, keyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockServiceAccountRepositoryMockRecorder) RevokeAPIKey(ctx, serviceAccountID, keyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockServiceAccountRepository)(nil).RevokeAPIKey), ctx, serviceAccountID, keyID)
+}
+
+// RotateAPIKey mocks base method.
+func (m *MockServiceAccountRepository) RotateAPIKey(ctx context.Context, oldKeyID int, graceEnd time.Time, key *model.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAPIKey", ctx, oldKeyID, graceEnd, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateAPIKey indicates an expected call of RotateAPIKey.
+func (mr *MockServiceAccountRepositoryMockRecorder) RotateAPIKey(ctx, oldKeyID, graceEnd, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAPIKey", reflect.TypeOf((*MockServiceAccountRepository)(nil).RotateAPIKey), ctx, oldKeyID, graceEnd, key)
+}
+
+// TouchAPIKey mocks base method.
+func (m *MockServiceAccountRepository) TouchAPIKey(ctx context.Context, keyID int, usedAt time.Time, interval time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIKey", ctx, keyID, usedAt, interval)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockServiceAccountRepositoryMockRecorder) TouchAPIKey(ctx, keyID, usedAt, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockServiceAccountRepository)(nil).TouchAPIKey), ctx, keyID, usedAt, interval)
+}
+
+// MockUserRepositoryForServiceAccount is a mock of UserRepositoryForServiceAccount interface.
+type MockUserRepositoryForServiceAccount struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryForServiceAccountMockRecorder
+}
+
+// MockUserRepositoryForServiceAccountMockRecorder is the mock recorder for MockUserRepositoryForServiceAccount.
+type MockUserRepositoryForServiceAccountMockRecorder struct {
+	mock *MockUserRepositoryForServiceAccount
+}
+
+// NewMockUserRepositoryForServiceAccount creates a new mock instance.
+func NewMockUserRepositoryForServiceAccount(ctrl *gomock.Controller) *MockUserRepositoryForServiceAccount {
+	mock := &MockUserRepositoryForServiceAccount{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryForServiceAccountMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepositoryForServiceAccount) EXPECT() *MockUserRepositoryForServiceAccountMockRecorder {
+	return m.recorder
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserRepositoryForServiceAccount) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryForServiceAccountMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepositoryForServiceAccount)(nil).GetUserByID), ctx, id)
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+func TestServiceAccountService_CreateAPIKey(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	account := &model.ServiceAccount{ID: 3, Name: "reseller", OwnerID: 7}
+	organizer := &model.User{ID: 7, Role: model.RoleOrganizer, Status: model.UserStatusActive}
+
+	tests := []struct {
+		name          string
+		request       model.CreateAPIKeyRequest
+		mockRepo      func(ctrl *gomock.Controller) *MockServiceAccountRepository
+		expectedError error
+	}{
+		{
+			name:    "Scopes held by the owner",
+			request: model.CreateAPIKeyRequest{ServiceAccountID: 3, Scopes: []model.Permission{model.PermissionCheckinScan}, ExpiresAt: util.ToPtr(now.Add(time.Hour))},
+			mockRepo: func(ctrl *gomock.Controller) *MockServiceAccountRepository {
+				mock := NewMockServiceAccountRepository(ctrl)
+				mock.EXPECT().GetServiceAccount(gomock.Any(), 3).Return(account, nil)
+				mock.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key *model.APIKey) error {
+					assert.Equal(t, 3, key.ServiceAccountID)
+					assert.Equal(t, []model.Permission{model.PermissionCheckinScan}, key.Scopes)
+					assert.Len(t, key.KeyHash, 64)
+					key.ID = 11
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:    "Scope the owner does not hold",
+			request: model.CreateAPIKeyRequest{ServiceAccountID: 3, Scopes: []model.Permission{model.PermissionBookingRefund}},
+			mockRepo: func(ctrl *gomock.Controller) *MockServiceAccountRepository {
+				mock := NewMockServiceAccountRepository(ctrl)
+				mock.EXPECT().GetServiceAccount(gomock.Any(), 3).Return(account, nil)
+				return mock
+			},
+			expectedError: model.ErrInvalidAPIKeyScope,
+		},
+		{
+			name:    "Expiry in the past",
+			request: model.CreateAPIKeyRequest{ServiceAccountID: 3, ExpiresAt: util.ToPtr(now.Add(-time.Hour))},
+			mockRepo: func(ctrl *gomock.Controller) *MockServiceAccountRepository {
+				mock := NewMockServiceAccountRepository(ctrl)
+				mock.EXPECT().GetServiceAccount(gomock.Any(), 3).Return(account, nil)
+				return mock
+			},
+			expectedError: model.ErrInvalidAPIKeyExpiry,
+		},
+		{
+			name:    "Unknown service account",
+			request: model.CreateAPIKeyRequest{ServiceAccountID: 4},
+			mockRepo: func(ctrl *gomock.Controller) *MockServiceAccountRepository {
+				mock := NewMockServiceAccountRepository(ctrl)
+				mock.EXPECT().GetServiceAccount(gomock.Any(), 4).Return(nil, model.ErrServiceAccountNotFound)
+				return mock
+			},
+			expectedError: model.ErrServiceAccountNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			userRepo := NewMockUserRepositoryForServiceAccount(ctrl)
+			userRepo.EXPECT().GetUserByID(gomock.Any(), 7).Return(organizer, nil).AnyTimes()
+
+			service := NewServiceAccountService(userRepo, tt.mockRepo(ctrl), func() time.Time { return now }, ServiceAccountConfig{RotationGrace: time.Hour})
+			key, err := service.CreateAPIKey(context.Background(), tt.request)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 11, key.ID)
+			assert.True(t, strings.HasPrefix(key.Key, key.Prefix))
+			assert.Equal(t, hashOpaqueToken(key.Key), key.KeyHash)
+		})
+	}
+}
+
+func TestServiceAccountService_VerifyAPIKey(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	key := apiKeyPrefix + "secret"
+	activeKey := func() *model.APIKeyOwner {
+		return &model.APIKeyOwner{
+			APIKey: model.APIKey{
+				ID:               11,
+				ServiceAccountID: 3,
+				Scopes:           []model.Permission{model.PermissionCheckinScan, model.PermissionEventCreate},
+				LastUsedAt:       util.ToPtr(now.Add(-time.Second)),
+			},
+			OwnerID:     7,
+			OwnerRole:   model.RoleOrganizer,
+			OwnerStatus: model.UserStatusActive,
+		}
+	}
+
+	tests := []struct {
+		name              string
+		key               string
+		mockRepo          func(ctrl *gomock.Controller) *MockServiceAccountRepository
+		expectedPrincipal *model.APIKeyPrincipal
+		expectedError     error
+	}{
+		{
+			name: "Valid key",
+			key:  key,
+			mockRepo: func(ctrl *gomock.Controller) *MockServiceAccountRepository {
+				mock := NewMockServiceAccountRepository(ctrl)
+				mock.EXPECT().GetAPIKeyOwnerByHash(gomock.Any(), hashOpaqueToken(key)).Return(activeKey(), nil)
+				return mock
+			},
+			expectedPrincipal: &model.APIKeyPrincipal{
+				ServiceAccountID: 3,
+				APIKeyID:         11,
+				OwnerID:          7,
+				Permissions:      []model.Permission{model.PermissionCheckinScan, model.PermissionEventCreate},
+			},
+		},
+		{
+			name: "Owner demoted, the scopes it lost are dropped and the use is recorded",
+			key:  key,
+			mockRepo: func(ctrl *gomock.Controller) *MockServiceAccountRepository {
+				apiKey := activeKey()
+				apiKey.OwnerRole = model.RoleUser
+				apiKey.LastUsedAt = util.ToPtr(now.Add(-time.Hour))
+				mock := NewMockServiceAccountRepository(ctrl)
+				mock.EXPECT().GetAPIKeyOwnerByHash(gomock.Any(), hashOpaqueToken(key)).Return(apiKey, nil)
+				mock.EXPECT().TouchAPIKey(gomock.Any(), 11, now, time.Minute).Return(nil)
+				return mock
+			},
+			expectedPrincipal: &model.APIKeyPrincipal{
+				ServiceAccountID: 3,
+				APIKeyID:         11,
+				OwnerID:          7,
+				Permissions:      []model.Permission{},
+			},
+		},
+		{
+			name: "Expired key",
+			key:  key,
+			mockRepo: func(ctrl *gomock.Controller) *MockServiceAccountRepository {
+				apiKey := activeKey()
+				apiKey.ExpiresAt = util.ToPtr(now)
+				mock := NewMockServiceAccountRepository(ctrl)
+				mock.EXPECT().GetAPIKeyOwnerByHash(gomock.Any(), hashOpaqueToken(key)).Return(apiKey, nil)
+				return mock
+			},
+			expectedError: model.ErrInvalidAPIKey,
+		},
+		{
+			name: "Revoked key",
+			key:  key,
+			mockRepo: func(ctrl *gomock.Controller) *MockServiceAccountRepository {
+				apiKey := activeKey()
+				apiKey.RevokedAt = util.ToPtr(now.Add(-time.Minute))
+				mock := NewMockServiceAccountRepository(ctrl)
+				mock.EXPECT().GetAPIKeyOwnerByHash(gomock.Any(), hashOpaqueToken(key)).Return(apiKey, nil)
+				return mock
+			},
+			expectedError: model.ErrInvalidAPIKey,
+		},
+		{
+			name: "Unknown key",
+			key:  key,
+			mockRepo: func(ctrl *gomock.Controller) *MockServiceAccountRepository {
+				mock := NewMockServiceAccountRepository(ctrl)
+				mock.EXPECT().GetAPIKeyOwnerByHash(gomock.Any(), hashOpaqueToken(key)).Return(nil, model.ErrAPIKeyNotFound)
+				return mock
+			},
+			expectedError: model.ErrInvalidAPIKey,
+		},
+		{
+			name: "Not an api key",
+			key:  "secret",
+			mockRepo: func(ctrl *gomock.Controller) *MockServiceAccountRepository {
+				return NewMockServiceAccountRepository(ctrl)
+			},
+			expectedError: model.ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewServiceAccountService(NewMockUserRepositoryForServiceAccount(ctrl), tt.mockRepo(ctrl), func() time.Time { return now }, ServiceAccountConfig{LastUsedInterval: time.Minute})
+			principal, err := service.VerifyAPIKey(context.Background(), tt.key)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedPrincipal, principal)
+		})
+	}
+}
+
+func TestServiceAccountService_RotateAPIKey(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(30 * 24 * time.Hour)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := NewMockServiceAccountRepository(ctrl)
+	mockRepo.EXPECT().GetAPIKey(gomock.Any(), 3, 11).Return(&model.APIKey{
+		ID:               11,
+		ServiceAccountID: 3,
+		Scopes:           []model.Permission{model.PermissionCheckinScan},
+		ExpiresAt:        &expiresAt,
+	}, nil)
+	mockRepo.EXPECT().RotateAPIKey(gomock.Any(), 11, now.Add(24*time.Hour), gomock.Any()).DoAndReturn(func(ctx context.Context, oldKeyID int, graceEnd time.Time, key *model.APIKey) error {
+		assert.Equal(t, 3, key.ServiceAccountID)
+		assert.Equal(t, []model.Permission{model.PermissionCheckinScan}, key.Scopes)
+		assert.Equal(t, &expiresAt, key.ExpiresAt)
+		key.ID = 12
+		return nil
+	})
+
+	service := NewServiceAccountService(NewMockUserRepositoryForServiceAccount(ctrl), mockRepo, func() time.Time { return now }, ServiceAccountConfig{RotationGrace: 24 * time.Hour})
+	key, err := service.RotateAPIKey(context.Background(), model.APIKeyRequest{ServiceAccountID: 3, KeyID: 11})
+	assert.NoError(t, err)
+	assert.Equal(t, 12, key.ID)
+	assert.NotEmpty(t, key.Key)
+
+	// a revoked key can not be rotated
+	mockRepo.EXPECT().GetAPIKey(gomock.Any(), 3, 11).Return(&model.APIKey{ID: 11, ServiceAccountID: 3, RevokedAt: &now}, nil)
+	_, err = service.RotateAPIKey(context.Background(), model.APIKeyRequest{ServiceAccountID: 3, KeyID: 11})
+	assert.ErrorIs(t, err, model.ErrAPIKeyNotFound)
+}
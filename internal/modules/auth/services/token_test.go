@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"crypto"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+// refreshingKeys stands for a JWKS client which does not know the key yet and has to fetch it within ctx.
+type refreshingKeys struct {
+	ctx context.Context
+}
+
+func (k *refreshingKeys) PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	k.ctx = ctx
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return testKeys.PublicKey(ctx, kid)
+}
+
+func TestTokenVerifier_ParseJWTToken_Context(t *testing.T) {
+	t.Parallel()
+	token, err := testKeys.SignToken(&model.TokenClaims{
+		UserID:           1,
+		Type:             model.TokenTypeAccess,
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+	})
+	assert.NoError(t, err)
+
+	type ctxKey struct{}
+	keys := &refreshingKeys{}
+	verifier := NewTokenVerifier(keys)
+	ctx := context.WithValue(context.Background(), ctxKey{}, "request")
+
+	claims, err := verifier.ParseJWTToken(ctx, token)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, claims.UserID)
+	assert.Equal(t, "request", keys.ctx.Value(ctxKey{}))
+
+	// a canceled request stops waiting for the keys
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = verifier.ParseJWTToken(canceled, token)
+	assert.ErrorIs(t, err, context.Canceled)
+}
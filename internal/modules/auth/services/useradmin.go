@@ -0,0 +1,78 @@
+//go:generate mockgen -source=useradmin.go -destination=useradmin_mock.go -package=services
+package services
+
+import (
+	"context"
+
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/modules/auth/model"
+)
+
+type UserRepositoryForAdmin interface {
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+	ListUsers(ctx context.Context, query model.ListUsersQuery) (*commonmodel.Page[model.User], error)
+	UpdateUserStatus(ctx context.Context, id int, status model.UserStatus, from ...model.UserStatus) error
+}
+
+type UserSessionRevoker interface {
+	RevokeUserSessions(ctx context.Context, userID int) error
+}
+
+// AdminUserService lets the admins search the users and suspend them. A suspended user can not log in and the
+// tokens it was issued are revoked.
+type AdminUserService struct {
+	userRepo       UserRepositoryForAdmin
+	sessionRevoker UserSessionRevoker
+}
+
+func NewAdminUserService(userRepo UserRepositoryForAdmin, sessionRevoker UserSessionRevoker) *AdminUserService {
+	return &AdminUserService{
+		userRepo:       userRepo,
+		sessionRevoker: sessionRevoker,
+	}
+}
+
+func (s *AdminUserService) ListUsers(ctx context.Context, query model.ListUsersQuery) (*commonmodel.Page[model.User], error) {
+	return s.userRepo.ListUsers(ctx, query)
+}
+
+// SuspendUser suspends the user and revokes its sessions, suspending a suspended user only revokes them again.
+func (s *AdminUserService) SuspendUser(ctx context.Context, params model.SuspendUserRequest) (*model.User, error) {
+	if params.UserID == params.ExecutorID {
+		return nil, model.ErrCannotSuspendSelf
+	}
+	user, err := s.userRepo.GetUserByID(ctx, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != model.UserStatusSuspended {
+		if err := s.userRepo.UpdateUserStatus(ctx, user.ID, model.UserStatusSuspended, user.Status); err != nil {
+			return nil, err
+		}
+		user.Status = model.UserStatusSuspended
+	}
+	if err := s.sessionRevoker.RevokeUserSessions(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// ReactivateUser lifts the suspension, the user gets back to unverified if it never verified its email.
+func (s *AdminUserService) ReactivateUser(ctx context.Context, userID int) (*model.User, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != model.UserStatusSuspended {
+		return nil, model.ErrUserNotSuspended
+	}
+	status := model.UserStatusActive
+	if user.VerifiedAt == nil {
+		status = model.UserStatusUnverified
+	}
+	if err := s.userRepo.UpdateUserStatus(ctx, user.ID, status, model.UserStatusSuspended); err != nil {
+		return nil, err
+	}
+	user.Status = status
+	return user, nil
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: useradmin.go
+//
+// Generated by this command:
+//
+//	mockgen -source=useradmin.go -destination=useradmin_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/common/model"
+	model0 "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRepositoryForAdmin is a mock of UserRepositoryForAdmin interface.
+type MockUserRepositoryForAdmin struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryForAdminMockRecorder
+}
+
+// MockUserRepositoryForAdminMockRecorder is the mock recorder for MockUserRepositoryForAdmin.
+type MockUserRepositoryForAdminMockRecorder struct {
+	mock *MockUserRepositoryForAdmin
+}
+
+// NewMockUserRepositoryForAdmin creates a new mock instance.
+func NewMockUserRepositoryForAdmin(ctrl *gomock.Controller) *MockUserRepositoryForAdmin {
+	mock := &MockUserRepositoryForAdmin{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryForAdminMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepositoryForAdmin) EXPECT() *MockUserRepositoryForAdminMockRecorder {
+	return m.recorder
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserRepositoryForAdmin) GetUserByID(ctx context.Context, id int) (*model0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryForAdminMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepositoryForAdmin)(nil).GetUserByID), ctx, id)
+}
+
+// ListUsers mocks base method.
+func (m *MockUserRepositoryForAdmin) ListUsers(ctx context.Context, query model0.ListUsersQuery) (*model.Page[model0.User], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, query)
+	ret0, _ := ret[0].(*model.Page[model0.User])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockUserRepositoryForAdminMockRecorder) ListUsers(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserRepositoryForAdmin)(nil).ListUsers), ctx, query)
+}
+
+// UpdateUserStatus mocks base method.
+func (m *MockUserRepositoryForAdmin) UpdateUserStatus(ctx context.Context, id int, status model0.UserStatus, from ...model0.UserStatus) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, status}
+	for _, a := range from {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUserStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserStatus indicates an expected call of UpdateUserStatus.
+func (mr *MockUserRepositoryForAdminMockRecorder) UpdateUserStatus(ctx, id, status any, from ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, status}, from...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatus", reflect.TypeOf((*MockUserRepositoryForAdmin)(nil).UpdateUserStatus), varargs...)
+}
+
+// MockUserSessionRevoker is a mock of UserSessionRevoker interface.
+type MockUserSessionRevoker struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserSessionRevokerMockRecorder
+}
+
+// MockUserSessionRevokerMockRecorder is the mock recorder for MockUserSessionRevoker.
+type MockUserSessionRevokerMockRecorder struct {
+	mock *MockUserSessionRevoker
+}
+
+// NewMockUserSessionRevoker creates a new mock instance.
+func NewMockUserSessionRevoker(ctrl *gomock.Controller) *MockUserSessionRevoker {
+	mock := &MockUserSessionRevoker{ctrl: ctrl}
+	mock.recorder = &MockUserSessionRevokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserSessionRevoker) EXPECT() *MockUserSessionRevokerMockRecorder {
+	return m.recorder
+}
+
+// RevokeUserSessions mocks base method.
+func (m *MockUserSessionRevoker) RevokeUserSessions(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserSessions indicates an expected call of RevokeUserSessions.
+func (mr *MockUserSessionRevokerMockRecorder) RevokeUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessions", reflect.TypeOf((*MockUserSessionRevoker)(nil).RevokeUserSessions), ctx, userID)
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+func TestAdminUserService_SuspendUser(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		request        model.SuspendUserRequest
+		mockUserRepo   func(ctrl *gomock.Controller) *MockUserRepositoryForAdmin
+		mockRevoker    func(ctrl *gomock.Controller) *MockUserSessionRevoker
+		expectedError  error
+		expectedStatus model.UserStatus
+	}{
+		{
+			name:    "Active user suspended and logged out",
+			request: model.SuspendUserRequest{UserID: 2, ExecutorID: 1},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForAdmin {
+				mock := NewMockUserRepositoryForAdmin(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 2).Return(&model.User{ID: 2, Status: model.UserStatusActive}, nil)
+				mock.EXPECT().UpdateUserStatus(gomock.Any(), 2, model.UserStatusSuspended, model.UserStatusActive).Return(nil)
+				return mock
+			},
+			mockRevoker: func(ctrl *gomock.Controller) *MockUserSessionRevoker {
+				mock := NewMockUserSessionRevoker(ctrl)
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), 2).Return(nil)
+				return mock
+			},
+			expectedStatus: model.UserStatusSuspended,
+		},
+		{
+			name:    "Suspended user only logged out again",
+			request: model.SuspendUserRequest{UserID: 2, ExecutorID: 1},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForAdmin {
+				mock := NewMockUserRepositoryForAdmin(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 2).Return(&model.User{ID: 2, Status: model.UserStatusSuspended}, nil)
+				return mock
+			},
+			mockRevoker: func(ctrl *gomock.Controller) *MockUserSessionRevoker {
+				mock := NewMockUserSessionRevoker(ctrl)
+				mock.EXPECT().RevokeUserSessions(gomock.Any(), 2).Return(nil)
+				return mock
+			},
+			expectedStatus: model.UserStatusSuspended,
+		},
+		{
+			name:    "Admin suspending itself",
+			request: model.SuspendUserRequest{UserID: 1, ExecutorID: 1},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForAdmin {
+				return NewMockUserRepositoryForAdmin(ctrl)
+			},
+			mockRevoker: func(ctrl *gomock.Controller) *MockUserSessionRevoker {
+				return NewMockUserSessionRevoker(ctrl)
+			},
+			expectedError: model.ErrCannotSuspendSelf,
+		},
+		{
+			name:    "Unknown user",
+			request: model.SuspendUserRequest{UserID: 2, ExecutorID: 1},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForAdmin {
+				mock := NewMockUserRepositoryForAdmin(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 2).Return(nil, model.ErrUserNotFound)
+				return mock
+			},
+			mockRevoker: func(ctrl *gomock.Controller) *MockUserSessionRevoker {
+				return NewMockUserSessionRevoker(ctrl)
+			},
+			expectedError: model.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewAdminUserService(tt.mockUserRepo(ctrl), tt.mockRevoker(ctrl))
+			user, err := service.SuspendUser(context.Background(), tt.request)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, user)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, user.Status)
+			}
+		})
+	}
+}
+
+func TestAdminUserService_ReactivateUser(t *testing.T) {
+	t.Parallel()
+	verifiedAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		user           *model.User
+		expectUpdate   bool
+		expectedError  error
+		expectedStatus model.UserStatus
+	}{
+		{
+			name:           "Verified user back to active",
+			user:           &model.User{ID: 2, Status: model.UserStatusSuspended, VerifiedAt: &verifiedAt},
+			expectUpdate:   true,
+			expectedStatus: model.UserStatusActive,
+		},
+		{
+			name:           "Unverified user back to unverified",
+			user:           &model.User{ID: 2, Status: model.UserStatusSuspended},
+			expectUpdate:   true,
+			expectedStatus: model.UserStatusUnverified,
+		},
+		{
+			name:          "User not suspended",
+			user:          &model.User{ID: 2, Status: model.UserStatusActive, VerifiedAt: &verifiedAt},
+			expectedError: model.ErrUserNotSuspended,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := NewMockUserRepositoryForAdmin(ctrl)
+			userRepo.EXPECT().GetUserByID(gomock.Any(), 2).Return(tt.user, nil)
+			if tt.expectUpdate {
+				userRepo.EXPECT().UpdateUserStatus(gomock.Any(), 2, tt.expectedStatus, model.UserStatusSuspended).Return(nil)
+			}
+
+			service := NewAdminUserService(userRepo, NewMockUserSessionRevoker(ctrl))
+			user, err := service.ReactivateUser(context.Background(), 2)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, user)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, user.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package asyntask
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hibiken/asynq"
+
+	"booking-event/internal/modules/auth/model"
+)
+
+type EmailService interface {
+	SendVerificationEmail(ctx context.Context, task model.SendVerificationEmailTask) error
+	SendAlreadyRegisteredEmail(ctx context.Context, task model.SendAlreadyRegisteredEmailTask) error
+	SendPasswordResetEmail(ctx context.Context, task model.SendPasswordResetEmailTask) error
+	SendAccountLockedEmail(ctx context.Context, task model.SendAccountLockedEmailTask) error
+	SendEmailChangeEmail(ctx context.Context, task model.SendEmailChangeEmailTask) error
+}
+
+type EmailTaskHandler struct {
+	emailService EmailService
+}
+
+func NewEmailTaskHandler(emailService EmailService) *EmailTaskHandler {
+	return &EmailTaskHandler{emailService: emailService}
+}
+
+func (h *EmailTaskHandler) HandleVerificationEmail(ctx context.Context, t *asynq.Task) error {
+	var task model.SendVerificationEmailTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.emailService.SendVerificationEmail(ctx, task)
+}
+
+func (h *EmailTaskHandler) HandleAlreadyRegisteredEmail(ctx context.Context, t *asynq.Task) error {
+	var task model.SendAlreadyRegisteredEmailTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.emailService.SendAlreadyRegisteredEmail(ctx, task)
+}
+
+func (h *EmailTaskHandler) HandlePasswordResetEmail(ctx context.Context, t *asynq.Task) error {
+	var task model.SendPasswordResetEmailTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.emailService.SendPasswordResetEmail(ctx, task)
+}
+
+func (h *EmailTaskHandler) HandleAccountLockedEmail(ctx context.Context, t *asynq.Task) error {
+	var task model.SendAccountLockedEmailTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.emailService.SendAccountLockedEmail(ctx, task)
+}
+
+func (h *EmailTaskHandler) HandleEmailChangeEmail(ctx context.Context, t *asynq.Task) error {
+	var task model.SendEmailChangeEmailTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.emailService.SendEmailChangeEmail(ctx, task)
+}
+
+func (h *EmailTaskHandler) Register(mux *asynq.ServeMux) {
+	mux.HandleFunc(string(model.TaskTypeSendVerificationEmail), h.HandleVerificationEmail)
+	mux.HandleFunc(string(model.TaskTypeSendAlreadyRegisteredEmail), h.HandleAlreadyRegisteredEmail)
+	mux.HandleFunc(string(model.TaskTypeSendPasswordResetEmail), h.HandlePasswordResetEmail)
+	mux.HandleFunc(string(model.TaskTypeSendAccountLockedEmail), h.HandleAccountLockedEmail)
+	mux.HandleFunc(string(model.TaskTypeSendEmailChangeEmail), h.HandleEmailChangeEmail)
+}
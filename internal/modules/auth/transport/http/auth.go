@@ -4,7 +4,9 @@ package transporthttp
 import (
 	"context"
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
@@ -14,7 +16,11 @@ import (
 )
 
 type AuthHandler interface {
-	LoginByEmail(ctx context.Context, email string, password string) (*model.User, *model.TokenPair, error)
+	LoginByEmail(ctx context.Context, attempt model.LoginAttempt) (*model.User, *model.TokenPair, *model.MFAChallenge, error)
+	EnrollMFAWithChallenge(ctx context.Context, challengeToken string) (*model.MFAEnrollment, error)
+	CompleteMFALogin(ctx context.Context, attempt model.MFALoginAttempt) (*model.User, *model.TokenPair, []string, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*model.TokenPair, error)
+	UnlockAccount(ctx context.Context, token string) error
 }
 
 type AuthHttpHandler struct {
@@ -27,6 +33,10 @@ func NewAuthHandler(authService AuthHandler) handler.HttpHandler {
 
 func (h *AuthHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
 	router.POST("/login", h.LoginByEmail)
+	router.POST("/login/mfa", h.CompleteMFALogin)
+	router.POST("/login/mfa/enroll", h.EnrollMFAWithChallenge)
+	router.POST("/token/refresh", h.RefreshToken)
+	router.GET("/unlock-account", h.UnlockAccount)
 }
 
 func (h *AuthHttpHandler) LoginByEmail(c *gin.Context) {
@@ -39,14 +49,109 @@ func (h *AuthHttpHandler) LoginByEmail(c *gin.Context) {
 		})
 		return
 	}
-	user, tokenPair, err := h.authService.LoginByEmail(c.Request.Context(), request.Email, request.Password)
-	if errors.Is(err, model.ErrInvalidPassword) {
+	user, tokenPair, challenge, err := h.authService.LoginByEmail(c.Request.Context(), model.LoginAttempt{
+		Email:     request.Email,
+		Password:  request.Password,
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	})
+	var throttledErr *model.LoginThrottledError
+	if errors.Is(err, model.ErrInvalidCredentials) {
+		c.JSON(http.StatusUnauthorized, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrUserSuspended) {
+		c.JSON(http.StatusForbidden, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.As(err, &throttledErr) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttledErr.RetryAfter.Seconds()))))
+		c.JSON(http.StatusTooManyRequests, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if challenge != nil {
+		c.JSON(http.StatusOK, commonmodel.Response{
+			Success: true,
+			Message: "mfa required",
+			Data: model.MFAChallengeResponse{
+				ChallengeToken:     challenge.Token,
+				ExpiresAt:          challenge.ExpiresAt,
+				EnrollmentRequired: challenge.EnrollmentRequired,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "login success",
+		Data:    newLoginResponse(user, tokenPair, nil),
+	})
+}
+
+// CompleteMFALogin exchanges the challenge token returned by the login and a TOTP or recovery code for the tokens.
+func (h *AuthHttpHandler) CompleteMFALogin(c *gin.Context) {
+	var request model.MFALoginRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	user, tokenPair, recoveryCodes, err := h.authService.CompleteMFALogin(c.Request.Context(), model.MFALoginAttempt{
+		ChallengeToken: request.ChallengeToken,
+		Code:           request.Code,
+		IP:             c.ClientIP(),
+		UserAgent:      c.Request.UserAgent(),
+	})
+	var throttledErr *model.LoginThrottledError
+	if errors.Is(err, model.ErrInvalidMFAChallenge) || errors.Is(err, model.ErrInvalidMFACode) {
 		c.JSON(http.StatusUnauthorized, commonmodel.Response{
 			Success: false,
 			Data:    nil,
 			Message: err.Error(),
 		})
 		return
+	} else if errors.Is(err, model.ErrMFANotEnrolled) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrUserSuspended) {
+		c.JSON(http.StatusForbidden, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.As(err, &throttledErr) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttledErr.RetryAfter.Seconds()))))
+		c.JSON(http.StatusTooManyRequests, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
 	} else if err != nil {
 		c.JSON(http.StatusInternalServerError, commonmodel.Response{
 			Success: false,
@@ -58,10 +163,102 @@ func (h *AuthHttpHandler) LoginByEmail(c *gin.Context) {
 	c.JSON(http.StatusOK, commonmodel.Response{
 		Success: true,
 		Message: "login success",
-		Data: model.LoginResponse{
-			Email:           user.Email,
-			UserID:          user.ID,
-			Role:            string(user.Role),
+		Data:    newLoginResponse(user, tokenPair, recoveryCodes),
+	})
+}
+
+// EnrollMFAWithChallenge starts the enrollment required by the role of the user in the middle of a login.
+func (h *AuthHttpHandler) EnrollMFAWithChallenge(c *gin.Context) {
+	var request model.MFAChallengeEnrollmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	enrollment, err := h.authService.EnrollMFAWithChallenge(c.Request.Context(), request.ChallengeToken)
+	if errors.Is(err, model.ErrInvalidMFAChallenge) {
+		c.JSON(http.StatusUnauthorized, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrMFAAlreadyEnabled) {
+		c.JSON(http.StatusConflict, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "scan the provisioning uri and complete the login with the first code",
+		Data:    enrollment,
+	})
+}
+
+func newLoginResponse(user *model.User, tokenPair *model.TokenPair, recoveryCodes []string) model.LoginResponse {
+	return model.LoginResponse{
+		Email:           user.Email,
+		UserID:          user.ID,
+		Role:            string(user.Role),
+		Permissions:     user.Role.Permissions(),
+		AccessToken:     tokenPair.AccessToken,
+		RefreshToken:    tokenPair.RefreshToken,
+		ExpAccessToken:  tokenPair.AccessTokenExp,
+		ExpRefreshToken: tokenPair.RefreshTokenExp,
+		RecoveryCodes:   recoveryCodes,
+	}
+}
+
+func (h *AuthHttpHandler) RefreshToken(c *gin.Context) {
+	var request model.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	tokenPair, err := h.authService.RefreshToken(c.Request.Context(), request.RefreshToken)
+	if errors.Is(err, model.ErrInvalidRefreshToken) || errors.Is(err, model.ErrRefreshTokenReused) {
+		c.JSON(http.StatusUnauthorized, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrUserSuspended) {
+		c.JSON(http.StatusForbidden, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "token refreshed",
+		Data: model.TokenPairResponse{
 			AccessToken:     tokenPair.AccessToken,
 			RefreshToken:    tokenPair.RefreshToken,
 			ExpAccessToken:  tokenPair.AccessTokenExp,
@@ -69,3 +266,36 @@ func (h *AuthHttpHandler) LoginByEmail(c *gin.Context) {
 		},
 	})
 }
+
+// UnlockAccount is opened from the link of the email sent when an account gets locked.
+func (h *AuthHttpHandler) UnlockAccount(c *gin.Context) {
+	var request model.UnlockAccountRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	err := h.authService.UnlockAccount(c.Request.Context(), request.Token)
+	if errors.Is(err, model.ErrInvalidUnlockToken) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "account unlocked",
+	})
+}
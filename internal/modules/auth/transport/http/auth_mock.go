@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: auth.go
+//
+// Generated by this command:
+//
+//	mockgen -source=auth.go -destination=auth_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthHandler is a mock of AuthHandler interface.
+type MockAuthHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthHandlerMockRecorder
+}
+
+// MockAuthHandlerMockRecorder is the mock recorder for MockAuthHandler.
+type MockAuthHandlerMockRecorder struct {
+	mock *MockAuthHandler
+}
+
+// NewMockAuthHandler creates a new mock instance.
+func NewMockAuthHandler(ctrl *gomock.Controller) *MockAuthHandler {
+	mock := &MockAuthHandler{ctrl: ctrl}
+	mock.recorder = &MockAuthHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthHandler) EXPECT() *MockAuthHandlerMockRecorder {
+	return m.recorder
+}
+
+// CompleteMFALogin mocks base method.
+func (m *MockAuthHandler) CompleteMFALogin(ctx context.Context, attempt model.MFALoginAttempt) (*model.User, *model.TokenPair, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMFALogin", ctx, attempt)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(*model.TokenPair)
+	ret2, _ := ret[2].([]string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// CompleteMFALogin indicates an expected call of CompleteMFALogin.
+func (mr *MockAuthHandlerMockRecorder) CompleteMFALogin(ctx, attempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMFALogin", reflect.TypeOf((*MockAuthHandler)(nil).CompleteMFALogin), ctx, attempt)
+}
+
+// EnrollMFAWithChallenge mocks base method.
+func (m *MockAuthHandler) EnrollMFAWithChallenge(ctx context.Context, challengeToken string) (*model.MFAEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMFAWithChallenge", ctx, challengeToken)
+	ret0, _ := ret[0].(*model.MFAEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFAWithChallenge indicates an expected call of EnrollMFAWithChallenge.
+func (mr *MockAuthHandlerMockRecorder) EnrollMFAWithChallenge(ctx, challengeToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFAWithChallenge", reflect.TypeOf((*MockAuthHandler)(nil).EnrollMFAWithChallenge), ctx, challengeToken)
+}
+
+// LoginByEmail mocks base method.
+func (m *MockAuthHandler) LoginByEmail(ctx context.Context, attempt model.LoginAttempt) (*model.User, *model.TokenPair, *model.MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginByEmail", ctx, attempt)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(*model.TokenPair)
+	ret2, _ := ret[2].(*model.MFAChallenge)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// LoginByEmail indicates an expected call of LoginByEmail.
+func (mr *MockAuthHandlerMockRecorder) LoginByEmail(ctx, attempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginByEmail", reflect.TypeOf((*MockAuthHandler)(nil).LoginByEmail), ctx, attempt)
+}
+
+// RefreshToken mocks base method.
+func (m *MockAuthHandler) RefreshToken(ctx context.Context, refreshToken string) (*model.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken", ctx, refreshToken)
+	ret0, _ := ret[0].(*model.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockAuthHandlerMockRecorder) RefreshToken(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthHandler)(nil).RefreshToken), ctx, refreshToken)
+}
+
+// UnlockAccount mocks base method.
+func (m *MockAuthHandler) UnlockAccount(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockAccount", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockAccount indicates an expected call of UnlockAccount.
+func (mr *MockAuthHandlerMockRecorder) UnlockAccount(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAccount", reflect.TypeOf((*MockAuthHandler)(nil).UnlockAccount), ctx, token)
+}
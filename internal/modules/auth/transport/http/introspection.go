@@ -0,0 +1,46 @@
+//go:generate mockgen -source=introspection.go -destination=introspection_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	"booking-event/internal/modules/auth/model"
+)
+
+type IntrospectionHandler interface {
+	Introspect(ctx context.Context, token string) (*model.IntrospectionResponse, error)
+}
+
+// IntrospectionHttpHandler lets the other servers check tokens with central-auth, the routes must be behind the
+// client authentication.
+type IntrospectionHttpHandler struct {
+	introspectionService IntrospectionHandler
+}
+
+func NewIntrospectionHandler(introspectionService IntrospectionHandler) handler.HttpHandler {
+	return &IntrospectionHttpHandler{introspectionService: introspectionService}
+}
+
+func (h *IntrospectionHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/introspect", h.Introspect)
+}
+
+// Introspect answers with the bare RFC 7662 document, like the JWKS it is read by other servers and not the web app.
+func (h *IntrospectionHttpHandler) Introspect(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	var request model.IntrospectionRequest
+	if err := c.ShouldBind(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_request"})
+		return
+	}
+	response, err := h.introspectionService.Introspect(c.Request.Context(), request.Token)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "server_error"})
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
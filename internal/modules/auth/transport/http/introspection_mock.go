@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: introspection.go
+//
+// Generated by this command:
+//
+//	mockgen -source=introspection.go -destination=introspection_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIntrospectionHandler is a mock of IntrospectionHandler interface.
+type MockIntrospectionHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockIntrospectionHandlerMockRecorder
+}
+
+// MockIntrospectionHandlerMockRecorder is the mock recorder for MockIntrospectionHandler.
+type MockIntrospectionHandlerMockRecorder struct {
+	mock *MockIntrospectionHandler
+}
+
+// NewMockIntrospectionHandler creates a new mock instance.
+func NewMockIntrospectionHandler(ctrl *gomock.Controller) *MockIntrospectionHandler {
+	mock := &MockIntrospectionHandler{ctrl: ctrl}
+	mock.recorder = &MockIntrospectionHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIntrospectionHandler) EXPECT() *MockIntrospectionHandlerMockRecorder {
+	return m.recorder
+}
+
+// Introspect mocks base method.
+func (m *MockIntrospectionHandler) Introspect(ctx context.Context, token string) (*model.IntrospectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Introspect", ctx, token)
+	ret0, _ := ret[0].(*model.IntrospectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Introspect indicates an expected call of Introspect.
+func (mr *MockIntrospectionHandlerMockRecorder) Introspect(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Introspect", reflect.TypeOf((*MockIntrospectionHandler)(nil).Introspect), ctx, token)
+}
@@ -0,0 +1,34 @@
+//go:generate mockgen -source=jwks.go -destination=jwks_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	"booking-event/internal/infra/jwks"
+)
+
+type JWKSProvider interface {
+	JWKS() jwks.JSONWebKeySet
+}
+
+// JWKSHttpHandler publishes the public keys verifying the tokens issued by central-auth.
+type JWKSHttpHandler struct {
+	keys JWKSProvider
+}
+
+func NewJWKSHandler(keys JWKSProvider) handler.HttpHandler {
+	return &JWKSHttpHandler{keys: keys}
+}
+
+func (h *JWKSHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/.well-known/jwks.json", h.GetJWKS)
+}
+
+// GetJWKS answers with the bare JWKS document, verifiers expect the standard format and not the api envelope.
+func (h *JWKSHttpHandler) GetJWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.keys.JWKS())
+}
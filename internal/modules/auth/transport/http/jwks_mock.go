@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: jwks.go
+//
+// Generated by this command:
+//
+//	mockgen -source=jwks.go -destination=jwks_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	jwks "booking-event/internal/infra/jwks"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJWKSProvider is a mock of JWKSProvider interface.
+type MockJWKSProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockJWKSProviderMockRecorder
+}
+
+// MockJWKSProviderMockRecorder is the mock recorder for MockJWKSProvider.
+type MockJWKSProviderMockRecorder struct {
+	mock *MockJWKSProvider
+}
+
+// NewMockJWKSProvider creates a new mock instance.
+func NewMockJWKSProvider(ctrl *gomock.Controller) *MockJWKSProvider {
+	mock := &MockJWKSProvider{ctrl: ctrl}
+	mock.recorder = &MockJWKSProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJWKSProvider) EXPECT() *MockJWKSProviderMockRecorder {
+	return m.recorder
+}
+
+// JWKS mocks base method.
+func (m *MockJWKSProvider) JWKS() jwks.JSONWebKeySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(jwks.JSONWebKeySet)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockJWKSProviderMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockJWKSProvider)(nil).JWKS))
+}
@@ -0,0 +1,143 @@
+//go:generate mockgen -source=mfa.go -destination=mfa_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+type MFAHandler interface {
+	Enroll(ctx context.Context, userID int) (*model.MFAEnrollment, error)
+	ConfirmEnrollment(ctx context.Context, request model.MFACodeRequest) ([]string, error)
+	Disable(ctx context.Context, request model.MFACodeRequest) error
+}
+
+// MFAHttpHandler manages the second factor of the caller, its routes must be behind the auth middleware.
+type MFAHttpHandler struct {
+	mfaService MFAHandler
+}
+
+func NewMFAHandler(mfaService MFAHandler) handler.HttpHandler {
+	return &MFAHttpHandler{mfaService: mfaService}
+}
+
+func (h *MFAHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/me/mfa/enroll", h.Enroll)
+	router.POST("/me/mfa/confirm", h.ConfirmEnrollment)
+	router.DELETE("/me/mfa", h.Disable)
+}
+
+func (h *MFAHttpHandler) Enroll(c *gin.Context) {
+	enrollment, err := h.mfaService.Enroll(c.Request.Context(), util.GetUserIDContext(c.Request.Context()))
+	if errors.Is(err, model.ErrMFAAlreadyEnabled) {
+		c.JSON(http.StatusConflict, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "scan the provisioning uri and confirm with the first code",
+		Data:    enrollment,
+	})
+}
+
+func (h *MFAHttpHandler) ConfirmEnrollment(c *gin.Context) {
+	var request model.MFACodeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.UserID = util.GetUserIDContext(c.Request.Context())
+
+	recoveryCodes, err := h.mfaService.ConfirmEnrollment(c.Request.Context(), request)
+	if errors.Is(err, model.ErrInvalidMFACode) || errors.Is(err, model.ErrMFANotEnrolled) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrMFAAlreadyEnabled) {
+		c.JSON(http.StatusConflict, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "mfa enabled, store the recovery codes, they are not shown again",
+		Data:    model.MFARecoveryCodesResponse{RecoveryCodes: recoveryCodes},
+	})
+}
+
+func (h *MFAHttpHandler) Disable(c *gin.Context) {
+	var request model.MFACodeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.UserID = util.GetUserIDContext(c.Request.Context())
+
+	err := h.mfaService.Disable(c.Request.Context(), request)
+	if errors.Is(err, model.ErrInvalidMFACode) || errors.Is(err, model.ErrMFANotEnrolled) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrMFARequired) {
+		c.JSON(http.StatusForbidden, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "mfa disabled",
+	})
+}
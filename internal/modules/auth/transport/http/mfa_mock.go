@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: mfa.go
+//
+// Generated by this command:
+//
+//	mockgen -source=mfa.go -destination=mfa_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMFAHandler is a mock of MFAHandler interface.
+type MockMFAHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFAHandlerMockRecorder
+}
+
+// MockMFAHandlerMockRecorder is the mock recorder for MockMFAHandler.
+type MockMFAHandlerMockRecorder struct {
+	mock *MockMFAHandler
+}
+
+// NewMockMFAHandler creates a new mock instance.
+func NewMockMFAHandler(ctrl *gomock.Controller) *MockMFAHandler {
+	mock := &MockMFAHandler{ctrl: ctrl}
+	mock.recorder = &MockMFAHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFAHandler) EXPECT() *MockMFAHandlerMockRecorder {
+	return m.recorder
+}
+
+// ConfirmEnrollment mocks base method.
+func (m *MockMFAHandler) ConfirmEnrollment(ctx context.Context, request model.MFACodeRequest) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEnrollment", ctx, request)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEnrollment indicates an expected call of ConfirmEnrollment.
+func (mr *MockMFAHandlerMockRecorder) ConfirmEnrollment(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEnrollment", reflect.TypeOf((*MockMFAHandler)(nil).ConfirmEnrollment), ctx, request)
+}
+
+// Disable mocks base method.
+func (m *MockMFAHandler) Disable(ctx context.Context, request model.MFACodeRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable.
+func (mr *MockMFAHandlerMockRecorder) Disable(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockMFAHandler)(nil).Disable), ctx, request)
+}
+
+// Enroll mocks base method.
+func (m *MockMFAHandler) Enroll(ctx context.Context, userID int) (*model.MFAEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enroll", ctx, userID)
+	ret0, _ := ret[0].(*model.MFAEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enroll indicates an expected call of Enroll.
+func (mr *MockMFAHandlerMockRecorder) Enroll(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enroll", reflect.TypeOf((*MockMFAHandler)(nil).Enroll), ctx, userID)
+}
@@ -0,0 +1,123 @@
+//go:generate mockgen -source=oidc.go -destination=oidc_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/modules/auth/model"
+)
+
+type OIDCHandler interface {
+	AuthorizationURL(ctx context.Context, provider string) (string, error)
+	Callback(ctx context.Context, request model.OIDCCallbackRequest) (*model.User, *model.TokenPair, *model.MFAChallenge, error)
+}
+
+// OIDCHttpHandler logs users in with the configured identity providers, its routes are public.
+type OIDCHttpHandler struct {
+	oidcService OIDCHandler
+}
+
+func NewOIDCHandler(oidcService OIDCHandler) handler.HttpHandler {
+	return &OIDCHttpHandler{oidcService: oidcService}
+}
+
+func (h *OIDCHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/oauth/:provider/authorize", h.Authorize)
+	router.GET("/oauth/:provider/callback", h.Callback)
+}
+
+// Authorize redirects the browser to the login page of the provider.
+func (h *OIDCHttpHandler) Authorize(c *gin.Context) {
+	authURL, err := h.oidcService.AuthorizationURL(c.Request.Context(), c.Param("provider"))
+	if errors.Is(err, model.ErrUnknownIdentityProvider) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// Callback is where the provider sends the browser back, it answers like the password login.
+func (h *OIDCHttpHandler) Callback(c *gin.Context) {
+	var request model.OIDCCallbackRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.Provider = c.Param("provider")
+
+	user, tokenPair, challenge, err := h.oidcService.Callback(c.Request.Context(), request)
+	if errors.Is(err, model.ErrUnknownIdentityProvider) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrInvalidOIDCState) || errors.Is(err, model.ErrOIDCEmailNotVerified) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrUserSuspended) {
+		c.JSON(http.StatusForbidden, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrOIDCLoginFailed) {
+		c.JSON(http.StatusUnauthorized, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: model.ErrOIDCLoginFailed.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if challenge != nil {
+		c.JSON(http.StatusOK, commonmodel.Response{
+			Success: true,
+			Message: "mfa required",
+			Data: model.MFAChallengeResponse{
+				ChallengeToken:     challenge.Token,
+				ExpiresAt:          challenge.ExpiresAt,
+				EnrollmentRequired: challenge.EnrollmentRequired,
+			},
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "login success",
+		Data:    newLoginResponse(user, tokenPair, nil),
+	})
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oidc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=oidc.go -destination=oidc_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOIDCHandler is a mock of OIDCHandler interface.
+type MockOIDCHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCHandlerMockRecorder
+}
+
+// MockOIDCHandlerMockRecorder is the mock recorder for MockOIDCHandler.
+type MockOIDCHandlerMockRecorder struct {
+	mock *MockOIDCHandler
+}
+
+// NewMockOIDCHandler creates a new mock instance.
+func NewMockOIDCHandler(ctrl *gomock.Controller) *MockOIDCHandler {
+	mock := &MockOIDCHandler{ctrl: ctrl}
+	mock.recorder = &MockOIDCHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCHandler) EXPECT() *MockOIDCHandlerMockRecorder {
+	return m.recorder
+}
+
+// AuthorizationURL mocks base method.
+func (m *MockOIDCHandler) AuthorizationURL(ctx context.Context, provider string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizationURL", ctx, provider)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizationURL indicates an expected call of AuthorizationURL.
+func (mr *MockOIDCHandlerMockRecorder) AuthorizationURL(ctx, provider any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizationURL", reflect.TypeOf((*MockOIDCHandler)(nil).AuthorizationURL), ctx, provider)
+}
+
+// Callback mocks base method.
+func (m *MockOIDCHandler) Callback(ctx context.Context, request model.OIDCCallbackRequest) (*model.User, *model.TokenPair, *model.MFAChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Callback", ctx, request)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(*model.TokenPair)
+	ret2, _ := ret[2].(*model.MFAChallenge)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// Callback indicates an expected call of Callback.
+func (mr *MockOIDCHandlerMockRecorder) Callback(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Callback", reflect.TypeOf((*MockOIDCHandler)(nil).Callback), ctx, request)
+}
@@ -0,0 +1,145 @@
+//go:generate mockgen -source=password.go -destination=password_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+type PasswordHandler interface {
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, params model.ResetPasswordRequest) error
+	ChangePassword(ctx context.Context, params model.ChangePasswordRequest) error
+}
+
+// PasswordHttpHandler recovers forgotten passwords, its routes are public.
+type PasswordHttpHandler struct {
+	passwordService PasswordHandler
+}
+
+func NewPasswordHandler(passwordService PasswordHandler) handler.HttpHandler {
+	return &PasswordHttpHandler{passwordService: passwordService}
+}
+
+func (h *PasswordHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/password/forgot", h.ForgotPassword)
+	router.POST("/password/reset", h.ResetPassword)
+}
+
+func (h *PasswordHttpHandler) ForgotPassword(c *gin.Context) {
+	var request model.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err := h.passwordService.ForgotPassword(c.Request.Context(), request.Email); err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "if the email is registered, a password reset link was sent",
+	})
+}
+
+func (h *PasswordHttpHandler) ResetPassword(c *gin.Context) {
+	var request model.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	err := h.passwordService.ResetPassword(c.Request.Context(), request)
+	if errors.Is(err, model.ErrInvalidResetToken) || errors.Is(err, model.ErrPasswordTooLong) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "password reset, log in with the new password",
+	})
+}
+
+// AccountPasswordHttpHandler changes the password of the caller, its routes must be behind the auth middleware.
+type AccountPasswordHttpHandler struct {
+	passwordService PasswordHandler
+}
+
+func NewAccountPasswordHandler(passwordService PasswordHandler) handler.HttpHandler {
+	return &AccountPasswordHttpHandler{passwordService: passwordService}
+}
+
+func (h *AccountPasswordHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.PUT("/me/password", h.ChangePassword)
+}
+
+func (h *AccountPasswordHttpHandler) ChangePassword(c *gin.Context) {
+	var request model.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.UserID = util.GetUserIDContext(c.Request.Context())
+
+	err := h.passwordService.ChangePassword(c.Request.Context(), request)
+	if errors.Is(err, model.ErrInvalidPassword) {
+		c.JSON(http.StatusForbidden, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrPasswordTooLong) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "password changed, every session was logged out",
+	})
+}
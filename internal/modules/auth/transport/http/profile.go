@@ -0,0 +1,210 @@
+//go:generate mockgen -source=profile.go -destination=profile_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+type ProfileHandler interface {
+	GetProfile(ctx context.Context, userID int) (*model.User, error)
+	UpdateProfile(ctx context.Context, params model.UpdateProfileRequest) (*model.User, error)
+	RequestEmailChange(ctx context.Context, params model.ChangeEmailRequest) error
+	VerifyEmailChange(ctx context.Context, token string) error
+}
+
+// EmailChangeHttpHandler confirms the new email addresses from the link sent to them, its routes are public.
+type EmailChangeHttpHandler struct {
+	profileService ProfileHandler
+}
+
+func NewEmailChangeHandler(profileService ProfileHandler) handler.HttpHandler {
+	return &EmailChangeHttpHandler{profileService: profileService}
+}
+
+func (h *EmailChangeHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/verify-email-change", h.VerifyEmailChange)
+}
+
+func (h *EmailChangeHttpHandler) VerifyEmailChange(c *gin.Context) {
+	var request model.VerifyEmailChangeRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	err := h.profileService.VerifyEmailChange(c.Request.Context(), request.Token)
+	if errors.Is(err, model.ErrInvalidEmailChange) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrEmailAlreadyRegistered) {
+		c.JSON(http.StatusConflict, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "email changed",
+	})
+}
+
+// ProfileHttpHandler lets the users manage their own account, its routes must be behind the auth middleware.
+type ProfileHttpHandler struct {
+	profileService ProfileHandler
+}
+
+func NewProfileHandler(profileService ProfileHandler) handler.HttpHandler {
+	return &ProfileHttpHandler{profileService: profileService}
+}
+
+func (h *ProfileHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/me", h.GetProfile)
+	router.PATCH("/me", h.UpdateProfile)
+	router.POST("/me/email", h.RequestEmailChange)
+}
+
+func (h *ProfileHttpHandler) GetProfile(c *gin.Context) {
+	user, err := h.profileService.GetProfile(c.Request.Context(), util.GetUserIDContext(c.Request.Context()))
+	if errors.Is(err, model.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "profile",
+		Data:    model.NewUserProfile(user),
+	})
+}
+
+func (h *ProfileHttpHandler) UpdateProfile(c *gin.Context) {
+	var request model.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.UserID = util.GetUserIDContext(c.Request.Context())
+
+	user, err := h.profileService.UpdateProfile(c.Request.Context(), request)
+	if errors.Is(err, model.ErrInvalidBirthdate) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "profile updated",
+		Data:    model.NewUserProfile(user),
+	})
+}
+
+func (h *ProfileHttpHandler) RequestEmailChange(c *gin.Context) {
+	var request model.ChangeEmailRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.UserID = util.GetUserIDContext(c.Request.Context())
+
+	err := h.profileService.RequestEmailChange(c.Request.Context(), request)
+	if errors.Is(err, model.ErrEmailUnchanged) || errors.Is(err, model.ErrCurrentPasswordNeeded) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrInvalidPassword) {
+		c.JSON(http.StatusForbidden, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrEmailAlreadyRegistered) {
+		c.JSON(http.StatusConflict, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusAccepted, commonmodel.Response{
+		Success: true,
+		Message: "check the new email to confirm the change",
+	})
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: profile.go
+//
+// Generated by this command:
+//
+//	mockgen -source=profile.go -destination=profile_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProfileHandler is a mock of ProfileHandler interface.
+type MockProfileHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileHandlerMockRecorder
+}
+
+// MockProfileHandlerMockRecorder is the mock recorder for MockProfileHandler.
+type MockProfileHandlerMockRecorder struct {
+	mock *MockProfileHandler
+}
+
+// NewMockProfileHandler creates a new mock instance.
+func NewMockProfileHandler(ctrl *gomock.Controller) *MockProfileHandler {
+	mock := &MockProfileHandler{ctrl: ctrl}
+	mock.recorder = &MockProfileHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfileHandler) EXPECT() *MockProfileHandlerMockRecorder {
+	return m.recorder
+}
+
+// GetProfile mocks base method.
+func (m *MockProfileHandler) GetProfile(ctx context.Context, userID int) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx, userID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockProfileHandlerMockRecorder) GetProfile(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockProfileHandler)(nil).GetProfile), ctx, userID)
+}
+
+// RequestEmailChange mocks base method.
+func (m *MockProfileHandler) RequestEmailChange(ctx context.Context, params model.ChangeEmailRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestEmailChange", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestEmailChange indicates an expected call of RequestEmailChange.
+func (mr *MockProfileHandlerMockRecorder) RequestEmailChange(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestEmailChange", reflect.TypeOf((*MockProfileHandler)(nil).RequestEmailChange), ctx, params)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockProfileHandler) UpdateProfile(ctx context.Context, params model.UpdateProfileRequest) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, params)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockProfileHandlerMockRecorder) UpdateProfile(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockProfileHandler)(nil).UpdateProfile), ctx, params)
+}
+
+// VerifyEmailChange mocks base method.
+func (m *MockProfileHandler) VerifyEmailChange(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmailChange", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmailChange indicates an expected call of VerifyEmailChange.
+func (mr *MockProfileHandlerMockRecorder) VerifyEmailChange(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmailChange", reflect.TypeOf((*MockProfileHandler)(nil).VerifyEmailChange), ctx, token)
+}
@@ -0,0 +1,123 @@
+//go:generate mockgen -source=registration.go -destination=registration_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/modules/auth/model"
+)
+
+type RegistrationHandler interface {
+	Register(ctx context.Context, params model.RegisterRequest) (*model.RegisterResponse, error)
+	ResendVerification(ctx context.Context, email string) error
+	VerifyEmail(ctx context.Context, token string) error
+}
+
+type RegistrationHttpHandler struct {
+	registrationService RegistrationHandler
+}
+
+func NewRegistrationHandler(registrationService RegistrationHandler) handler.HttpHandler {
+	return &RegistrationHttpHandler{registrationService: registrationService}
+}
+
+func (h *RegistrationHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/register", h.Register)
+	router.GET("/verify-email", h.VerifyEmail)
+	router.POST("/verify-email/resend", h.ResendVerification)
+}
+
+func (h *RegistrationHttpHandler) Register(c *gin.Context) {
+	var request model.RegisterRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	response, err := h.registrationService.Register(c.Request.Context(), request)
+	if errors.Is(err, model.ErrPasswordTooLong) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, commonmodel.Response{
+		Success: true,
+		Message: "registration success, check your email to verify your account",
+		Data:    response,
+	})
+}
+
+func (h *RegistrationHttpHandler) VerifyEmail(c *gin.Context) {
+	var request model.VerifyEmailRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	err := h.registrationService.VerifyEmail(c.Request.Context(), request.Token)
+	if errors.Is(err, model.ErrInvalidVerificationToken) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "email verified",
+	})
+}
+
+func (h *RegistrationHttpHandler) ResendVerification(c *gin.Context) {
+	var request model.ResendVerificationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err := h.registrationService.ResendVerification(c.Request.Context(), request.Email); err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "if the email is registered and not verified yet, a new verification link was sent",
+	})
+}
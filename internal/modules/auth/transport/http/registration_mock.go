@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: registration.go
+//
+// Generated by this command:
+//
+//	mockgen -source=registration.go -destination=registration_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRegistrationHandler is a mock of RegistrationHandler interface.
+type MockRegistrationHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistrationHandlerMockRecorder
+}
+
+// MockRegistrationHandlerMockRecorder is the mock recorder for MockRegistrationHandler.
+type MockRegistrationHandlerMockRecorder struct {
+	mock *MockRegistrationHandler
+}
+
+// NewMockRegistrationHandler creates a new mock instance.
+func NewMockRegistrationHandler(ctrl *gomock.Controller) *MockRegistrationHandler {
+	mock := &MockRegistrationHandler{ctrl: ctrl}
+	mock.recorder = &MockRegistrationHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistrationHandler) EXPECT() *MockRegistrationHandlerMockRecorder {
+	return m.recorder
+}
+
+// Register mocks base method.
+func (m *MockRegistrationHandler) Register(ctx context.Context, params model.RegisterRequest) (*model.RegisterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, params)
+	ret0, _ := ret[0].(*model.RegisterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockRegistrationHandlerMockRecorder) Register(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockRegistrationHandler)(nil).Register), ctx, params)
+}
+
+// ResendVerification mocks base method.
+func (m *MockRegistrationHandler) ResendVerification(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendVerification", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendVerification indicates an expected call of ResendVerification.
+func (mr *MockRegistrationHandlerMockRecorder) ResendVerification(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendVerification", reflect.TypeOf((*MockRegistrationHandler)(nil).ResendVerification), ctx, email)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockRegistrationHandler) VerifyEmail(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockRegistrationHandlerMockRecorder) VerifyEmail(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockRegistrationHandler)(nil).VerifyEmail), ctx, token)
+}
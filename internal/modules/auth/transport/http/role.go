@@ -0,0 +1,96 @@
+//go:generate mockgen -source=role.go -destination=role_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+type RoleHandler interface {
+	AssignRole(ctx context.Context, params model.AssignRoleRequest) (*model.User, error)
+}
+
+// AdminRoleHttpHandler manages the roles of the users, its routes must be behind the admin auth middleware.
+type AdminRoleHttpHandler struct {
+	roleService RoleHandler
+}
+
+func NewAdminRoleHandler(roleService RoleHandler) handler.HttpHandler {
+	return &AdminRoleHttpHandler{roleService: roleService}
+}
+
+func (h *AdminRoleHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.PUT("/users/:user_id/role", h.AssignRole)
+}
+
+func (h *AdminRoleHttpHandler) AssignRole(c *gin.Context) {
+	var request model.AssignRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	request.UserID, err = strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
+
+	user, err := h.roleService.AssignRole(c.Request.Context(), request)
+	if errors.Is(err, model.ErrUnknownRole) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrCannotChangeOwnRole) {
+		c.JSON(http.StatusForbidden, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "role assigned",
+		Data: model.AssignRoleResponse{
+			UserID:      user.ID,
+			Role:        user.Role,
+			Permissions: user.Role.Permissions(),
+		},
+	})
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: role.go
+//
+// Generated by this command:
+//
+//	mockgen -source=role.go -destination=role_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoleHandler is a mock of RoleHandler interface.
+type MockRoleHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleHandlerMockRecorder
+}
+
+// MockRoleHandlerMockRecorder is the mock recorder for MockRoleHandler.
+type MockRoleHandlerMockRecorder struct {
+	mock *MockRoleHandler
+}
+
+// NewMockRoleHandler creates a new mock instance.
+func NewMockRoleHandler(ctrl *gomock.Controller) *MockRoleHandler {
+	mock := &MockRoleHandler{ctrl: ctrl}
+	mock.recorder = &MockRoleHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleHandler) EXPECT() *MockRoleHandlerMockRecorder {
+	return m.recorder
+}
+
+// AssignRole mocks base method.
+func (m *MockRoleHandler) AssignRole(ctx context.Context, params model.AssignRoleRequest) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRole", ctx, params)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignRole indicates an expected call of AssignRole.
+func (mr *MockRoleHandlerMockRecorder) AssignRole(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockRoleHandler)(nil).AssignRole), ctx, params)
+}
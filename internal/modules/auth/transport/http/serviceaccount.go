@@ -0,0 +1,260 @@
+//go:generate mockgen -source=serviceaccount.go -destination=serviceaccount_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+type ServiceAccountHandler interface {
+	CreateServiceAccount(ctx context.Context, params model.CreateServiceAccountRequest) (*model.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context) ([]model.ServiceAccount, error)
+	ListAPIKeys(ctx context.Context, serviceAccountID int) ([]model.APIKey, error)
+	CreateAPIKey(ctx context.Context, params model.CreateAPIKeyRequest) (*model.CreatedAPIKey, error)
+	RotateAPIKey(ctx context.Context, params model.APIKeyRequest) (*model.CreatedAPIKey, error)
+	RevokeAPIKey(ctx context.Context, params model.APIKeyRequest) error
+}
+
+// AdminServiceAccountHttpHandler manages the service accounts and their API keys, its routes must be behind the
+// admin auth middleware.
+type AdminServiceAccountHttpHandler struct {
+	serviceAccountService ServiceAccountHandler
+}
+
+func NewAdminServiceAccountHandler(serviceAccountService ServiceAccountHandler) handler.HttpHandler {
+	return &AdminServiceAccountHttpHandler{serviceAccountService: serviceAccountService}
+}
+
+func (h *AdminServiceAccountHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/service-accounts", h.CreateServiceAccount)
+	router.GET("/service-accounts", h.ListServiceAccounts)
+	router.GET("/service-accounts/:service_account_id/keys", h.ListAPIKeys)
+	router.POST("/service-accounts/:service_account_id/keys", h.CreateAPIKey)
+	router.POST("/service-accounts/:service_account_id/keys/:key_id/rotate", h.RotateAPIKey)
+	router.DELETE("/service-accounts/:service_account_id/keys/:key_id", h.RevokeAPIKey)
+}
+
+func (h *AdminServiceAccountHttpHandler) CreateServiceAccount(c *gin.Context) {
+	var request model.CreateServiceAccountRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
+
+	account, err := h.serviceAccountService.CreateServiceAccount(c.Request.Context(), request)
+	if errors.Is(err, model.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, commonmodel.Response{
+		Success: true,
+		Message: "service account created",
+		Data:    account,
+	})
+}
+
+func (h *AdminServiceAccountHttpHandler) ListServiceAccounts(c *gin.Context) {
+	accounts, err := h.serviceAccountService.ListServiceAccounts(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "service accounts",
+		Data:    accounts,
+	})
+}
+
+func (h *AdminServiceAccountHttpHandler) ListAPIKeys(c *gin.Context) {
+	serviceAccountID, err := strconv.Atoi(c.Param("service_account_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	keys, err := h.serviceAccountService.ListAPIKeys(c.Request.Context(), serviceAccountID)
+	if errors.Is(err, model.ErrServiceAccountNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "api keys",
+		Data:    keys,
+	})
+}
+
+func (h *AdminServiceAccountHttpHandler) CreateAPIKey(c *gin.Context) {
+	var request model.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	request.ServiceAccountID, err = strconv.Atoi(c.Param("service_account_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	key, err := h.serviceAccountService.CreateAPIKey(c.Request.Context(), request)
+	if errors.Is(err, model.ErrInvalidAPIKeyScope) || errors.Is(err, model.ErrInvalidAPIKeyExpiry) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrServiceAccountNotFound) || errors.Is(err, model.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, commonmodel.Response{
+		Success: true,
+		Message: "api key created, it is not shown again",
+		Data:    key,
+	})
+}
+
+func (h *AdminServiceAccountHttpHandler) RotateAPIKey(c *gin.Context) {
+	request, err := apiKeyRequestFromParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	key, err := h.serviceAccountService.RotateAPIKey(c.Request.Context(), request)
+	if errors.Is(err, model.ErrAPIKeyNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, commonmodel.Response{
+		Success: true,
+		Message: "api key rotated, it is not shown again",
+		Data:    key,
+	})
+}
+
+func (h *AdminServiceAccountHttpHandler) RevokeAPIKey(c *gin.Context) {
+	request, err := apiKeyRequestFromParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err = h.serviceAccountService.RevokeAPIKey(c.Request.Context(), request)
+	if errors.Is(err, model.ErrAPIKeyNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "api key revoked",
+	})
+}
+
+func apiKeyRequestFromParams(c *gin.Context) (model.APIKeyRequest, error) {
+	serviceAccountID, err := strconv.Atoi(c.Param("service_account_id"))
+	if err != nil {
+		return model.APIKeyRequest{}, err
+	}
+	keyID, err := strconv.Atoi(c.Param("key_id"))
+	if err != nil {
+		return model.APIKeyRequest{}, err
+	}
+	return model.APIKeyRequest{ServiceAccountID: serviceAccountID, KeyID: keyID}, nil
+}
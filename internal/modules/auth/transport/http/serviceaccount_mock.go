@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: serviceaccount.go
+//
+// Generated by this command:
+//
+//	mockgen -source=serviceaccount.go -destination=serviceaccount_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockServiceAccountHandler is a mock of ServiceAccountHandler interface.
+type MockServiceAccountHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceAccountHandlerMockRecorder
+}
+
+// MockServiceAccountHandlerMockRecorder is the mock recorder for MockServiceAccountHandler.
+type MockServiceAccountHandlerMockRecorder struct {
+	mock *MockServiceAccountHandler
+}
+
+// NewMockServiceAccountHandler creates a new mock instance.
+func NewMockServiceAccountHandler(ctrl *gomock.Controller) *MockServiceAccountHandler {
+	mock := &MockServiceAccountHandler{ctrl: ctrl}
+	mock.recorder = &MockServiceAccountHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceAccountHandler) EXPECT() *MockServiceAccountHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockServiceAccountHandler) CreateAPIKey(ctx context.Context, params model.CreateAPIKeyRequest) (*model.CreatedAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, params)
+	ret0, _ := ret[0].(*model.CreatedAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockServiceAccountHandlerMockRecorder) CreateAPIKey(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockServiceAccountHandler)(nil).CreateAPIKey), ctx, params)
+}
+
+// CreateServiceAccount mocks base method.
+func (m *MockServiceAccountHandler) CreateServiceAccount(ctx context.Context, params model.CreateServiceAccountRequest) (*model.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceAccount", ctx, params)
+	ret0, _ := ret[0].(*model.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceAccount indicates an expected call of CreateServiceAccount.
+func (mr *MockServiceAccountHandlerMockRecorder) CreateServiceAccount(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceAccount", reflect.TypeOf((*MockServiceAccountHandler)(nil).CreateServiceAccount), ctx, params)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockServiceAccountHandler) ListAPIKeys(ctx context.Context, serviceAccountID int) ([]model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, serviceAccountID)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockServiceAccountHandlerMockRecorder) ListAPIKeys(ctx, serviceAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockServiceAccountHandler)(nil).ListAPIKeys), ctx, serviceAccountID)
+}
+
+// ListServiceAccounts mocks base method.
+func (m *MockServiceAccountHandler) ListServiceAccounts(ctx context.Context) ([]model.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceAccounts", ctx)
+	ret0, _ := ret[0].([]model.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceAccounts indicates an expected call of ListServiceAccounts.
+func (mr *MockServiceAccountHandlerMockRecorder) ListServiceAccounts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceAccounts", reflect.TypeOf((*MockServiceAccountHandler)(nil).ListServiceAccounts), ctx)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockServiceAccountHandler) RevokeAPIKey(ctx context.Context, params model.APIKeyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockServiceAccountHandlerMockRecorder) RevokeAPIKey(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockServiceAccountHandler)(nil).RevokeAPIKey), ctx, params)
+}
+
+// RotateAPIKey mocks base method.
+func (m *MockServiceAccountHandler) RotateAPIKey(ctx context.Context, params model.APIKeyRequest) (*model.CreatedAPIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAPIKey", ctx, params)
+	ret0, _ := ret[0].(*model.CreatedAPIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateAPIKey indicates an expected call of RotateAPIKey.
+func (mr *MockServiceAccountHandlerMockRecorder) RotateAPIKey(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAPIKey", reflect.TypeOf((*MockServiceAccountHandler)(nil).RotateAPIKey), ctx, params)
+}
@@ -0,0 +1,74 @@
+//go:generate mockgen -source=session.go -destination=session_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/middleware"
+	"booking-event/internal/modules/auth/model"
+)
+
+type SessionHandler interface {
+	Logout(ctx context.Context, accessToken string, refreshToken string) error
+	LogoutAll(ctx context.Context, accessToken string) error
+}
+
+// SessionHttpHandler ends sessions, its routes must be behind the auth middleware.
+type SessionHttpHandler struct {
+	authService SessionHandler
+}
+
+func NewSessionHandler(authService SessionHandler) handler.HttpHandler {
+	return &SessionHttpHandler{authService: authService}
+}
+
+func (h *SessionHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/logout", h.Logout)
+	router.POST("/logout-all", h.LogoutAll)
+}
+
+func (h *SessionHttpHandler) Logout(c *gin.Context) {
+	var request model.LogoutRequest
+	if err := c.ShouldBindJSON(&request); err != nil && c.Request.ContentLength > 0 {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	accessToken, _ := middleware.ExtractTokenFromBearer(c.GetHeader("Authorization"))
+	if err := h.authService.Logout(c.Request.Context(), accessToken, request.RefreshToken); err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "logout success",
+	})
+}
+
+func (h *SessionHttpHandler) LogoutAll(c *gin.Context) {
+	accessToken, _ := middleware.ExtractTokenFromBearer(c.GetHeader("Authorization"))
+	if err := h.authService.LogoutAll(c.Request.Context(), accessToken); err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "all sessions logged out",
+	})
+}
@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: session.go
+//
+// Generated by this command:
+//
+//	mockgen -source=session.go -destination=session_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSessionHandler is a mock of SessionHandler interface.
+type MockSessionHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionHandlerMockRecorder
+}
+
+// MockSessionHandlerMockRecorder is the mock recorder for MockSessionHandler.
+type MockSessionHandlerMockRecorder struct {
+	mock *MockSessionHandler
+}
+
+// NewMockSessionHandler creates a new mock instance.
+func NewMockSessionHandler(ctrl *gomock.Controller) *MockSessionHandler {
+	mock := &MockSessionHandler{ctrl: ctrl}
+	mock.recorder = &MockSessionHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionHandler) EXPECT() *MockSessionHandlerMockRecorder {
+	return m.recorder
+}
+
+// Logout mocks base method.
+func (m *MockSessionHandler) Logout(ctx context.Context, accessToken, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, accessToken, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockSessionHandlerMockRecorder) Logout(ctx, accessToken, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockSessionHandler)(nil).Logout), ctx, accessToken, refreshToken)
+}
+
+// LogoutAll mocks base method.
+func (m *MockSessionHandler) LogoutAll(ctx context.Context, accessToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutAll", ctx, accessToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogoutAll indicates an expected call of LogoutAll.
+func (mr *MockSessionHandlerMockRecorder) LogoutAll(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutAll", reflect.TypeOf((*MockSessionHandler)(nil).LogoutAll), ctx, accessToken)
+}
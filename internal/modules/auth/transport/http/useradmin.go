@@ -0,0 +1,161 @@
+//go:generate mockgen -source=useradmin.go -destination=useradmin_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/auth/model"
+)
+
+type AdminUserHandler interface {
+	ListUsers(ctx context.Context, query model.ListUsersQuery) (*commonmodel.Page[model.User], error)
+	SuspendUser(ctx context.Context, params model.SuspendUserRequest) (*model.User, error)
+	ReactivateUser(ctx context.Context, userID int) (*model.User, error)
+}
+
+// AdminUserHttpHandler lists and suspends the users, its routes must be behind the admin auth middleware.
+type AdminUserHttpHandler struct {
+	adminUserService AdminUserHandler
+}
+
+func NewAdminUserHandler(adminUserService AdminUserHandler) handler.HttpHandler {
+	return &AdminUserHttpHandler{adminUserService: adminUserService}
+}
+
+func (h *AdminUserHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/users", h.ListUsers)
+	router.POST("/users/:user_id/suspend", h.SuspendUser)
+	router.POST("/users/:user_id/reactivate", h.ReactivateUser)
+}
+
+func (h *AdminUserHttpHandler) ListUsers(c *gin.Context) {
+	var query model.ListUsersQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	page, err := h.adminUserService.ListUsers(c.Request.Context(), query)
+	if errors.Is(err, commonmodel.ErrInvalidCursor) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	profiles := make([]model.UserProfile, 0, len(page.Items))
+	for i := range page.Items {
+		profiles = append(profiles, model.NewUserProfile(&page.Items[i]))
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success:    true,
+		Data:       profiles,
+		Message:    "users retrieved",
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	})
+}
+
+func (h *AdminUserHttpHandler) SuspendUser(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	user, err := h.adminUserService.SuspendUser(c.Request.Context(), model.SuspendUserRequest{
+		UserID:     userID,
+		ExecutorID: util.GetUserIDContext(c.Request.Context()),
+	})
+	if errors.Is(err, model.ErrCannotSuspendSelf) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "user suspended, every session was logged out",
+		Data:    model.NewUserProfile(user),
+	})
+}
+
+func (h *AdminUserHttpHandler) ReactivateUser(c *gin.Context) {
+	userID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	user, err := h.adminUserService.ReactivateUser(c.Request.Context(), userID)
+	if errors.Is(err, model.ErrUserNotSuspended) {
+		c.JSON(http.StatusConflict, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if errors.Is(err, model.ErrUserNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	} else if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "user reactivated",
+		Data:    model.NewUserProfile(user),
+	})
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: useradmin.go
+//
+// Generated by this command:
+//
+//	mockgen -source=useradmin.go -destination=useradmin_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/common/model"
+	model0 "booking-event/internal/modules/auth/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminUserHandler is a mock of AdminUserHandler interface.
+type MockAdminUserHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminUserHandlerMockRecorder
+}
+
+// MockAdminUserHandlerMockRecorder is the mock recorder for MockAdminUserHandler.
+type MockAdminUserHandlerMockRecorder struct {
+	mock *MockAdminUserHandler
+}
+
+// NewMockAdminUserHandler creates a new mock instance.
+func NewMockAdminUserHandler(ctrl *gomock.Controller) *MockAdminUserHandler {
+	mock := &MockAdminUserHandler{ctrl: ctrl}
+	mock.recorder = &MockAdminUserHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminUserHandler) EXPECT() *MockAdminUserHandlerMockRecorder {
+	return m.recorder
+}
+
+// ListUsers mocks base method.
+func (m *MockAdminUserHandler) ListUsers(ctx context.Context, query model0.ListUsersQuery) (*model.Page[model0.User], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, query)
+	ret0, _ := ret[0].(*model.Page[model0.User])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockAdminUserHandlerMockRecorder) ListUsers(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockAdminUserHandler)(nil).ListUsers), ctx, query)
+}
+
+// ReactivateUser mocks base method.
+func (m *MockAdminUserHandler) ReactivateUser(ctx context.Context, userID int) (*model0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReactivateUser", ctx, userID)
+	ret0, _ := ret[0].(*model0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReactivateUser indicates an expected call of ReactivateUser.
+func (mr *MockAdminUserHandlerMockRecorder) ReactivateUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivateUser", reflect.TypeOf((*MockAdminUserHandler)(nil).ReactivateUser), ctx, userID)
+}
+
+// SuspendUser mocks base method.
+func (m *MockAdminUserHandler) SuspendUser(ctx context.Context, params model0.SuspendUserRequest) (*model0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", ctx, params)
+	ret0, _ := ret[0].(*model0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockAdminUserHandlerMockRecorder) SuspendUser(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockAdminUserHandler)(nil).SuspendUser), ctx, params)
+}
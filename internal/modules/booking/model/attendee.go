@@ -0,0 +1,48 @@
+package model
+
+import (
+	commonmodel "booking-event/internal/common/model"
+	"time"
+)
+
+type AttendeeExportFormat string
+
+const (
+	AttendeeExportFormatCSV  AttendeeExportFormat = "csv"
+	AttendeeExportFormatJSON AttendeeExportFormat = "json"
+)
+
+// Attendee is the holder of a used ticket of an event.
+type Attendee struct {
+	TicketID    int        `json:"ticket_id"`
+	Token       string     `json:"token"`
+	BookingID   int        `json:"booking_id"`
+	UserID      int        `json:"user_id"`
+	Email       string     `json:"email"`
+	BookedAt    time.Time  `json:"booked_at"`
+	CheckedInAt *time.Time `json:"checked_in_at"`
+}
+
+// AttendeeWriter receives a streamed attendee export. Begin is called once before
+// the first attendee with the cursor of the next page, empty on the last page.
+type AttendeeWriter interface {
+	Begin(nextCursor string) error
+	Write(attendee Attendee) error
+	End() error
+}
+
+type AttendeeQuery struct {
+	EventID         int
+	CheckedIn       *bool                  `form:"checked_in"`
+	Format          AttendeeExportFormat   `form:"format" binding:"omitempty,oneof=csv json"`
+	Pagination      commonmodel.Pagination `form:"pagination"`
+	ExecutorID      int
+	ExecutorIsAdmin bool
+}
+
+type CheckInRequest struct {
+	EventID         int
+	Token           string `json:"token" binding:"required"`
+	ExecutorID      int
+	ExecutorIsAdmin bool
+}
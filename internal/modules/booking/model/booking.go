@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	commonmodel "booking-event/internal/common/model"
+)
 
 type BookingStatus string
 
@@ -9,6 +13,7 @@ const (
 	BookingStatusConfirmed BookingStatus = "confirmed"
 	BookingStatusPaid      BookingStatus = "paid"
 	BookingStatusCanceled  BookingStatus = "canceled"
+	BookingStatusRefunded  BookingStatus = "refunded"
 )
 
 type Booking struct {
@@ -18,6 +23,7 @@ type Booking struct {
 	Status          BookingStatus `json:"status"`
 	InitialQuantity int           `json:"initial_quantity"`
 	Quantity        int           `json:"quantity"`
+	UnitPrice       int64         `json:"unit_price"` // price of a ticket when the booking was made
 	CreatedAt       time.Time     `json:"created_at"`
 	UpdatedAt       time.Time     `json:"updated_at"`
 }
@@ -46,3 +52,10 @@ type CancelBookingRequest struct {
 type GetBookingByIDRequest struct {
 	BookingID int `uri:"booking_id" binding:"required"`
 }
+
+type BookingQuery struct {
+	UserID     int
+	EventID    int                    `form:"event_id"`
+	Status     BookingStatus          `form:"status" binding:"omitempty,oneof=pending confirmed paid canceled refunded"`
+	Pagination commonmodel.Pagination `form:"pagination"`
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// CalendarProdID identifies the product writing the invites and the feeds.
+const CalendarProdID = "-//Booking Event//Bookings//EN"
+
+// CalendarFeed is the private feed of the bookings of a user, only the hash of its token is stored.
+type CalendarFeed struct {
+	UserID    int
+	TokenHash string
+	CreatedAt time.Time
+}
+
+// CalendarFeedResponse is only returned when the feed is created, the token of the URL is not shown again.
+type CalendarFeedResponse struct {
+	URL string `json:"url"`
+}
+
+// CalendarEntry is a booking listed in the calendar feed.
+type CalendarEntry struct {
+	Booking Booking
+	Event   Event
+}
+
+// BookingCalendarUID identifies the calendar entry of a booking, the same in the emails and the feed so the apps
+// update a single entry.
+func BookingCalendarUID(bookingID int) string {
+	return fmt.Sprintf("booking-%d@booking-event", bookingID)
+}
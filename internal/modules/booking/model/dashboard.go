@@ -0,0 +1,51 @@
+package model
+
+import (
+	commonmodel "booking-event/internal/common/model"
+	"time"
+)
+
+type SalesGranularity string
+
+const (
+	SalesGranularityHour SalesGranularity = "hour"
+	SalesGranularityDay  SalesGranularity = "day"
+)
+
+// EventSalesStats are the sales metrics of an event, they are aggregated by the worker
+// and can lag behind the bookings by up to the refresh interval.
+type EventSalesStats struct {
+	EventID           int        `json:"event_id"`
+	EventName         string     `json:"event_name"`
+	StartAt           time.Time  `json:"start_at"`
+	TicketsSold       int        `json:"tickets_sold"`
+	TicketsLocked     int        `json:"tickets_locked"`
+	TicketsCancelled  int        `json:"tickets_cancelled"`
+	TicketsRefunded   int        `json:"tickets_refunded"` // always 0 until bookings can be refunded
+	BookingsTotal     int        `json:"bookings_total"`
+	BookingsConfirmed int        `json:"bookings_confirmed"`
+	ConversionRate    float64    `json:"conversion_rate"` // share of bookings whose lock was confirmed
+	GrossRevenue      int64      `json:"gross_revenue"`   // at the prices the tickets were booked at
+	NetRevenue        int64      `json:"net_revenue"`     // gross revenue minus refunds
+	Currency          string     `json:"currency"`
+	RefreshedAt       *time.Time `json:"refreshed_at"`
+}
+
+type SalesDataPoint struct {
+	Bucket       time.Time `json:"bucket"`
+	TicketsSold  int       `json:"tickets_sold"`
+	GrossRevenue int64     `json:"gross_revenue"`
+}
+
+type SalesStatsQuery struct {
+	CreatorID  int
+	Pagination commonmodel.Pagination `form:"pagination"`
+}
+
+type SalesTimeSeriesQuery struct {
+	EventID     int
+	Granularity SalesGranularity `form:"granularity" binding:"omitempty,oneof=hour day"`
+	From        time.Time        `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To          time.Time        `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	ExecutorID  int
+}
@@ -0,0 +1,25 @@
+package model
+
+import "errors"
+
+var (
+	ErrUnknownCategory      = errors.New("unknown category")
+	ErrUnsupportedImageType = errors.New("unsupported image type")
+	ErrImageTooLarge        = errors.New("image is too large")
+
+	ErrInvalidReviewTransition = errors.New("invalid review status transition")
+	ErrEventNotApproved        = errors.New("event must be approved before going on sale")
+	ErrRejectReasonRequired    = errors.New("a reason is required to reject an event")
+
+	ErrAlreadyCheckedIn = errors.New("ticket is already checked in")
+	ErrTicketNotValid   = errors.New("ticket is not valid for the event")
+
+	ErrUserNotVerified = errors.New("email address must be verified before booking")
+
+	ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+	ErrInsecureWebhookURL          = errors.New("webhook url must use https")
+	ErrUnreachableWebhookURL       = errors.New("webhook url must resolve to a public address")
+	ErrWebhookSubscriptionDisabled = errors.New("webhook subscription is disabled")
+	ErrWebhookDeliveryPending      = errors.New("webhook delivery is still pending")
+)
@@ -2,15 +2,13 @@ package model
 
 import (
 	"booking-event/internal/common/model"
+	"io"
 	"time"
 )
 
+// EventCategory is the slug of a category managed by admins.
 type EventCategory string
 
-const (
-	EventCategoryMusic EventCategory = "music"
-)
-
 type EventStatus string
 
 const (
@@ -19,28 +17,50 @@ const (
 )
 
 type Event struct {
-	ID             int           `json:"id"`
-	Name           string        `json:"name"`
-	AvailableSeats int           `json:"available_seats"`
-	StartAt        time.Time     `json:"start_at"`
-	Location       string        `json:"location"`
-	Category       EventCategory `json:"category"`
-	Price          int64         `json:"price"`
-	Currency       string        `json:"currency"`
-	Status         EventStatus   `json:"status"`
-	CreatorID      int           `json:"creator_id"`
-	CreatedAt      time.Time     `json:"created_at"`
-	UpdatedAt      time.Time     `json:"updated_at"`
+	ID             int               `json:"id"`
+	Name           string            `json:"name"`
+	AvailableSeats int               `json:"available_seats"`
+	StartAt        time.Time         `json:"start_at"`
+	Location       string            `json:"location"`
+	Categories     []EventCategory   `json:"categories"`
+	Description    string            `json:"description"` // markdown
+	Tags           []string          `json:"tags"`
+	MinAge         int               `json:"min_age"`
+	Images         []EventImage      `json:"images,omitempty"` // only loaded with the event detail
+	Price          int64             `json:"price"`
+	Currency       string            `json:"currency"`
+	Status         EventStatus       `json:"status"`
+	ReviewStatus   EventReviewStatus `json:"review_status"`
+	CreatorID      int               `json:"creator_id"`
+	Latitude       *float64          `json:"latitude,omitempty"`
+	Longitude      *float64          `json:"longitude,omitempty"`
+	DistanceKm     *float64          `json:"distance_km,omitempty"` // only set by geo search
+	Sequence       int               `json:"sequence"`              // bumped by the changes sent to the attendees
+	// ReminderMinutes are the minutes before the start the reminders are sent, nil follows the global schedule and
+	// an empty list sends none.
+	ReminderMinutes []int     `json:"reminder_minutes"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
 }
 
 type EventQuery struct {
-	ID         int              `json:"id"`
-	Category   EventCategory    `json:"category"`
-	Location   string           `json:"location"`
-	StartFrom  time.Time        `json:"start_from"`
-	StartTo    time.Time        `json:"start_to"`
-	Name       string           `json:"name"`
-	Pagination model.Pagination `json:"pagination" binding:"required"`
+	ID           int               `json:"id"`
+	Category     EventCategory     `json:"category"`
+	Location     string            `json:"location"`
+	StartFrom    time.Time         `json:"start_from"`
+	StartTo      time.Time         `json:"start_to"`
+	Name         string            `json:"name"`
+	Tags         []string          `json:"tags"` // events having any of the tags
+	ReviewStatus EventReviewStatus `json:"-"`
+	Latitude     *float64          `json:"latitude" binding:"required_with=Longitude RadiusKm,omitempty,latitude"`
+	Longitude    *float64          `json:"longitude" binding:"required_with=Latitude RadiusKm,omitempty,longitude"`
+	RadiusKm     float64           `json:"radius_km" binding:"required_with=Latitude Longitude,omitempty,gt=0,lte=1000"`
+	Pagination   model.Pagination  `json:"pagination" binding:"required"`
+}
+
+// HasGeo reports whether the query filters events around a location.
+func (q EventQuery) HasGeo() bool {
+	return q.Latitude != nil && q.Longitude != nil && q.RadiusKm > 0
 }
 
 type RetrieveEventDetailRequest struct {
@@ -48,17 +68,71 @@ type RetrieveEventDetailRequest struct {
 }
 
 type CreateEventRequest struct {
-	Name           string        `json:"name" binding:"required"`
-	AvailableSeats int           `json:"available_seats" binding:"required"`
-	StartAt        time.Time     `json:"start_at" binding:"required"`
-	Location       string        `json:"location" binding:"required"`
-	Category       EventCategory `json:"category" binding:"required"`
-	Price          float64       `json:"price" binding:"required"`
-	ExecutorID     int
+	Name           string          `json:"name" binding:"required"`
+	AvailableSeats int             `json:"available_seats" binding:"required"`
+	StartAt        time.Time       `json:"start_at" binding:"required"`
+	Location       string          `json:"location" binding:"required"`
+	Categories     []EventCategory `json:"categories" binding:"required,min=1,dive,required"`
+	Description    string          `json:"description" binding:"max=20000"`
+	Tags           []string        `json:"tags" binding:"max=20,dive,required,max=50"`
+	MinAge         int             `json:"min_age" binding:"gte=0,lte=100"`
+	Price          float64         `json:"price" binding:"required"`
+	Latitude       *float64        `json:"latitude" binding:"required_with=Longitude,omitempty,latitude"`
+	Longitude      *float64        `json:"longitude" binding:"required_with=Latitude,omitempty,longitude"`
+	// ReminderMinutes replaces the global reminder schedule when set, an empty list disables the reminders.
+	ReminderMinutes []int `json:"reminder_minutes" binding:"max=5,dive,min=1,max=43200"`
+	ExecutorID      int
 }
 
+// UpdateEventRequest changes the fields set, moving StartAt reschedules the reminders of the bookings.
 type UpdateEventRequest struct {
-	EventID    int
-	Status     EventStatus `json:"status" binding:"required,oneof=active inactive"`
+	EventID         int
+	Status          EventStatus `json:"status" binding:"omitempty,oneof=active inactive"`
+	StartAt         *time.Time  `json:"start_at,omitempty"`
+	ReminderMinutes *[]int      `json:"reminder_minutes,omitempty" binding:"omitempty,max=5,dive,min=1,max=43200"`
+	// ResetReminderMinutes puts the event back on the global reminder schedule, reminder_minutes can not tell it
+	// from leaving the schedule unchanged.
+	ResetReminderMinutes bool `json:"reset_reminder_minutes,omitempty" binding:"excluded_with=ReminderMinutes"`
+	ExecutorID           int
+}
+
+type EventImage struct {
+	ID          int       `json:"id"`
+	EventID     int       `json:"event_id"`
+	StorageKey  string    `json:"-"`
+	URL         string    `json:"url"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type UploadEventImageRequest struct {
+	EventID     int
+	ExecutorID  int
+	FileName    string
+	ContentType string
+	Size        int64
+	Content     io.Reader
+}
+
+type DeleteEventImageRequest struct {
+	EventID    int `uri:"event_id" binding:"required"`
+	ImageID    int `uri:"image_id" binding:"required"`
 	ExecutorID int
 }
+
+type Category struct {
+	ID          int           `json:"id"`
+	Slug        EventCategory `json:"slug"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+type UpsertCategoryRequest struct {
+	ID          int
+	Slug        EventCategory `json:"slug" binding:"required,max=100"`
+	Name        string        `json:"name" binding:"required,max=255"`
+	Description string        `json:"description"`
+}
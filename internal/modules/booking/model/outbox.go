@@ -0,0 +1,36 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxMessage is a task written in the transaction of the state change it announces, the worker publishes it
+// to the queue afterwards. DedupKey identifies the task so it is only published once.
+type OutboxMessage struct {
+	ID            int64
+	TaskType      TaskType
+	Payload       []byte
+	DedupKey      string
+	ProcessAt     *time.Time
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	PublishedAt   *time.Time
+	FailedAt      *time.Time
+	CreatedAt     time.Time
+}
+
+// NewOutboxMessage encodes the task of a message, processAt delays the task when set.
+func NewOutboxMessage(taskType TaskType, dedupKey string, task any, processAt *time.Time) (*OutboxMessage, error) {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxMessage{
+		TaskType:  taskType,
+		Payload:   payload,
+		DedupKey:  dedupKey,
+		ProcessAt: processAt,
+	}, nil
+}
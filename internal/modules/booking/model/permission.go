@@ -0,0 +1,14 @@
+package model
+
+// The permissions granted by the roles of the auth module, as carried by the access tokens.
+const (
+	PermissionEventCreate    = "event:create"
+	PermissionEventManageOwn = "event:manage:own"
+	PermissionBookingRefund  = "booking:refund"
+	PermissionCheckinScan    = "checkin:scan"
+	PermissionWebhookManage  = "webhook:manage"
+	// held by every role, only the API keys can lack them
+	PermissionEventRead         = "event:read"
+	PermissionBookingManageOwn  = "booking:manage:own"
+	PermissionCalendarManageOwn = "calendar:manage:own"
+)
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// BookingReminder is a reminder email scheduled for a booking, tracked by the id of its task so it is deleted from
+// the queue when the booking is canceled or its event changes.
+type BookingReminder struct {
+	TaskID     string
+	BookingID  int
+	RemindAt   time.Time
+	CanceledAt *time.Time
+}
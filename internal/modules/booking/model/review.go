@@ -0,0 +1,54 @@
+package model
+
+import (
+	commonmodel "booking-event/internal/common/model"
+	"time"
+)
+
+// EventReviewStatus is the moderation state of an event, it is independent of
+// the sale status but an event can only go on sale once approved.
+type EventReviewStatus string
+
+const (
+	EventReviewStatusDraft         EventReviewStatus = "draft"
+	EventReviewStatusPendingReview EventReviewStatus = "pending_review"
+	EventReviewStatusApproved      EventReviewStatus = "approved"
+	EventReviewStatusRejected      EventReviewStatus = "rejected"
+)
+
+var eventReviewTransitions = map[EventReviewStatus][]EventReviewStatus{
+	EventReviewStatusDraft:         {EventReviewStatusPendingReview},
+	EventReviewStatusPendingReview: {EventReviewStatusApproved, EventReviewStatusRejected},
+	EventReviewStatusRejected:      {EventReviewStatusPendingReview},
+}
+
+func (s EventReviewStatus) CanTransitionTo(to EventReviewStatus) bool {
+	for _, status := range eventReviewTransitions[s] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// EventReview is one entry of the review history of an event.
+type EventReview struct {
+	ID         int               `json:"id"`
+	EventID    int               `json:"event_id"`
+	FromStatus EventReviewStatus `json:"from_status"`
+	ToStatus   EventReviewStatus `json:"to_status"`
+	ActorID    int               `json:"actor_id"`
+	Reason     string            `json:"reason,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+type ReviewEventRequest struct {
+	EventID    int
+	Reason     string `json:"reason" binding:"max=2000"`
+	ExecutorID int
+}
+
+type EventReviewQuery struct {
+	ReviewStatus EventReviewStatus      `form:"review_status" binding:"omitempty,oneof=draft pending_review approved rejected"`
+	Pagination   commonmodel.Pagination `form:"pagination"`
+}
@@ -5,13 +5,16 @@ type TaskType string
 const (
 	TaskTypeSendReminderEmail     TaskType = "send_reminder_email"
 	TaskTypeSendConfirmationEmail TaskType = "send_confirmation_email"
+	TaskTypeSendCancellationEmail TaskType = "send_cancellation_email"
+	TaskTypeSendEventReviewEmail  TaskType = "send_event_review_email"
+	TaskTypeSendEventChangeEmail  TaskType = "send_event_change_email"
+	TaskTypeSyncReminders         TaskType = "sync_reminders"
+	TaskTypeDispatchWebhookEvent  TaskType = "dispatch_webhook_event"
+	TaskTypeDeliverWebhook        TaskType = "deliver_webhook"
+	TaskTypeRefreshSalesStats     TaskType = "refresh_sales_stats"
+	TaskTypeRelayOutbox           TaskType = "relay_outbox"
 )
 
-type User struct {
-	ID    int
-	Email string
-}
-
 type SendReminderEmailTask struct {
 	User    User    `json:"user"`
 	Event   Event   `json:"event"`
@@ -19,7 +22,47 @@ type SendReminderEmailTask struct {
 }
 
 type SendConfirmationEmailTask struct {
+	User    User          `json:"user"`
+	Event   Event         `json:"event"`
+	Booking Booking       `json:"booking"`
+	Items   []BookingItem `json:"items"`
+}
+
+type SendCancellationEmailTask struct {
 	User    User    `json:"user"`
 	Event   Event   `json:"event"`
 	Booking Booking `json:"booking"`
 }
+
+type SendEventReviewEmailTask struct {
+	User   User        `json:"user"`
+	Event  Event       `json:"event"`
+	Review EventReview `json:"review"`
+}
+
+// SendEventChangeEmailTask announces a change of the event to the attendee of the booking, the booking and the
+// event are loaded when sent.
+type SendEventChangeEmailTask struct {
+	BookingID int `json:"booking_id"`
+	Sequence  int `json:"sequence"`
+}
+
+// SyncRemindersTask brings the reminders scheduled for the booking in line with its status and the start of its
+// event, Sequence is the sequence of the event when the task was written.
+type SyncRemindersTask struct {
+	BookingID int `json:"booking_id"`
+	Sequence  int `json:"sequence"`
+}
+
+// DispatchWebhookEventTask creates a delivery of the webhook event for each subscription of the organizer of the
+// event taking its type.
+type DispatchWebhookEventTask struct {
+	EventID int          `json:"event_id"`
+	Event   WebhookEvent `json:"event"`
+}
+
+// DeliverWebhookTask is an attempt of a delivery, Attempt numbers it so a task published twice is only sent once.
+type DeliverWebhookTask struct {
+	DeliveryID int64 `json:"delivery_id"`
+	Attempt    int   `json:"attempt"`
+}
@@ -0,0 +1,99 @@
+package model
+
+import "time"
+
+// EventTemplateContent is the reusable configuration of an event, everything except its schedule and state.
+type EventTemplateContent struct {
+	Name            string          `json:"name"`
+	AvailableSeats  int             `json:"available_seats"`
+	Location        string          `json:"location"`
+	Categories      []EventCategory `json:"categories"`
+	Description     string          `json:"description"`
+	Tags            []string        `json:"tags"`
+	MinAge          int             `json:"min_age"`
+	Price           int64           `json:"price"`
+	Currency        string          `json:"currency"`
+	Latitude        *float64        `json:"latitude,omitempty"`
+	Longitude       *float64        `json:"longitude,omitempty"`
+	ReminderMinutes []int           `json:"reminder_minutes"`
+}
+
+// TemplateContent extracts the reusable configuration of the event.
+func (e Event) TemplateContent() EventTemplateContent {
+	return EventTemplateContent{
+		Name:            e.Name,
+		AvailableSeats:  e.AvailableSeats,
+		Location:        e.Location,
+		Categories:      append([]EventCategory{}, e.Categories...),
+		Description:     e.Description,
+		Tags:            append([]string{}, e.Tags...),
+		MinAge:          e.MinAge,
+		Price:           e.Price,
+		Currency:        e.Currency,
+		Latitude:        e.Latitude,
+		Longitude:       e.Longitude,
+		ReminderMinutes: copyReminderMinutes(e.ReminderMinutes),
+	}
+}
+
+// NewEvent builds a new event of the creator starting at startAt from the content.
+func (c EventTemplateContent) NewEvent(startAt time.Time, creatorID int) *Event {
+	return &Event{
+		Name:            c.Name,
+		AvailableSeats:  c.AvailableSeats,
+		StartAt:         startAt,
+		Location:        c.Location,
+		Categories:      append([]EventCategory{}, c.Categories...),
+		Description:     c.Description,
+		Tags:            append([]string{}, c.Tags...),
+		MinAge:          c.MinAge,
+		Price:           c.Price,
+		Currency:        c.Currency,
+		CreatorID:       creatorID,
+		Latitude:        c.Latitude,
+		Longitude:       c.Longitude,
+		ReminderMinutes: copyReminderMinutes(c.ReminderMinutes),
+	}
+}
+
+// copyReminderMinutes copies the schedule keeping nil, which follows the global schedule, apart from empty.
+func copyReminderMinutes(minutes []int) []int {
+	if minutes == nil {
+		return nil
+	}
+	return append([]int{}, minutes...)
+}
+
+type EventTemplate struct {
+	ID        int                  `json:"id"`
+	CreatorID int                  `json:"creator_id"`
+	Name      string               `json:"name"`
+	Content   EventTemplateContent `json:"content"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+type DuplicateEventRequest struct {
+	EventID    int
+	Name       string    `json:"name" binding:"max=255"` // defaults to the name of the source event
+	StartAt    time.Time `json:"start_at" binding:"required"`
+	ExecutorID int
+}
+
+type SaveEventTemplateRequest struct {
+	EventID    int
+	Name       string `json:"name" binding:"required,max=255"`
+	ExecutorID int
+}
+
+type EventTemplateRequest struct {
+	TemplateID int `uri:"template_id" binding:"required"`
+	ExecutorID int
+}
+
+type CreateEventFromTemplateRequest struct {
+	TemplateID int
+	Name       string    `json:"name" binding:"max=255"` // defaults to the name saved in the template
+	StartAt    time.Time `json:"start_at" binding:"required"`
+	ExecutorID int
+}
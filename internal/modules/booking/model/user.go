@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// UserRoleAdmin is the role of the admins, as issued by the auth module.
+const UserRoleAdmin = "admin"
+
+// UserStatusActive is the status of the users who verified their email address.
+const UserStatusActive = "active"
+
+type User struct {
+	ID        int
+	Email     string
+	Status    string
+	Birthdate *time.Time
+	Locale    string
+	Timezone  string
+}
+
+// AgeAt returns the age in full years the user has at t.
+func (u User) AgeAt(t time.Time) int {
+	if u.Birthdate == nil {
+		return 0
+	}
+	birthdate := *u.Birthdate
+	age := t.Year() - birthdate.Year()
+	if t.Month() < birthdate.Month() || (t.Month() == birthdate.Month() && t.Day() < birthdate.Day()) {
+		age--
+	}
+	return age
+}
@@ -0,0 +1,164 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	commonmodel "booking-event/internal/common/model"
+)
+
+type WebhookEventType string
+
+const (
+	WebhookEventBookingCreated   WebhookEventType = "booking.created"
+	WebhookEventBookingConfirmed WebhookEventType = "booking.confirmed"
+	WebhookEventBookingCancelled WebhookEventType = "booking.cancelled"
+	WebhookEventEventUpdated     WebhookEventType = "event.updated"
+	WebhookEventCheckinScanned   WebhookEventType = "checkin.scanned"
+)
+
+// WebhookEvent is the body posted to the subscriptions, ID is the same in every delivery and replay of the change
+// so the partners can drop the duplicates.
+type WebhookEvent struct {
+	ID        string           `json:"id"`
+	Type      WebhookEventType `json:"type"`
+	CreatedAt time.Time        `json:"created_at"`
+	Data      json.RawMessage  `json:"data"`
+}
+
+// NewWebhookEventMessage builds the message dispatching a change of the event to the subscriptions of its
+// organizer, key identifies the change among those of its type.
+func NewWebhookEventMessage(eventType WebhookEventType, eventID int, key string, data any, createdAt time.Time) (*OutboxMessage, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	webhookEvent := WebhookEvent{
+		ID:        fmt.Sprintf("%s:%s", eventType, key),
+		Type:      eventType,
+		CreatedAt: createdAt,
+		Data:      payload,
+	}
+	return NewOutboxMessage(
+		TaskTypeDispatchWebhookEvent,
+		"webhook:"+webhookEvent.ID,
+		DispatchWebhookEventTask{EventID: eventID, Event: webhookEvent},
+		nil,
+	)
+}
+
+// NewDeliverWebhookMessage builds the message of an attempt of the delivery, processAt delays a retry.
+func NewDeliverWebhookMessage(deliveryID int64, attempt int, processAt *time.Time) (*OutboxMessage, error) {
+	return NewOutboxMessage(
+		TaskTypeDeliverWebhook,
+		fmt.Sprintf("webhook_delivery:%d:%d", deliveryID, attempt),
+		DeliverWebhookTask{DeliveryID: deliveryID, Attempt: attempt},
+		processAt,
+	)
+}
+
+// WebhookSubscription is an endpoint of an organizer, or of one of its API clients when ServiceAccountID is set.
+// The secret signs the deliveries and is only shown when the subscription is created.
+type WebhookSubscription struct {
+	ID                  int                `json:"id"`
+	OwnerID             int                `json:"owner_id"`
+	ServiceAccountID    *int               `json:"service_account_id,omitempty"`
+	URL                 string             `json:"url"`
+	Secret              string             `json:"-"`
+	EventTypes          []WebhookEventType `json:"event_types"`
+	ConsecutiveFailures int                `json:"consecutive_failures"`
+	DisabledAt          *time.Time         `json:"disabled_at"`
+	CreatedAt           time.Time          `json:"created_at"`
+	UpdatedAt           time.Time          `json:"updated_at"`
+}
+
+// CreatedWebhookSubscription is the subscription with its secret, returned only when it is created.
+type CreatedWebhookSubscription struct {
+	WebhookSubscription
+	Secret string `json:"secret"`
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	URL              string             `json:"url" binding:"required,url"`
+	EventTypes       []WebhookEventType `json:"event_types" binding:"required,min=1,dive,oneof=booking.created booking.confirmed booking.cancelled event.updated checkin.scanned"`
+	ServiceAccountID int
+	ExecutorID       int
+}
+
+// UpdateWebhookSubscriptionRequest changes the fields set, enabling a disabled subscription clears its failures.
+type UpdateWebhookSubscriptionRequest struct {
+	SubscriptionID   int
+	URL              *string             `json:"url,omitempty" binding:"omitempty,url"`
+	EventTypes       *[]WebhookEventType `json:"event_types,omitempty" binding:"omitempty,min=1,dive,oneof=booking.created booking.confirmed booking.cancelled event.updated checkin.scanned"`
+	Enabled          *bool               `json:"enabled,omitempty"`
+	ServiceAccountID int
+	ExecutorID       int
+}
+
+type WebhookSubscriptionRequest struct {
+	SubscriptionID   int `uri:"webhook_id" binding:"required"`
+	ServiceAccountID int
+	ExecutorID       int
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is a change sent to a subscription. Attempts counts every attempt, Failures those failed since
+// the delivery was created or replayed.
+type WebhookDelivery struct {
+	ID             int64                 `json:"id"`
+	SubscriptionID int                   `json:"subscription_id"`
+	WebhookEventID string                `json:"webhook_event_id"`
+	EventType      WebhookEventType      `json:"event_type"`
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	Failures       int                   `json:"failures"`
+	NextAttemptAt  *time.Time            `json:"next_attempt_at"`
+	DeliveredAt    *time.Time            `json:"delivered_at"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// WebhookDeliveryAttempt is the log of an attempt, StatusCode is 0 when no response was received.
+type WebhookDeliveryAttempt struct {
+	ID           int64     `json:"id"`
+	DeliveryID   int64     `json:"delivery_id"`
+	Attempt      int       `json:"attempt"`
+	StatusCode   int       `json:"status_code"`
+	Error        string    `json:"error"`
+	ResponseBody string    `json:"response_body"`
+	DurationMs   int       `json:"duration_ms"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// WebhookDeliveryOutcome is an attempt with the state of the delivery after it: Retry is the message of the next
+// attempt when the delivery is still pending.
+type WebhookDeliveryOutcome struct {
+	Attempt       WebhookDeliveryAttempt
+	Status        WebhookDeliveryStatus
+	NextAttemptAt *time.Time
+	Retry         *OutboxMessage
+}
+
+type WebhookDeliveryQuery struct {
+	SubscriptionID   int
+	Status           WebhookDeliveryStatus  `form:"status" binding:"omitempty,oneof=pending succeeded failed"`
+	Pagination       commonmodel.Pagination `form:"pagination"`
+	ServiceAccountID int
+	ExecutorID       int
+}
+
+type WebhookDeliveryRequest struct {
+	SubscriptionID   int   `uri:"webhook_id" binding:"required"`
+	DeliveryID       int64 `uri:"delivery_id" binding:"required"`
+	ServiceAccountID int
+	ExecutorID       int
+}
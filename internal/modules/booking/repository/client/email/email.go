@@ -1,35 +1,158 @@
 package email
 
 import (
+	"context"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/skip2/go-qrcode"
+
 	"booking-event/internal/infra/emailsender"
+	"booking-event/internal/infra/emailtemplate"
+	"booking-event/internal/infra/icalendar"
 	"booking-event/internal/modules/booking/model"
-	"context"
 )
 
+// qrCodeSize is the width in pixels of the ticket QR codes.
+const qrCodeSize = 256
+
+type Config struct {
+	From string
+	// EventDuration is the length of the calendar entries, the events have no end time.
+	EventDuration time.Duration
+}
+
 type EmailClient struct {
 	emailsender.EmailService
+	renderer *emailtemplate.Renderer
+	cfg      Config
+}
+
+func NewEmailClient(emailService emailsender.EmailService, renderer *emailtemplate.Renderer, cfg Config) *EmailClient {
+	return &EmailClient{EmailService: emailService, renderer: renderer, cfg: cfg}
 }
 
-func NewEmailClient(emailService emailsender.EmailService) *EmailClient {
-	return &EmailClient{EmailService: emailService}
+// ticket is a line of the confirmation email, its QR code is attached inline under ContentID.
+type ticket struct {
+	Token     string
+	ContentID string
+}
+
+type confirmationData struct {
+	Event     model.Event
+	Booking   model.Booking
+	Tickets   []ticket
+	UnitPrice int64
+	Total     int64
+	Currency  string
 }
 
 func (c *EmailClient) SendReminderEmail(ctx context.Context, task model.SendReminderEmailTask) error {
-	email := emailsender.Email{
-		To:      task.User.Email,
-		From:    "noreply@booking-event.com",
-		Subject: "Reminder",
-		Body:    "Reminder",
-	}
-	return c.EmailService.SendEmail(ctx, &email)
+	return c.send(ctx, task.User, emailtemplate.TemplateBookingReminder, task, nil, nil)
 }
 
 func (c *EmailClient) SendConfirmationEmail(ctx context.Context, task model.SendConfirmationEmailTask) error {
+	data := confirmationData{
+		Event:     task.Event,
+		Booking:   task.Booking,
+		Tickets:   make([]ticket, 0, len(task.Items)),
+		UnitPrice: task.Booking.UnitPrice,
+		Total:     task.Booking.UnitPrice * int64(task.Booking.Quantity),
+		Currency:  task.Event.Currency,
+	}
+	inline := make([]emailsender.Attachment, 0, len(task.Items))
+	for _, item := range task.Items {
+		png, err := qrcode.Encode(item.Token, qrcode.Medium, qrCodeSize)
+		if err != nil {
+			return err
+		}
+		contentID := fmt.Sprintf("ticket-%d@booking-event", item.ID)
+		data.Tickets = append(data.Tickets, ticket{Token: item.Token, ContentID: contentID})
+		inline = append(inline, emailsender.Attachment{
+			ContentID:   contentID,
+			Filename:    fmt.Sprintf("ticket-%d.png", item.ID),
+			ContentType: "image/png",
+			Data:        png,
+		})
+	}
+	invite := c.invite(icalendar.MethodRequest, task.User, task.Event, task.Booking)
+	return c.send(ctx, task.User, emailtemplate.TemplateBookingConfirmation, data, inline, []emailsender.Attachment{invite})
+}
+
+func (c *EmailClient) SendCancellationEmail(ctx context.Context, task model.SendCancellationEmailTask) error {
+	invite := c.invite(icalendar.MethodCancel, task.User, task.Event, task.Booking)
+	return c.send(ctx, task.User, emailtemplate.TemplateBookingCancellation, task, nil, []emailsender.Attachment{invite})
+}
+
+// SendEventChangeEmail sends the event as changed to the attendee of the booking, with an invite replacing the entry
+// of its calendar or a cancellation removing it when the event is no longer active.
+func (c *EmailClient) SendEventChangeEmail(ctx context.Context, user model.User, event model.Event, booking model.Booking) error {
+	template, method := emailtemplate.TemplateEventUpdate, icalendar.MethodRequest
+	if event.Status != model.EventStatusActive {
+		template, method = emailtemplate.TemplateEventCancellation, icalendar.MethodCancel
+	}
+	data := map[string]any{"Event": event, "Booking": booking}
+	invite := c.invite(method, user, event, booking)
+	return c.send(ctx, user, template, data, nil, []emailsender.Attachment{invite})
+}
+
+func (c *EmailClient) SendEventReviewEmail(ctx context.Context, task model.SendEventReviewEmailTask) error {
+	return c.send(ctx, task.User, emailtemplate.TemplateEventReview, task, nil, nil)
+}
+
+// invite is the .ics attachment of a booking, the sequence of the event orders the invites sent for its changes.
+func (c *EmailClient) invite(method string, user model.User, event model.Event, booking model.Booking) emailsender.Attachment {
+	organizer := c.cfg.From
+	if address, err := mail.ParseAddress(c.cfg.From); err == nil {
+		organizer = address.Address
+	}
+	status := icalendar.StatusConfirmed
+	if method == icalendar.MethodCancel {
+		status = icalendar.StatusCancelled
+	}
+	calendar := icalendar.Calendar{
+		ProdID: model.CalendarProdID,
+		Method: method,
+		Events: []icalendar.Event{{
+			UID:         model.BookingCalendarUID(booking.ID),
+			Sequence:    event.Sequence,
+			Start:       event.StartAt,
+			End:         event.StartAt.Add(c.cfg.EventDuration),
+			Summary:     event.Name,
+			Location:    event.Location,
+			Description: fmt.Sprintf("Booking #%d, %d ticket(s).", booking.ID, booking.Quantity),
+			Status:      status,
+			Latitude:    event.Latitude,
+			Longitude:   event.Longitude,
+			Organizer:   organizer,
+			Attendee:    user.Email,
+		}},
+	}
+	return emailsender.Attachment{
+		Filename:    "invite.ics",
+		ContentType: "text/calendar; charset=UTF-8; method=" + method,
+		Data:        calendar.Encode(time.Now()),
+	}
+}
+
+// send renders the template in the locale and the time zone of the user.
+func (c *EmailClient) send(ctx context.Context, user model.User, template string, data any, inline, attachments []emailsender.Attachment) error {
+	message, err := c.renderer.Render(template, user.Locale, user.Timezone, data)
+	if err != nil {
+		return err
+	}
 	email := emailsender.Email{
-		To:      task.User.Email,
-		From:    "noreply@booking-event.com",
-		Subject: "Confirmation",
-		Body:    "Confirmation",
+		To:          user.Email,
+		From:        c.cfg.From,
+		Subject:     message.Subject,
+		Body:        message.Text,
+		HTMLBody:    message.HTML,
+		Attachments: attachments,
+	}
+	// the inline attachments are only referenced from the HTML part
+	if message.HTML != "" {
+		email.Inline = inline
 	}
 	return c.EmailService.SendEmail(ctx, &email)
 }
@@ -0,0 +1,63 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+
+	bookingasynq "booking-event/internal/infra/asynq"
+	"booking-event/internal/modules/booking/model"
+)
+
+type Config struct {
+	// Retention keeps the completed tasks of the outbox messages, their ids keep conflicting with a message
+	// published again during that time.
+	Retention time.Duration
+}
+
+// TaskClient enqueues the background tasks handled by the worker.
+type TaskClient struct {
+	client bookingasynq.AsyncTaskEnqueueClient
+	cfg    Config
+}
+
+func NewTaskClient(client bookingasynq.AsyncTaskEnqueueClient, cfg Config) *TaskClient {
+	return &TaskClient{client: client, cfg: cfg}
+}
+
+func (c *TaskClient) EnqueueEventReviewEmail(ctx context.Context, task model.SendEventReviewEmailTask) error {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return c.client.Enqueue(ctx, asynq.NewTask(string(model.TaskTypeSendEventReviewEmail), payload))
+}
+
+// PublishOutboxMessage enqueues the task of an outbox message. The dedup key is the id of the task, so a message
+// published twice, like after a relay crashed before recording it, is only enqueued once. The ids only conflict
+// with the tasks still in the queue, the completed ones are retained for that.
+func (c *TaskClient) PublishOutboxMessage(ctx context.Context, message model.OutboxMessage) error {
+	opts := []asynq.Option{asynq.TaskID(message.DedupKey), asynq.Retention(c.cfg.Retention)}
+	if message.ProcessAt != nil {
+		opts = append(opts, asynq.ProcessAt(*message.ProcessAt))
+	}
+	err := c.client.Enqueue(ctx, asynq.NewTask(string(message.TaskType), message.Payload), opts...)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+	return err
+}
+
+// DeleteTasks removes the tasks from the queue, those already processed are ignored.
+func (c *TaskClient) DeleteTasks(ctx context.Context, taskIDs []string) error {
+	var errs []error
+	for _, taskID := range taskIDs {
+		if err := c.client.Delete(ctx, bookingasynq.DefaultQueue, taskID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
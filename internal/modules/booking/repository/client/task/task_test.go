@@ -0,0 +1,59 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+
+	"booking-event/internal/modules/booking/model"
+)
+
+// fakeEnqueueClient keeps the ids of the tasks like the queue with retention: an id conflicts until it is deleted.
+type fakeEnqueueClient struct {
+	tasks    map[string]bool
+	enqueued int
+	opts     []asynq.Option
+}
+
+func (c *fakeEnqueueClient) Enqueue(ctx context.Context, task *asynq.Task, opts ...asynq.Option) error {
+	c.opts = opts
+	for _, opt := range opts {
+		if opt.Type() == asynq.TaskIDOpt {
+			if c.tasks[opt.Value().(string)] {
+				return asynq.ErrTaskIDConflict
+			}
+			c.tasks[opt.Value().(string)] = true
+		}
+	}
+	c.enqueued++
+	return nil
+}
+
+func (c *fakeEnqueueClient) Delete(ctx context.Context, queue string, taskID string) error {
+	delete(c.tasks, taskID)
+	return nil
+}
+
+func TestTaskClient_PublishOutboxMessage(t *testing.T) {
+	t.Parallel()
+	client := &fakeEnqueueClient{tasks: make(map[string]bool)}
+	taskClient := NewTaskClient(client, Config{Retention: 7 * 24 * time.Hour})
+	message := model.OutboxMessage{ID: 1, TaskType: model.TaskTypeSendConfirmationEmail, DedupKey: "booking:1:confirmation", Payload: []byte("{}")}
+
+	assert.NoError(t, taskClient.PublishOutboxMessage(context.Background(), message))
+	// the completed task is retained, so the id keeps conflicting after it ran
+	var retention time.Duration
+	for _, opt := range client.opts {
+		if opt.Type() == asynq.RetentionOpt {
+			retention = opt.Value().(time.Duration)
+		}
+	}
+	assert.Equal(t, 7*24*time.Hour, retention)
+
+	// a relay publishing the message again, after dying before recording it, enqueues nothing
+	assert.NoError(t, taskClient.PublishOutboxMessage(context.Background(), message))
+	assert.Equal(t, 1, client.enqueued)
+}
@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Attendee struct {
+	TicketID    int          `db:"ticket_id"`
+	Token       string       `db:"token"`
+	BookingID   int          `db:"booking_id"`
+	UserID      int          `db:"user_id"`
+	Email       string       `db:"email"`
+	BookedAt    time.Time    `db:"booked_at"`
+	CheckedInAt sql.NullTime `db:"checked_in_at"`
+}
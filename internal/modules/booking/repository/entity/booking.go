@@ -9,6 +9,7 @@ type Booking struct {
 	Status          string    `db:"status"`
 	InitialQuantity int       `db:"initial_quantity"`
 	Quantity        int       `db:"quantity"`
+	UnitPrice       int64     `db:"unit_price"`
 	CreatedAt       time.Time `db:"created_at"`
 	UpdatedAt       time.Time `db:"updated_at"`
 }
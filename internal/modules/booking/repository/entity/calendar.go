@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+type CalendarFeed struct {
+	UserID    int       `db:"user_id"`
+	TokenHash string    `db:"token_hash"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// CalendarEntry is a booking joined with its event, only with the columns written in the feed.
+type CalendarEntry struct {
+	BookingID     int             `db:"booking_id"`
+	UserID        int             `db:"user_id"`
+	BookingStatus string          `db:"booking_status"`
+	Quantity      int             `db:"quantity"`
+	EventID       int             `db:"event_id"`
+	Name          string          `db:"name"`
+	StartAt       time.Time       `db:"start_at"`
+	Location      string          `db:"location"`
+	EventStatus   string          `db:"event_status"`
+	Latitude      sql.NullFloat64 `db:"latitude"`
+	Longitude     sql.NullFloat64 `db:"longitude"`
+	Sequence      int             `db:"sequence"`
+}
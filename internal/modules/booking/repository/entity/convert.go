@@ -4,6 +4,9 @@ import (
 	"booking-event/internal/common/util"
 	"booking-event/internal/modules/booking/model"
 	"database/sql"
+	"encoding/json"
+
+	"github.com/lib/pq"
 )
 
 func ConvertBookingToModel(booking *Booking) *model.Booking {
@@ -14,6 +17,7 @@ func ConvertBookingToModel(booking *Booking) *model.Booking {
 		Status:          model.BookingStatus(booking.Status),
 		Quantity:        booking.Quantity,
 		InitialQuantity: booking.InitialQuantity,
+		UnitPrice:       booking.UnitPrice,
 		CreatedAt:       booking.CreatedAt,
 		UpdatedAt:       booking.UpdatedAt,
 	}
@@ -27,6 +31,7 @@ func ConvertBookingToEntity(booking *model.Booking) *Booking {
 		Status:          string(booking.Status),
 		Quantity:        booking.Quantity,
 		InitialQuantity: booking.InitialQuantity,
+		UnitPrice:       booking.UnitPrice,
 		CreatedAt:       booking.CreatedAt,
 		UpdatedAt:       booking.UpdatedAt,
 	}
@@ -101,20 +106,36 @@ func ConvertBookingItemsToEntities(bookingItems []model.BookingItem) []BookingIt
 }
 
 func ConvertEventToEntity(event model.Event) *Event {
-	return &Event{
+	out := &Event{
 		ID:             event.ID,
 		Name:           event.Name,
 		AvailableSeats: event.AvailableSeats,
 		StartAt:        event.StartAt,
 		Location:       event.Location,
-		Category:       string(event.Category),
+		Categories:     ConvertCategorySlugsToStrings(event.Categories),
+		Description:    event.Description,
+		Tags:           pq.StringArray(event.Tags),
+		MinAge:         event.MinAge,
 		Price:          event.Price,
 		CreatorID:      event.CreatorID,
 		Currency:       event.Currency,
 		Status:         string(event.Status),
+		ReviewStatus:   string(event.ReviewStatus),
+		Sequence:       event.Sequence,
 		CreatedAt:      event.CreatedAt,
 		UpdatedAt:      event.UpdatedAt,
 	}
+	if event.ReminderMinutes != nil {
+		out.ReminderMinutes = make(pq.Int64Array, 0, len(event.ReminderMinutes))
+		for _, minutes := range event.ReminderMinutes {
+			out.ReminderMinutes = append(out.ReminderMinutes, int64(minutes))
+		}
+	}
+	if event.Latitude != nil && event.Longitude != nil {
+		out.Latitude = sql.NullFloat64{Float64: *event.Latitude, Valid: true}
+		out.Longitude = sql.NullFloat64{Float64: *event.Longitude, Valid: true}
+	}
+	return out
 }
 
 func ConvertEventsToEntities(events []*model.Event) []Event {
@@ -126,20 +147,39 @@ func ConvertEventsToEntities(events []*model.Event) []Event {
 }
 
 func ConvertEventToModel(event Event) *model.Event {
-	return &model.Event{
+	out := &model.Event{
 		ID:             event.ID,
 		Name:           event.Name,
 		AvailableSeats: event.AvailableSeats,
 		StartAt:        event.StartAt,
 		Location:       event.Location,
-		Category:       model.EventCategory(event.Category),
+		Categories:     ConvertStringsToCategorySlugs(event.Categories),
+		Description:    event.Description,
+		Tags:           []string(event.Tags),
+		MinAge:         event.MinAge,
 		Price:          event.Price,
 		Currency:       event.Currency,
 		Status:         model.EventStatus(event.Status),
+		ReviewStatus:   model.EventReviewStatus(event.ReviewStatus),
 		CreatorID:      event.CreatorID,
+		Sequence:       event.Sequence,
 		CreatedAt:      event.CreatedAt,
 		UpdatedAt:      event.UpdatedAt,
 	}
+	if event.ReminderMinutes != nil {
+		out.ReminderMinutes = make([]int, 0, len(event.ReminderMinutes))
+		for _, minutes := range event.ReminderMinutes {
+			out.ReminderMinutes = append(out.ReminderMinutes, int(minutes))
+		}
+	}
+	if event.Latitude.Valid && event.Longitude.Valid {
+		out.Latitude = util.ToPtr(event.Latitude.Float64)
+		out.Longitude = util.ToPtr(event.Longitude.Float64)
+	}
+	if event.Distance.Valid {
+		out.DistanceKm = util.ToPtr(event.Distance.Float64)
+	}
+	return out
 }
 
 func ConvertEventsToModels(events []Event) []model.Event {
@@ -149,3 +189,327 @@ func ConvertEventsToModels(events []Event) []model.Event {
 	}
 	return models
 }
+
+func ConvertCategorySlugsToStrings(slugs []model.EventCategory) pq.StringArray {
+	out := make(pq.StringArray, len(slugs))
+	for i, slug := range slugs {
+		out[i] = string(slug)
+	}
+	return out
+}
+
+func ConvertStringsToCategorySlugs(slugs []string) []model.EventCategory {
+	out := make([]model.EventCategory, len(slugs))
+	for i, slug := range slugs {
+		out[i] = model.EventCategory(slug)
+	}
+	return out
+}
+
+func ConvertCategoryToModel(category Category) *model.Category {
+	return &model.Category{
+		ID:          category.ID,
+		Slug:        model.EventCategory(category.Slug),
+		Name:        category.Name,
+		Description: category.Description,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+	}
+}
+
+func ConvertCategoriesToModels(categories []Category) []model.Category {
+	models := make([]model.Category, len(categories))
+	for i, category := range categories {
+		models[i] = *ConvertCategoryToModel(category)
+	}
+	return models
+}
+
+func ConvertEventImageToModel(image EventImage) *model.EventImage {
+	return &model.EventImage{
+		ID:          image.ID,
+		EventID:     image.EventID,
+		StorageKey:  image.StorageKey,
+		URL:         image.URL,
+		ContentType: image.ContentType,
+		Size:        image.Size,
+		CreatedAt:   image.CreatedAt,
+	}
+}
+
+func ConvertEventImagesToModels(images []EventImage) []model.EventImage {
+	models := make([]model.EventImage, len(images))
+	for i, image := range images {
+		models[i] = *ConvertEventImageToModel(image)
+	}
+	return models
+}
+
+func ConvertUserToModel(user User) *model.User {
+	out := &model.User{
+		ID:       user.ID,
+		Email:    user.Email,
+		Status:   user.Status,
+		Locale:   user.Locale,
+		Timezone: user.Timezone,
+	}
+	if user.Birthdate.Valid {
+		out.Birthdate = &user.Birthdate.Time
+	}
+	return out
+}
+
+func ConvertEventReviewToModel(review EventReview) *model.EventReview {
+	return &model.EventReview{
+		ID:         review.ID,
+		EventID:    review.EventID,
+		FromStatus: model.EventReviewStatus(review.FromStatus),
+		ToStatus:   model.EventReviewStatus(review.ToStatus),
+		ActorID:    review.ActorID,
+		Reason:     review.Reason,
+		CreatedAt:  review.CreatedAt,
+	}
+}
+
+func ConvertEventReviewsToModels(reviews []EventReview) []model.EventReview {
+	models := make([]model.EventReview, len(reviews))
+	for i, review := range reviews {
+		models[i] = *ConvertEventReviewToModel(review)
+	}
+	return models
+}
+
+func ConvertEventSalesStatsToModel(stats EventSalesStats) *model.EventSalesStats {
+	out := &model.EventSalesStats{
+		EventID:           stats.EventID,
+		EventName:         stats.EventName,
+		StartAt:           stats.StartAt,
+		TicketsSold:       int(stats.TicketsSold.Int64),
+		TicketsLocked:     int(stats.TicketsLocked.Int64),
+		TicketsCancelled:  int(stats.TicketsCancelled.Int64),
+		TicketsRefunded:   int(stats.TicketsRefunded.Int64),
+		BookingsTotal:     int(stats.BookingsTotal.Int64),
+		BookingsConfirmed: int(stats.BookingsConfirmed.Int64),
+		GrossRevenue:      stats.GrossRevenue.Int64,
+		NetRevenue:        stats.NetRevenue.Int64,
+		Currency:          stats.Currency,
+	}
+	if out.BookingsTotal > 0 {
+		out.ConversionRate = float64(out.BookingsConfirmed) / float64(out.BookingsTotal)
+	}
+	if stats.RefreshedAt.Valid {
+		out.RefreshedAt = util.ToPtr(stats.RefreshedAt.Time)
+	}
+	return out
+}
+
+func ConvertEventSalesStatsListToModels(stats []EventSalesStats) []model.EventSalesStats {
+	models := make([]model.EventSalesStats, len(stats))
+	for i, s := range stats {
+		models[i] = *ConvertEventSalesStatsToModel(s)
+	}
+	return models
+}
+
+func ConvertSalesDataPointsToModels(points []SalesDataPoint) []model.SalesDataPoint {
+	models := make([]model.SalesDataPoint, len(points))
+	for i, point := range points {
+		models[i] = model.SalesDataPoint{Bucket: point.Bucket, TicketsSold: point.TicketsSold, GrossRevenue: point.GrossRevenue}
+	}
+	return models
+}
+
+func ConvertAttendeeToModel(attendee Attendee) *model.Attendee {
+	out := &model.Attendee{
+		TicketID:  attendee.TicketID,
+		Token:     attendee.Token,
+		BookingID: attendee.BookingID,
+		UserID:    attendee.UserID,
+		Email:     attendee.Email,
+		BookedAt:  attendee.BookedAt,
+	}
+	if attendee.CheckedInAt.Valid {
+		out.CheckedInAt = util.ToPtr(attendee.CheckedInAt.Time)
+	}
+	return out
+}
+
+func ConvertEventTemplateToModel(template EventTemplate) (*model.EventTemplate, error) {
+	out := &model.EventTemplate{
+		ID:        template.ID,
+		CreatorID: template.CreatorID,
+		Name:      template.Name,
+		CreatedAt: template.CreatedAt,
+		UpdatedAt: template.UpdatedAt,
+	}
+	if err := json.Unmarshal(template.Content, &out.Content); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func ConvertEventTemplatesToModels(templates []EventTemplate) ([]model.EventTemplate, error) {
+	models := make([]model.EventTemplate, len(templates))
+	for i, template := range templates {
+		m, err := ConvertEventTemplateToModel(template)
+		if err != nil {
+			return nil, err
+		}
+		models[i] = *m
+	}
+	return models, nil
+}
+
+func ConvertOutboxMessageToEntity(message model.OutboxMessage) OutboxMessage {
+	out := OutboxMessage{
+		ID:            message.ID,
+		TaskType:      string(message.TaskType),
+		Payload:       string(message.Payload),
+		DedupKey:      message.DedupKey,
+		Attempts:      message.Attempts,
+		LastError:     message.LastError,
+		NextAttemptAt: message.NextAttemptAt,
+		CreatedAt:     message.CreatedAt,
+	}
+	if message.ProcessAt != nil {
+		out.ProcessAt = sql.NullTime{Time: *message.ProcessAt, Valid: true}
+	}
+	return out
+}
+
+func ConvertOutboxMessageToModel(message OutboxMessage) model.OutboxMessage {
+	out := model.OutboxMessage{
+		ID:            message.ID,
+		TaskType:      model.TaskType(message.TaskType),
+		Payload:       []byte(message.Payload),
+		DedupKey:      message.DedupKey,
+		Attempts:      message.Attempts,
+		LastError:     message.LastError,
+		NextAttemptAt: message.NextAttemptAt,
+		CreatedAt:     message.CreatedAt,
+	}
+	if message.ProcessAt.Valid {
+		out.ProcessAt = util.ToPtr(message.ProcessAt.Time)
+	}
+	if message.PublishedAt.Valid {
+		out.PublishedAt = util.ToPtr(message.PublishedAt.Time)
+	}
+	if message.FailedAt.Valid {
+		out.FailedAt = util.ToPtr(message.FailedAt.Time)
+	}
+	return out
+}
+
+func ConvertCalendarEntryToModel(entry CalendarEntry) model.CalendarEntry {
+	out := model.CalendarEntry{
+		Booking: model.Booking{
+			ID:       entry.BookingID,
+			UserID:   entry.UserID,
+			EventID:  entry.EventID,
+			Status:   model.BookingStatus(entry.BookingStatus),
+			Quantity: entry.Quantity,
+		},
+		Event: model.Event{
+			ID:       entry.EventID,
+			Name:     entry.Name,
+			StartAt:  entry.StartAt,
+			Location: entry.Location,
+			Status:   model.EventStatus(entry.EventStatus),
+			Sequence: entry.Sequence,
+		},
+	}
+	if entry.Latitude.Valid && entry.Longitude.Valid {
+		out.Event.Latitude = util.ToPtr(entry.Latitude.Float64)
+		out.Event.Longitude = util.ToPtr(entry.Longitude.Float64)
+	}
+	return out
+}
+
+func ConvertCalendarEntriesToModels(entries []CalendarEntry) []model.CalendarEntry {
+	out := make([]model.CalendarEntry, 0, len(entries))
+	for _, entry := range entries {
+		out = append(out, ConvertCalendarEntryToModel(entry))
+	}
+	return out
+}
+
+func ConvertWebhookSubscriptionToEntity(subscription model.WebhookSubscription) WebhookSubscription {
+	out := WebhookSubscription{
+		ID:                  subscription.ID,
+		OwnerID:             subscription.OwnerID,
+		URL:                 subscription.URL,
+		Secret:              subscription.Secret,
+		EventTypes:          make(pq.StringArray, 0, len(subscription.EventTypes)),
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+	if subscription.ServiceAccountID != nil {
+		out.ServiceAccountID = sql.NullInt64{Int64: int64(*subscription.ServiceAccountID), Valid: true}
+	}
+	for _, eventType := range subscription.EventTypes {
+		out.EventTypes = append(out.EventTypes, string(eventType))
+	}
+	if subscription.DisabledAt != nil {
+		out.DisabledAt = sql.NullTime{Time: *subscription.DisabledAt, Valid: true}
+	}
+	return out
+}
+
+func ConvertWebhookSubscriptionToModel(subscription WebhookSubscription) model.WebhookSubscription {
+	out := model.WebhookSubscription{
+		ID:                  subscription.ID,
+		OwnerID:             subscription.OwnerID,
+		URL:                 subscription.URL,
+		Secret:              subscription.Secret,
+		EventTypes:          make([]model.WebhookEventType, 0, len(subscription.EventTypes)),
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+	if subscription.ServiceAccountID.Valid {
+		out.ServiceAccountID = util.ToPtr(int(subscription.ServiceAccountID.Int64))
+	}
+	for _, eventType := range subscription.EventTypes {
+		out.EventTypes = append(out.EventTypes, model.WebhookEventType(eventType))
+	}
+	if subscription.DisabledAt.Valid {
+		out.DisabledAt = util.ToPtr(subscription.DisabledAt.Time)
+	}
+	return out
+}
+
+func ConvertWebhookDeliveryToModel(delivery WebhookDelivery) model.WebhookDelivery {
+	out := model.WebhookDelivery{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		WebhookEventID: delivery.WebhookEventID,
+		EventType:      model.WebhookEventType(delivery.EventType),
+		Payload:        []byte(delivery.Payload),
+		Status:         model.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		Failures:       delivery.Failures,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+	if delivery.NextAttemptAt.Valid {
+		out.NextAttemptAt = util.ToPtr(delivery.NextAttemptAt.Time)
+	}
+	if delivery.DeliveredAt.Valid {
+		out.DeliveredAt = util.ToPtr(delivery.DeliveredAt.Time)
+	}
+	return out
+}
+
+func ConvertWebhookDeliveryAttemptToModel(attempt WebhookDeliveryAttempt) model.WebhookDeliveryAttempt {
+	return model.WebhookDeliveryAttempt{
+		ID:           attempt.ID,
+		DeliveryID:   attempt.DeliveryID,
+		Attempt:      attempt.Attempt,
+		StatusCode:   attempt.StatusCode,
+		Error:        attempt.Error,
+		ResponseBody: attempt.ResponseBody,
+		DurationMs:   attempt.DurationMs,
+		CreatedAt:    attempt.CreatedAt,
+	}
+}
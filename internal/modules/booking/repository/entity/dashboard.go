@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+type EventSalesStats struct {
+	EventID           int           `db:"event_id"`
+	EventName         string        `db:"event_name"`
+	StartAt           time.Time     `db:"start_at"`
+	TicketsSold       sql.NullInt64 `db:"tickets_sold"`
+	TicketsLocked     sql.NullInt64 `db:"tickets_locked"`
+	TicketsCancelled  sql.NullInt64 `db:"tickets_cancelled"`
+	TicketsRefunded   sql.NullInt64 `db:"tickets_refunded"`
+	BookingsTotal     sql.NullInt64 `db:"bookings_total"`
+	BookingsConfirmed sql.NullInt64 `db:"bookings_confirmed"`
+	GrossRevenue      sql.NullInt64 `db:"gross_revenue"`
+	NetRevenue        sql.NullInt64 `db:"net_revenue"`
+	Currency          string        `db:"currency"`
+	RefreshedAt       sql.NullTime  `db:"refreshed_at"`
+}
+
+type SalesDataPoint struct {
+	Bucket       time.Time `db:"bucket"`
+	TicketsSold  int       `db:"tickets_sold"`
+	GrossRevenue int64     `db:"gross_revenue"`
+}
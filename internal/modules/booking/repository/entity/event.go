@@ -1,20 +1,62 @@
 package entity
 
 import (
+	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 type Event struct {
-	ID             int       `db:"id"`
-	Name           string    `db:"name"`
-	AvailableSeats int       `db:"available_seats"`
-	StartAt        time.Time `db:"start_at"`
-	Location       string    `db:"location"`
-	Category       string    `db:"category"`
-	Price          int64     `db:"price"`
-	Currency       string    `db:"currency"`
-	Status         string    `db:"status"`
-	CreatorID      int       `db:"creator_id"`
-	CreatedAt      time.Time `db:"created_at"`
-	UpdatedAt      time.Time `db:"updated_at"`
+	ID             int             `db:"id"`
+	Name           string          `db:"name"`
+	AvailableSeats int             `db:"available_seats"`
+	StartAt        time.Time       `db:"start_at"`
+	Location       string          `db:"location"`
+	Categories     pq.StringArray  `db:"categories"`
+	Description    string          `db:"description"`
+	Tags           pq.StringArray  `db:"tags"`
+	MinAge         int             `db:"min_age"`
+	Price          int64           `db:"price"`
+	Currency       string          `db:"currency"`
+	Status         string          `db:"status"`
+	ReviewStatus   string          `db:"review_status"`
+	CreatorID      int             `db:"creator_id"`
+	Latitude       sql.NullFloat64 `db:"latitude"`
+	Longitude      sql.NullFloat64 `db:"longitude"`
+	Distance       sql.NullFloat64 `db:"distance"`
+	Sequence       int             `db:"sequence"`
+	// ReminderMinutes is NULL when the event follows the global schedule.
+	ReminderMinutes pq.Int64Array `db:"reminder_minutes"`
+	CreatedAt       time.Time     `db:"created_at"`
+	UpdatedAt       time.Time     `db:"updated_at"`
+}
+
+type EventImage struct {
+	ID          int       `db:"id"`
+	EventID     int       `db:"event_id"`
+	StorageKey  string    `db:"storage_key"`
+	URL         string    `db:"url"`
+	ContentType string    `db:"content_type"`
+	Size        int64     `db:"size"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type Category struct {
+	ID          int       `db:"id"`
+	Slug        string    `db:"slug"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+type EventReview struct {
+	ID         int       `db:"id"`
+	EventID    int       `db:"event_id"`
+	FromStatus string    `db:"from_status"`
+	ToStatus   string    `db:"to_status"`
+	ActorID    int       `db:"actor_id"`
+	Reason     string    `db:"reason"`
+	CreatedAt  time.Time `db:"created_at"`
 }
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+type OutboxMessage struct {
+	ID            int64        `db:"id"`
+	TaskType      string       `db:"task_type"`
+	Payload       string       `db:"payload"` // a string so lib/pq does not send it as bytea
+	DedupKey      string       `db:"dedup_key"`
+	ProcessAt     sql.NullTime `db:"process_at"`
+	Attempts      int          `db:"attempts"`
+	LastError     string       `db:"last_error"`
+	NextAttemptAt time.Time    `db:"next_attempt_at"`
+	PublishedAt   sql.NullTime `db:"published_at"`
+	FailedAt      sql.NullTime `db:"failed_at"`
+	CreatedAt     time.Time    `db:"created_at"`
+}
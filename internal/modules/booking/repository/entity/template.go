@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+type EventTemplate struct {
+	ID        int       `db:"id"`
+	CreatorID int       `db:"creator_id"`
+	Name      string    `db:"name"`
+	Content   []byte    `db:"content"` // model.EventTemplateContent as JSON
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
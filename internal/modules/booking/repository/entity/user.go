@@ -0,0 +1,12 @@
+package entity
+
+import "database/sql"
+
+type User struct {
+	ID        int          `db:"id"`
+	Email     string       `db:"email"`
+	Status    string       `db:"status"`
+	Birthdate sql.NullTime `db:"birthdate"`
+	Locale    string       `db:"locale"`
+	Timezone  string       `db:"timezone"`
+}
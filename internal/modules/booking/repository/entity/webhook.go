@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type WebhookSubscription struct {
+	ID                  int            `db:"id"`
+	OwnerID             int            `db:"owner_id"`
+	ServiceAccountID    sql.NullInt64  `db:"service_account_id"`
+	URL                 string         `db:"url"`
+	Secret              string         `db:"secret"`
+	EventTypes          pq.StringArray `db:"event_types"`
+	ConsecutiveFailures int            `db:"consecutive_failures"`
+	DisabledAt          sql.NullTime   `db:"disabled_at"`
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             int64        `db:"id"`
+	SubscriptionID int          `db:"subscription_id"`
+	WebhookEventID string       `db:"webhook_event_id"`
+	EventType      string       `db:"event_type"`
+	Payload        string       `db:"payload"` // a string so lib/pq does not send it as bytea
+	Status         string       `db:"status"`
+	Attempts       int          `db:"attempts"`
+	Failures       int          `db:"failures"`
+	NextAttemptAt  sql.NullTime `db:"next_attempt_at"`
+	DeliveredAt    sql.NullTime `db:"delivered_at"`
+	CreatedAt      time.Time    `db:"created_at"`
+	UpdatedAt      time.Time    `db:"updated_at"`
+}
+
+type WebhookDeliveryAttempt struct {
+	ID           int64     `db:"id"`
+	DeliveryID   int64     `db:"delivery_id"`
+	Attempt      int       `db:"attempt"`
+	StatusCode   int       `db:"status_code"`
+	Error        string    `db:"error"`
+	ResponseBody string    `db:"response_body"`
+	DurationMs   int       `db:"duration_ms"`
+	CreatedAt    time.Time `db:"created_at"`
+}
@@ -0,0 +1,167 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"booking-event/internal/common/errors"
+	postgresql "booking-event/internal/infra/posgresql"
+	"booking-event/internal/modules/booking/model"
+	"booking-event/internal/modules/booking/repository/entity"
+)
+
+type AttendeeRepository struct {
+	db         *sqlx.DB
+	outboxRepo OutboxRepositoryForAttendee
+}
+
+type OutboxRepositoryForAttendee interface {
+	CreateMessagesByTx(ctx context.Context, tx postgresql.ExecerContext, messages []model.OutboxMessage) error
+}
+
+func NewAttendeeRepository(db *sqlx.DB, outboxRepo OutboxRepositoryForAttendee) *AttendeeRepository {
+	return &AttendeeRepository{db: db, outboxRepo: outboxRepo}
+}
+
+// attendeesQuery selects the used tickets of an event with their current holder. Released tokens
+// can be booked again, so only the confirmed booking of a token is joined.
+func attendeesQuery(columns string, query model.AttendeeQuery, startID int) (string, map[string]interface{}) {
+	queryString := `SELECT ` + columns + ` FROM event_tokens t
+		JOIN booking_items bi ON bi.token = t.token
+		JOIN bookings b ON b.id = bi.booking_id AND b.event_id = t.event_id AND b.status IN ('confirmed', 'paid')
+		JOIN users u ON u.id = b.user_id
+		WHERE t.event_id = :event_id AND t.status = :status`
+	if query.CheckedIn != nil {
+		if *query.CheckedIn {
+			queryString += " AND t.checked_in_at IS NOT NULL"
+		} else {
+			queryString += " AND t.checked_in_at IS NULL"
+		}
+	}
+	if startID > 0 {
+		queryString += " AND t.id >= :start_id"
+	}
+	queryString += " ORDER BY t.id"
+	return queryString, map[string]interface{}{
+		"event_id": query.EventID,
+		"status":   string(model.TokenStatusUsed),
+		"start_id": startID,
+	}
+}
+
+// StreamAttendees calls fn for each attendee starting at ticket startID, without loading the whole list in memory.
+// A zero limit streams every remaining attendee.
+func (r *AttendeeRepository) StreamAttendees(ctx context.Context, query model.AttendeeQuery, startID int, limit int, fn func(model.Attendee) error) error {
+	queryString, args := attendeesQuery(`t.id AS ticket_id, t.token, t.checked_in_at, b.id AS booking_id, b.user_id, u.email,
+		COALESCE(b.confirmed_at, b.created_at) AS booked_at`, query, startID)
+	if limit > 0 {
+		queryString += " LIMIT :limit"
+		args["limit"] = limit
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, queryString, args)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var attendee entity.Attendee
+		if err := rows.StructScan(&attendee); err != nil {
+			return err
+		}
+		if err := fn(*entity.ConvertAttendeeToModel(attendee)); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// GetAttendeeIDAfter returns the ticket id of the first attendee following the page of limit
+// attendees starting at startID, or 0 when that page is the last one.
+func (r *AttendeeRepository) GetAttendeeIDAfter(ctx context.Context, query model.AttendeeQuery, startID int, limit int) (int, error) {
+	queryString, args := attendeesQuery("t.id", query, startID)
+	queryString += " OFFSET :limit LIMIT 1"
+	args["limit"] = limit
+
+	rows, err := r.db.NamedQueryContext(ctx, queryString, args)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	var id int
+	if rows.Next() {
+		if err := rows.Scan(&id); err != nil {
+			return 0, err
+		}
+	}
+	return id, rows.Err()
+}
+
+// CheckIn marks the ticket as scanned and dispatches the checkin.scanned webhook event in the same transaction.
+func (r *AttendeeRepository) CheckIn(ctx context.Context, eventID int, token string) (*time.Time, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var ticketID int
+	var checkedInAt time.Time
+	err = tx.QueryRowxContext(ctx, `UPDATE event_tokens SET checked_in_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE event_id = $1 AND token = $2 AND status = $3 AND checked_in_at IS NULL
+		RETURNING id, checked_in_at`, eventID, token, string(model.TokenStatusUsed)).Scan(&ticketID, &checkedInAt)
+	if err == nil {
+		if err := r.createCheckInMessageByTx(ctx, tx, eventID, ticketID, checkedInAt); err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+		return &checkedInAt, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+	_ = tx.Rollback()
+
+	// nothing was updated, find out why
+	var current entity.EventToken
+	err = r.db.GetContext(ctx, &current, "SELECT id, event_id, token, status, holder_id, locked_until, created_at, updated_at FROM event_tokens WHERE event_id = $1 AND token = $2", eventID, token)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != string(model.TokenStatusUsed) {
+		return nil, model.ErrTicketNotValid
+	}
+	return nil, model.ErrAlreadyCheckedIn
+}
+
+// createCheckInMessageByTx writes the checkin.scanned webhook event with the holder of the ticket, a ticket without
+// a confirmed booking has no holder to report and is skipped.
+func (r *AttendeeRepository) createCheckInMessageByTx(ctx context.Context, tx *sqlx.Tx, eventID int, ticketID int, checkedInAt time.Time) error {
+	var attendee entity.Attendee
+	err := tx.GetContext(ctx, &attendee, `SELECT t.id AS ticket_id, t.token, t.checked_in_at, b.id AS booking_id, b.user_id, u.email,
+		COALESCE(b.confirmed_at, b.created_at) AS booked_at FROM event_tokens t
+		JOIN booking_items bi ON bi.token = t.token
+		JOIN bookings b ON b.id = bi.booking_id AND b.event_id = t.event_id AND b.status IN ('confirmed', 'paid')
+		JOIN users u ON u.id = b.user_id
+		WHERE t.id = $1`, ticketID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	message, err := model.NewWebhookEventMessage(model.WebhookEventCheckinScanned, eventID, strconv.Itoa(ticketID), entity.ConvertAttendeeToModel(attendee), checkedInAt)
+	if err != nil {
+		return err
+	}
+	return r.outboxRepo.CreateMessagesByTx(ctx, tx, []model.OutboxMessage{*message})
+}
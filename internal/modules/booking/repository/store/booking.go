@@ -3,12 +3,14 @@ package store
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/Rhymond/go-money"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 
-	bookingasynq "booking-event/internal/infra/asynq"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
 	"booking-event/internal/infra/paymentgateway"
 	postgresql "booking-event/internal/infra/posgresql"
 	"booking-event/internal/modules/booking/model"
@@ -29,12 +31,21 @@ type EventTokenRepositoryForBooking interface {
 	ConfirmUsedTokensByTx(ctx context.Context, tx postgresql.ExecerContext, tokens []model.ConfirmingToken) error
 }
 
+type OutboxRepositoryForBooking interface {
+	CreateMessagesByTx(ctx context.Context, tx postgresql.ExecerContext, messages []model.OutboxMessage) error
+}
+
+type ReminderRepositoryForBooking interface {
+	CreateRemindersByTx(ctx context.Context, tx postgresql.ExecerContext, reminders []model.BookingReminder) error
+}
+
 type BookingRepository struct {
 	db              *sqlx.DB
 	paymentClient   PaymentClient
 	bookingItemRepo BookingItemRepositoryForBooking
 	tokenRepo       EventTokenRepositoryForBooking
-	asynqClient     bookingasynq.AsyncTaskEnqueueClient
+	outboxRepo      OutboxRepositoryForBooking
+	reminderRepo    ReminderRepositoryForBooking
 }
 
 func NewBookingRepository(
@@ -42,9 +53,17 @@ func NewBookingRepository(
 	paymentClient PaymentClient,
 	bookingItemRepo BookingItemRepositoryForBooking,
 	tokenRepo EventTokenRepositoryForBooking,
-	asynqClient bookingasynq.AsyncTaskEnqueueClient,
+	outboxRepo OutboxRepositoryForBooking,
+	reminderRepo ReminderRepositoryForBooking,
 ) *BookingRepository {
-	return &BookingRepository{db: db, paymentClient: paymentClient, bookingItemRepo: bookingItemRepo, tokenRepo: tokenRepo, asynqClient: asynqClient}
+	return &BookingRepository{
+		db:              db,
+		paymentClient:   paymentClient,
+		bookingItemRepo: bookingItemRepo,
+		tokenRepo:       tokenRepo,
+		outboxRepo:      outboxRepo,
+		reminderRepo:    reminderRepo,
+	}
 }
 
 const ()
@@ -60,10 +79,10 @@ func (c *BookingRepository) CreateBooking(ctx context.Context, booking *model.Bo
 
 	entityBooking := entity.ConvertBookingToEntity(booking)
 	err = tx.QueryRowxContext(ctx, `
-		INSERT INTO bookings (user_id, event_id, status, initial_quantity, quantity)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id
-	`, entityBooking.UserID, entityBooking.EventID, entityBooking.Status, entityBooking.InitialQuantity, entityBooking.Quantity).Scan(&booking.ID)
+		INSERT INTO bookings (user_id, event_id, status, initial_quantity, quantity, unit_price)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at
+	`, entityBooking.UserID, entityBooking.EventID, entityBooking.Status, entityBooking.InitialQuantity, entityBooking.Quantity, entityBooking.UnitPrice).Scan(&booking.ID, &booking.CreatedAt, &booking.UpdatedAt)
 	if err != nil {
 		return tx.Rollback()
 	}
@@ -77,6 +96,18 @@ func (c *BookingRepository) CreateBooking(ctx context.Context, booking *model.Bo
 		return tx.Rollback()
 	}
 
+	// the id of the booking is only known here, so its webhook event is built with the insert
+	webhookEvent, err := model.NewWebhookEventMessage(model.WebhookEventBookingCreated, booking.EventID, strconv.Itoa(booking.ID), booking, booking.CreatedAt)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	err = c.outboxRepo.CreateMessagesByTx(ctx, tx, []model.OutboxMessage{*webhookEvent})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
 	return tx.Commit()
 }
 
@@ -88,7 +119,7 @@ func (c *BookingRepository) CountBookingByUserID(ctx context.Context, eventID in
 
 func (c *BookingRepository) GetBookingByID(ctx context.Context, id int) (*model.Booking, error) {
 	entityBooking := &entity.Booking{}
-	err := c.db.QueryRowxContext(ctx, "SELECT id, user_id, event_id, status, initial_quantity, quantity FROM bookings WHERE id = $1", id).StructScan(entityBooking)
+	err := c.db.QueryRowxContext(ctx, "SELECT id, user_id, event_id, status, initial_quantity, quantity, unit_price FROM bookings WHERE id = $1", id).StructScan(entityBooking)
 	if err != nil {
 		return nil, err
 	}
@@ -96,15 +127,89 @@ func (c *BookingRepository) GetBookingByID(ctx context.Context, id int) (*model.
 	return entity.ConvertBookingToModel(entityBooking), nil
 }
 
-func (c *BookingRepository) ConfirmBooking(ctx context.Context, booking *model.Booking, event *model.Event, bookingItems []model.BookingItem) error {
+func (c *BookingRepository) ListBookings(ctx context.Context, query model.BookingQuery) (*commonmodel.Page[model.Booking], error) {
+	cursor, err := query.Pagination.GetCursor()
+	if err != nil {
+		return nil, err
+	}
+
+	queryString := "SELECT id, user_id, event_id, status, initial_quantity, quantity, unit_price, created_at, updated_at FROM bookings WHERE user_id = :user_id"
+	if query.EventID != 0 {
+		queryString += " AND event_id = :event_id"
+	}
+	if query.Status != "" {
+		queryString += " AND status = :status"
+	}
+
+	limit := query.Pagination.GetLimit()
+	args := map[string]interface{}{
+		"user_id":  query.UserID,
+		"event_id": query.EventID,
+		"status":   string(query.Status),
+		"limit":    limit,
+		"offset":   query.Pagination.GetOffset(),
+	}
+
+	useCursor := query.Pagination.UseCursor()
+	backward := cursor != nil && cursor.Backward
+	if cursor != nil {
+		if cursor.Time == nil {
+			return nil, commonmodel.ErrInvalidCursor
+		}
+		args["cursor_value"] = *cursor.Time
+		args["cursor_id"] = cursor.ID
+		if backward {
+			queryString += " AND (created_at, id) > (:cursor_value, :cursor_id)"
+		} else {
+			queryString += " AND (created_at, id) < (:cursor_value, :cursor_id)"
+		}
+	}
+	if backward {
+		queryString += " ORDER BY created_at ASC, id ASC"
+	} else {
+		queryString += " ORDER BY created_at DESC, id DESC"
+	}
+	if useCursor {
+		args["limit"] = limit + 1
+		queryString += " LIMIT :limit"
+	} else {
+		queryString += " LIMIT :limit OFFSET :offset"
+	}
+
+	rows, err := c.db.NamedQueryContext(ctx, queryString, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	bookings := []model.Booking{}
+	for rows.Next() {
+		var booking entity.Booking
+		if err := rows.StructScan(&booking); err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, *entity.ConvertBookingToModel(&booking))
+	}
+
+	if !useCursor {
+		return &commonmodel.Page[model.Booking]{Items: bookings}, nil
+	}
+	return commonmodel.NewCursorPage(bookings, limit, cursor, func(booking model.Booking) commonmodel.Cursor {
+		return commonmodel.Cursor{Time: util.ToPtr(booking.CreatedAt), ID: booking.ID}
+	}), nil
+}
+
+// ConfirmBooking confirms the booking and writes the messages announcing it in the same transaction, they are
+// published by the outbox relay of the worker once committed. The reminders among the messages are tracked.
+func (c *BookingRepository) ConfirmBooking(ctx context.Context, booking *model.Booking, event *model.Event, bookingItems []model.BookingItem, messages []model.OutboxMessage, reminders []model.BookingReminder) error {
 	tx, err := c.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	_, err = tx.ExecContext(ctx, "UPDATE bookings SET status = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2", string(model.BookingStatusConfirmed), booking.ID)
+	_, err = tx.ExecContext(ctx, "UPDATE bookings SET status = $1, confirmed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP WHERE id = $2", string(model.BookingStatusConfirmed), booking.ID)
 	if err != nil {
-		return tx.Rollback()
+		_ = tx.Rollback()
+		return err
 	}
 
 	tokens := make([]model.ConfirmingToken, len(bookingItems))
@@ -114,7 +219,20 @@ func (c *BookingRepository) ConfirmBooking(ctx context.Context, booking *model.B
 
 	err = c.tokenRepo.ConfirmUsedTokensByTx(ctx, tx, tokens)
 	if err != nil {
-		return tx.Rollback()
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = c.outboxRepo.CreateMessagesByTx(ctx, tx, messages)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = c.reminderRepo.CreateRemindersByTx(ctx, tx, reminders)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
 	}
 
 	amount := money.New(event.Price, event.Currency)
@@ -126,16 +244,15 @@ func (c *BookingRepository) ConfirmBooking(ctx context.Context, booking *model.B
 
 	err = c.paymentClient.CreatePayment(ctx, payment)
 	if err != nil {
-		return tx.Rollback()
+		_ = tx.Rollback()
+		return err
 	}
 
-	// _ = c.asynqClient.Enqueue(ctx, asynq.NewTask(string(model.TaskTypeSendConfirmationEmail), []byte("{}")))
-	// _ = c.asynqClient.Enqueue(ctx, asynq.NewTask(string(model.TaskTypeSendReminderEmail), []byte("{}")), asynq.ProcessAt(event.StartAt))
-
 	return tx.Commit()
 }
 
-func (c *BookingRepository) CancelBooking(ctx context.Context, bookingID int) error {
+// CancelBooking cancels the booking, releases its tokens and writes the messages announcing it in one transaction.
+func (c *BookingRepository) CancelBooking(ctx context.Context, bookingID int, messages []model.OutboxMessage) error {
 	bookingItems, err := c.bookingItemRepo.GetBookingItemsByBookingID(ctx, bookingID)
 	if err != nil {
 		return err
@@ -155,7 +272,8 @@ func (c *BookingRepository) CancelBooking(ctx context.Context, bookingID int) er
 		"status": string(model.BookingStatusCanceled),
 	})
 	if err != nil {
-		return tx.Rollback()
+		_ = tx.Rollback()
+		return err
 	}
 
 	bookingItemsTokens := make([]string, len(bookingItems))
@@ -165,7 +283,14 @@ func (c *BookingRepository) CancelBooking(ctx context.Context, bookingID int) er
 
 	err = c.tokenRepo.ReleaseTokensByTx(ctx, tx, bookingItemsTokens)
 	if err != nil {
-		return tx.Rollback()
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = c.outboxRepo.CreateMessagesByTx(ctx, tx, messages)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
 	}
 
 	err = tx.Commit()
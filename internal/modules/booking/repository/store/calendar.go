@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+	"booking-event/internal/modules/booking/repository/entity"
+)
+
+type CalendarFeedRepository struct {
+	db *sqlx.DB
+}
+
+func NewCalendarFeedRepository(db *sqlx.DB) *CalendarFeedRepository {
+	return &CalendarFeedRepository{db: db}
+}
+
+// SaveCalendarFeed creates the feed of the user or replaces its token, the URL of the previous one stops working.
+func (r *CalendarFeedRepository) SaveCalendarFeed(ctx context.Context, feed *model.CalendarFeed) error {
+	return r.db.QueryRowxContext(ctx, `INSERT INTO calendar_feeds (user_id, token_hash) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_at = CURRENT_TIMESTAMP
+		RETURNING created_at`, feed.UserID, feed.TokenHash).Scan(&feed.CreatedAt)
+}
+
+func (r *CalendarFeedRepository) GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*model.CalendarFeed, error) {
+	var feed entity.CalendarFeed
+	err := r.db.GetContext(ctx, &feed, "SELECT user_id, token_hash, created_at FROM calendar_feeds WHERE token_hash = $1", tokenHash)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &model.CalendarFeed{UserID: feed.UserID, TokenHash: feed.TokenHash, CreatedAt: feed.CreatedAt}, nil
+}
+
+func (r *CalendarFeedRepository) DeleteCalendarFeed(ctx context.Context, userID int) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM calendar_feeds WHERE user_id = $1", userID)
+	return err
+}
+
+// ListCalendarEntries returns the confirmed or paid bookings of the user for the active events starting from from.
+func (r *CalendarFeedRepository) ListCalendarEntries(ctx context.Context, userID int, from time.Time) ([]model.CalendarEntry, error) {
+	entries := []entity.CalendarEntry{}
+	err := r.db.SelectContext(ctx, &entries, `SELECT b.id AS booking_id, b.user_id, b.status AS booking_status, b.quantity,
+		e.id AS event_id, e.name, e.start_at, e.location, e.status AS event_status, e.latitude, e.longitude, e.sequence
+		FROM bookings b JOIN events e ON e.id = b.event_id
+		WHERE b.user_id = $1 AND b.status IN ($2, $3) AND e.status = $4 AND e.start_at >= $5
+		ORDER BY e.start_at, b.id`,
+		userID, model.BookingStatusConfirmed, model.BookingStatusPaid, model.EventStatusActive, from)
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertCalendarEntriesToModels(entries), nil
+}
@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+	"booking-event/internal/modules/booking/repository/entity"
+)
+
+type CategoryRepository struct {
+	db *sqlx.DB
+}
+
+func NewCategoryRepository(db *sqlx.DB) *CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+func (r *CategoryRepository) ListCategories(ctx context.Context) ([]model.Category, error) {
+	var categories []entity.Category
+	err := r.db.SelectContext(ctx, &categories, "SELECT id, slug, name, description, created_at, updated_at FROM categories ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertCategoriesToModels(categories), nil
+}
+
+func (r *CategoryRepository) GetCategoriesBySlugs(ctx context.Context, slugs []model.EventCategory) ([]model.Category, error) {
+	var categories []entity.Category
+	err := r.db.SelectContext(ctx, &categories, "SELECT id, slug, name, description, created_at, updated_at FROM categories WHERE slug = ANY($1)", pq.Array(slugs))
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertCategoriesToModels(categories), nil
+}
+
+func (r *CategoryRepository) CreateCategory(ctx context.Context, category *model.Category) error {
+	return r.db.QueryRowxContext(ctx, "INSERT INTO categories (slug, name, description) VALUES ($1, $2, $3) RETURNING id, created_at, updated_at",
+		string(category.Slug), category.Name, category.Description).Scan(&category.ID, &category.CreatedAt, &category.UpdatedAt)
+}
+
+func (r *CategoryRepository) UpdateCategory(ctx context.Context, category *model.Category) error {
+	err := r.db.QueryRowxContext(ctx, "UPDATE categories SET slug = $1, name = $2, description = $3, updated_at = CURRENT_TIMESTAMP WHERE id = $4 RETURNING created_at, updated_at",
+		string(category.Slug), category.Name, category.Description, category.ID).Scan(&category.CreatedAt, &category.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return errors.ErrNotFound
+	}
+	return err
+}
+
+func (r *CategoryRepository) DeleteCategory(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM categories WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+	"booking-event/internal/modules/booking/repository/entity"
+)
+
+const salesStatsColumns = `e.id AS event_id, e.name AS event_name, e.start_at, e.currency,
+	s.tickets_sold, s.tickets_locked, s.tickets_cancelled, s.tickets_refunded, s.bookings_total, s.bookings_confirmed,
+	s.gross_revenue, s.net_revenue, s.refreshed_at`
+
+// SalesStatsRepository maintains and reads the aggregated sales tables backing the organizer dashboard.
+type SalesStatsRepository struct {
+	db *sqlx.DB
+}
+
+func NewSalesStatsRepository(db *sqlx.DB) *SalesStatsRepository {
+	return &SalesStatsRepository{db: db}
+}
+
+// GetLastRefreshedAt returns the time of the latest refresh, zero when the stats were never refreshed.
+func (r *SalesStatsRepository) GetLastRefreshedAt(ctx context.Context) (time.Time, error) {
+	var refreshedAt sql.NullTime
+	if err := r.db.GetContext(ctx, &refreshedAt, "SELECT MAX(refreshed_at) FROM event_sales_stats"); err != nil {
+		return time.Time{}, err
+	}
+	return refreshedAt.Time, nil
+}
+
+// RefreshSalesStats recomputes the stats of the events whose bookings or tokens changed since the
+// given time, plus the events still holding locked tickets since their locks expire silently.
+// It returns the number of refreshed events.
+func (r *SalesStatsRepository) RefreshSalesStats(ctx context.Context, since time.Time) (int, error) {
+	var eventIDs pq.Int64Array
+	err := r.db.GetContext(ctx, &eventIDs, `
+		SELECT COALESCE(array_agg(event_id), '{}') FROM (
+			SELECT event_id FROM bookings WHERE updated_at >= $1
+			UNION SELECT event_id FROM event_tokens WHERE updated_at >= $1
+			UNION SELECT event_id FROM event_sales_stats WHERE tickets_locked > 0
+		) AS touched`, since)
+	if err != nil {
+		return 0, err
+	}
+	if len(eventIDs) == 0 {
+		return 0, nil
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_sales_stats (event_id, tickets_sold, tickets_locked, tickets_cancelled, tickets_refunded,
+			bookings_total, bookings_confirmed, gross_revenue, net_revenue, currency, refreshed_at)
+		SELECT e.id,
+			COALESCE(b.tickets_sold, 0),
+			COALESCE(t.tickets_locked, 0),
+			COALESCE(b.tickets_cancelled, 0),
+			-- no flow refunds a booking yet, nothing is refunded and the net revenue is the gross revenue
+			0,
+			COALESCE(b.bookings_total, 0),
+			COALESCE(b.bookings_confirmed, 0),
+			COALESCE(b.gross_revenue, 0),
+			COALESCE(b.gross_revenue, 0),
+			e.currency,
+			CURRENT_TIMESTAMP
+		FROM events e
+		LEFT JOIN (
+			SELECT event_id,
+				SUM(quantity) FILTER (WHERE status IN ('confirmed', 'paid')) AS tickets_sold,
+				SUM(quantity) FILTER (WHERE status = 'canceled') AS tickets_cancelled,
+				-- the price of each booking is kept, changing the price of the event does not rewrite the past sales
+				SUM(quantity * unit_price) FILTER (WHERE status IN ('confirmed', 'paid')) AS gross_revenue,
+				COUNT(*) AS bookings_total,
+				COUNT(*) FILTER (WHERE confirmed_at IS NOT NULL) AS bookings_confirmed
+			FROM bookings WHERE event_id = ANY($1) GROUP BY event_id
+		) b ON b.event_id = e.id
+		LEFT JOIN (
+			SELECT event_id, COUNT(*) AS tickets_locked
+			FROM event_tokens WHERE event_id = ANY($1) AND status = 'locked' AND locked_until > CURRENT_TIMESTAMP
+			GROUP BY event_id
+		) t ON t.event_id = e.id
+		WHERE e.id = ANY($1)
+		ON CONFLICT (event_id) DO UPDATE SET
+			tickets_sold = EXCLUDED.tickets_sold,
+			tickets_locked = EXCLUDED.tickets_locked,
+			tickets_cancelled = EXCLUDED.tickets_cancelled,
+			tickets_refunded = EXCLUDED.tickets_refunded,
+			bookings_total = EXCLUDED.bookings_total,
+			bookings_confirmed = EXCLUDED.bookings_confirmed,
+			gross_revenue = EXCLUDED.gross_revenue,
+			net_revenue = EXCLUDED.net_revenue,
+			currency = EXCLUDED.currency,
+			refreshed_at = EXCLUDED.refreshed_at`, eventIDs)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	// cancelled bookings can empty a bucket, so the buckets are rebuilt rather than upserted
+	_, err = tx.ExecContext(ctx, "DELETE FROM event_sales_hourly WHERE event_id = ANY($1)", eventIDs)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_sales_hourly (event_id, bucket, tickets_sold, gross_revenue)
+		SELECT b.event_id, date_trunc('hour', b.confirmed_at), SUM(b.quantity), SUM(b.quantity * b.unit_price)
+		FROM bookings b
+		WHERE b.event_id = ANY($1) AND b.status IN ('confirmed', 'paid') AND b.confirmed_at IS NOT NULL
+		GROUP BY b.event_id, date_trunc('hour', b.confirmed_at)`, eventIDs)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(eventIDs), nil
+}
+
+func (r *SalesStatsRepository) GetEventSalesStats(ctx context.Context, eventID int) (*model.EventSalesStats, error) {
+	var stats entity.EventSalesStats
+	err := r.db.GetContext(ctx, &stats, "SELECT "+salesStatsColumns+" FROM events e LEFT JOIN event_sales_stats s ON s.event_id = e.id WHERE e.id = $1", eventID)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertEventSalesStatsToModel(stats), nil
+}
+
+func (r *SalesStatsRepository) ListEventSalesStats(ctx context.Context, query model.SalesStatsQuery) ([]model.EventSalesStats, error) {
+	stats := []entity.EventSalesStats{}
+	err := r.db.SelectContext(ctx, &stats, "SELECT "+salesStatsColumns+" FROM events e LEFT JOIN event_sales_stats s ON s.event_id = e.id WHERE e.creator_id = $1 ORDER BY e.start_at DESC, e.id DESC LIMIT $2 OFFSET $3",
+		query.CreatorID, query.Pagination.GetLimit(), query.Pagination.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertEventSalesStatsListToModels(stats), nil
+}
+
+func (r *SalesStatsRepository) GetSalesTimeSeries(ctx context.Context, query model.SalesTimeSeriesQuery) ([]model.SalesDataPoint, error) {
+	points := []entity.SalesDataPoint{}
+	err := r.db.SelectContext(ctx, &points, `
+		SELECT date_trunc($2, bucket) AS bucket, SUM(tickets_sold) AS tickets_sold, SUM(gross_revenue) AS gross_revenue
+		FROM event_sales_hourly
+		WHERE event_id = $1 AND bucket >= $3 AND bucket < $4
+		GROUP BY 1 ORDER BY 1`,
+		query.EventID, string(query.Granularity), query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertSalesDataPointsToModels(points), nil
+}
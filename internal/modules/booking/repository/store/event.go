@@ -5,59 +5,85 @@ import (
 	"database/sql"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"booking-event/internal/common/errors"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
 	postgresql "booking-event/internal/infra/posgresql"
 	"booking-event/internal/modules/booking/model"
 	"booking-event/internal/modules/booking/repository/entity"
 )
 
+const eventColumns = `id, name, available_seats, start_at, location, description, tags, min_age, price, currency, creator_id, status, review_status, latitude, longitude, sequence, reminder_minutes, created_at, updated_at,
+	ARRAY(SELECT c.slug FROM event_categories ec JOIN categories c ON c.id = ec.category_id WHERE ec.event_id = events.id ORDER BY c.slug) AS categories`
+
 type EventRepository struct {
-	db        *sqlx.DB
-	tokenRepo TokenRepositoryForEvent
+	db         *sqlx.DB
+	tokenRepo  TokenRepositoryForEvent
+	outboxRepo OutboxRepositoryForEvent
 }
 
 type TokenRepositoryForEvent interface {
 	CreateTokensTX(ctx context.Context, tx postgresql.ExecerContext, tokens []model.EventToken) error
 }
 
-func NewEventRepository(db *sqlx.DB, tokenRepo TokenRepositoryForEvent) *EventRepository {
-	return &EventRepository{db: db, tokenRepo: tokenRepo}
+type OutboxRepositoryForEvent interface {
+	CreateMessagesByTx(ctx context.Context, tx postgresql.ExecerContext, messages []model.OutboxMessage) error
+	CreateEventBookingMessagesByTx(ctx context.Context, tx postgresql.ExecerContext, taskType model.TaskType, eventID int, sequence int) error
 }
 
-func (r *EventRepository) CreateEvent(ctx context.Context, event model.Event, tokens []model.EventToken) error {
-	entityEvent := entity.ConvertEventToEntity(event)
+func NewEventRepository(db *sqlx.DB, tokenRepo TokenRepositoryForEvent, outboxRepo OutboxRepositoryForEvent) *EventRepository {
+	return &EventRepository{db: db, tokenRepo: tokenRepo, outboxRepo: outboxRepo}
+}
+
+func (r *EventRepository) CreateEvent(ctx context.Context, event *model.Event, tokens []model.EventToken) error {
+	entityEvent := entity.ConvertEventToEntity(*event)
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	err = tx.QueryRowxContext(ctx, "INSERT INTO events (name, available_seats, start_at, location, category, price, currency, creator_id, status) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id",
+	err = tx.QueryRowxContext(ctx, "INSERT INTO events (name, available_seats, start_at, location, description, tags, min_age, price, currency, creator_id, status, review_status, latitude, longitude, reminder_minutes) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) RETURNING id, created_at, updated_at",
 		entityEvent.Name,
 		entityEvent.AvailableSeats,
 		entityEvent.StartAt,
 		entityEvent.Location,
-		entityEvent.Category,
+		entityEvent.Description,
+		entityEvent.Tags,
+		entityEvent.MinAge,
 		entityEvent.Price,
 		entityEvent.Currency,
 		entityEvent.CreatorID,
-		entityEvent.Status).Scan(&entityEvent.ID)
+		entityEvent.Status,
+		entityEvent.ReviewStatus,
+		entityEvent.Latitude,
+		entityEvent.Longitude,
+		entityEvent.ReminderMinutes).Scan(&event.ID, &event.CreatedAt, &event.UpdatedAt)
 	if err != nil {
-		return tx.Rollback()
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO event_categories (event_id, category_id) SELECT $1, id FROM categories WHERE slug = ANY($2)", event.ID, entityEvent.Categories)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
 	}
 
 	for i := range tokens {
-		tokens[i].EventID = entityEvent.ID
+		tokens[i].EventID = event.ID
 	}
 	err = r.tokenRepo.CreateTokensTX(ctx, tx, tokens)
 	if err != nil {
-		return tx.Rollback()
+		_ = tx.Rollback()
+		return err
 	}
 	return tx.Commit()
 }
 
 func (r *EventRepository) GetEventByID(ctx context.Context, id int) (*model.Event, error) {
 	event := entity.Event{}
-	err := r.db.GetContext(ctx, &event, "SELECT id, name, available_seats, start_at, location, category, price, currency, creator_id, status, created_at, updated_at FROM events WHERE id = $1", id)
+	err := r.db.GetContext(ctx, &event, "SELECT "+eventColumns+" FROM events WHERE id = $1", id)
 	if err == sql.ErrNoRows {
 		return nil, errors.ErrNotFound
 	}
@@ -67,8 +93,22 @@ func (r *EventRepository) GetEventByID(ctx context.Context, id int) (*model.Even
 	return entity.ConvertEventToModel(event), nil
 }
 
-func (r *EventRepository) QueryEvents(ctx context.Context, query model.EventQuery) ([]model.Event, error) {
-	queryString := `SELECT id, name, available_seats, start_at, location, category, price, currency, creator_id, status, created_at, updated_at FROM events WHERE 1=1`
+// distanceExpr is the haversine distance in kilometers between the event venue and the searched location.
+const distanceExpr = `(2 * 6371 * ASIN(LEAST(1, SQRT(
+	POWER(SIN(RADIANS(latitude - :latitude) / 2), 2) +
+	COS(RADIANS(:latitude)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - :longitude) / 2), 2)))))`
+
+func (r *EventRepository) QueryEvents(ctx context.Context, query model.EventQuery) (*commonmodel.Page[model.Event], error) {
+	cursor, err := query.Pagination.GetCursor()
+	if err != nil {
+		return nil, err
+	}
+
+	selectColumns := eventColumns
+	if query.HasGeo() {
+		selectColumns += ", " + distanceExpr + " AS distance"
+	}
+	queryString := `SELECT ` + selectColumns + ` FROM events WHERE 1=1`
 
 	if query.ID != 0 {
 		queryString += " AND id = :id"
@@ -80,7 +120,13 @@ func (r *EventRepository) QueryEvents(ctx context.Context, query model.EventQuer
 		queryString += " AND location = :location"
 	}
 	if query.Category != "" {
-		queryString += " AND category = :category"
+		queryString += " AND EXISTS (SELECT 1 FROM event_categories ec JOIN categories c ON c.id = ec.category_id WHERE ec.event_id = events.id AND c.slug = :category)"
+	}
+	if len(query.Tags) > 0 {
+		queryString += " AND tags && :tags"
+	}
+	if query.ReviewStatus != "" {
+		queryString += " AND review_status = :review_status"
 	}
 	if !query.StartFrom.IsZero() {
 		queryString += " AND start_at >= :start_from"
@@ -88,26 +134,103 @@ func (r *EventRepository) QueryEvents(ctx context.Context, query model.EventQuer
 	if !query.StartTo.IsZero() {
 		queryString += " AND start_at <= :start_to"
 	}
-	queryString += ` ORDER BY updated_at DESC`
 
-	if query.Pagination.Limit > 0 {
-		queryString += ` LIMIT :limit`
+	limit := query.Pagination.GetLimit()
+	args := map[string]interface{}{
+		"id":            query.ID,
+		"limit":         limit,
+		"offset":        query.Pagination.GetOffset(),
+		"name":          "%" + query.Name + "%",
+		"location":      query.Location,
+		"category":      query.Category,
+		"tags":          pq.Array(query.Tags),
+		"review_status": string(query.ReviewStatus),
+		"start_from":    query.StartFrom,
+		"start_to":      query.StartTo,
 	}
-	if query.Pagination.Page > 0 {
-		queryString += ` OFFSET :offset`
+
+	// keyset pagination walks (sort value, id) pairs, backward pages are read in
+	// reverse order and flipped back by NewCursorPage
+	useCursor := query.Pagination.UseCursor()
+	backward := cursor != nil && cursor.Backward
+	if cursor != nil {
+		args["cursor_id"] = cursor.ID
+	}
+
+	if query.HasGeo() {
+		// the bounding box lets postgres use the latitude/longitude index before computing exact distances
+		box := util.NewBoundingBox(*query.Latitude, *query.Longitude, query.RadiusKm)
+		queryString += " AND latitude BETWEEN :min_lat AND :max_lat"
+		if box.CrossesAntimeridian() {
+			queryString += " AND (longitude >= :min_lng OR longitude <= :max_lng)"
+		} else {
+			queryString += " AND longitude BETWEEN :min_lng AND :max_lng"
+		}
+		queryString = `SELECT * FROM (` + queryString + `) AS nearby_events WHERE distance <= :radius_km`
+
+		args["latitude"] = *query.Latitude
+		args["longitude"] = *query.Longitude
+		args["radius_km"] = query.RadiusKm
+		args["min_lat"] = box.MinLat
+		args["max_lat"] = box.MaxLat
+		args["min_lng"] = box.MinLng
+		args["max_lng"] = box.MaxLng
+
+		if cursor != nil {
+			if cursor.Number == nil {
+				return nil, commonmodel.ErrInvalidCursor
+			}
+			args["cursor_value"] = *cursor.Number
+			if backward {
+				queryString += " AND (distance, id) < (:cursor_value, :cursor_id)"
+			} else {
+				queryString += " AND (distance, id) > (:cursor_value, :cursor_id)"
+			}
+		}
+		if backward {
+			queryString += ` ORDER BY distance DESC, id DESC`
+		} else {
+			queryString += ` ORDER BY distance ASC, id ASC`
+		}
+	} else {
+		if cursor != nil {
+			if cursor.Time == nil {
+				return nil, commonmodel.ErrInvalidCursor
+			}
+			args["cursor_value"] = *cursor.Time
+			if backward {
+				queryString += " AND (created_at, id) > (:cursor_value, :cursor_id)"
+			} else {
+				queryString += " AND (created_at, id) < (:cursor_value, :cursor_id)"
+			}
+		}
+		// created_at never changes, an event edited while the list is scrolled keeps its place. The numbered pages
+		// keep listing the last changed events first.
+		switch {
+		case !useCursor:
+			queryString += ` ORDER BY updated_at DESC, id DESC`
+		case backward:
+			queryString += ` ORDER BY created_at ASC, id ASC`
+		default:
+			queryString += ` ORDER BY created_at DESC, id DESC`
+		}
+	}
+
+	if useCursor {
+		// one more row than requested tells whether there is a next page
+		args["limit"] = limit + 1
+		queryString += ` LIMIT :limit`
+	} else {
+		if query.Pagination.Limit > 0 {
+			queryString += ` LIMIT :limit`
+		}
+		if query.Pagination.Page > 0 {
+			queryString += ` OFFSET :offset`
+		}
 	}
 
 	events := []entity.Event{}
-	rows, err := r.db.NamedQueryContext(ctx, queryString, map[string]interface{}{
-		"id":         query.ID,
-		"limit":      query.Pagination.GetLimit(),
-		"offset":     query.Pagination.GetOffset(),
-		"name":       "%" + query.Name + "%",
-		"location":   query.Location,
-		"category":   query.Category,
-		"start_from": query.StartFrom,
-		"start_to":   query.StartTo,
-	})
+	rows, err := r.db.NamedQueryContext(ctx, queryString, args)
 	if err != nil {
 		return nil, err
 	}
@@ -121,11 +244,45 @@ func (r *EventRepository) QueryEvents(ctx context.Context, query model.EventQuer
 		events = append(events, event)
 	}
 
-	return entity.ConvertEventsToModels(events), nil
+	models := entity.ConvertEventsToModels(events)
+	if !useCursor {
+		return &commonmodel.Page[model.Event]{Items: models}, nil
+	}
+	return commonmodel.NewCursorPage(models, limit, cursor, func(event model.Event) commonmodel.Cursor {
+		if query.HasGeo() {
+			return commonmodel.Cursor{Number: event.DistanceKm, ID: event.ID}
+		}
+		return commonmodel.Cursor{Time: util.ToPtr(event.CreatedAt), ID: event.ID}
+	}), nil
 }
 
-func (r *EventRepository) UpdateEvent(ctx context.Context, event model.Event) error {
+const updateEventQuery = `UPDATE events SET status = :status, start_at = :start_at, reminder_minutes = :reminder_minutes, sequence = :sequence,
+	updated_at = CURRENT_TIMESTAMP WHERE id = :id`
+
+// UpdateEventAndNotifyBookings updates the event and writes the messages, and a task of each type for each of its
+// confirmed or paid bookings, to the outbox in the same transaction.
+func (r *EventRepository) UpdateEventAndNotifyBookings(ctx context.Context, event model.Event, taskTypes []model.TaskType, messages []model.OutboxMessage) error {
 	entityEvent := entity.ConvertEventToEntity(event)
-	_, err := r.db.NamedExecContext(ctx, "UPDATE events SET status = :status, updated_at = CURRENT_TIMESTAMP WHERE id = :id", entityEvent)
-	return err
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	_, err = tx.NamedExecContext(ctx, updateEventQuery, entityEvent)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	for _, taskType := range taskTypes {
+		err = r.outboxRepo.CreateEventBookingMessagesByTx(ctx, tx, taskType, event.ID, event.Sequence)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	err = r.outboxRepo.CreateMessagesByTx(ctx, tx, messages)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
 }
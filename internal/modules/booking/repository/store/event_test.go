@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestEventRepository_QueryEvents_UpdatedWhileScrolling(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	repo := NewEventRepository(db, nil, nil)
+
+	name := fmt.Sprintf("keyset-%d", time.Now().UnixNano())
+	createdAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	var ids []int
+	for i := 0; i < 4; i++ {
+		var id int
+		err := db.QueryRowxContext(ctx, `INSERT INTO events (name, available_seats, start_at, location, category, status, price, currency, creator_id, created_at, updated_at)
+			VALUES ($1, 10, $2, 'Hanoi', 'music', 'active', 1000, 'USD', 1, $2, $2) RETURNING id`,
+			name, createdAt.Add(time.Duration(i)*time.Minute)).Scan(&id)
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	t.Cleanup(func() { _, _ = db.ExecContext(ctx, "DELETE FROM events WHERE name = $1", name) })
+
+	query := model.EventQuery{Name: name, Pagination: commonmodel.Pagination{Limit: 2}}
+	first, err := repo.QueryEvents(ctx, query)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{ids[3], ids[2]}, eventIDs(first.Items))
+
+	// editing events of both pages between the fetches moves neither
+	_, err = db.ExecContext(ctx, "UPDATE events SET updated_at = CURRENT_TIMESTAMP WHERE id = ANY($1)", fmt.Sprintf("{%d,%d}", ids[0], ids[3]))
+	assert.NoError(t, err)
+
+	query.Pagination.Cursor = first.NextCursor
+	second, err := repo.QueryEvents(ctx, query)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{ids[1], ids[0]}, eventIDs(second.Items))
+	assert.Empty(t, second.NextCursor)
+}
+
+func TestEventRepository_QueryEvents_PagesByLastChange(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	repo := NewEventRepository(db, nil, nil)
+
+	name := fmt.Sprintf("offset-%d", time.Now().UnixNano())
+	createdAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	var ids []int
+	for i := 0; i < 3; i++ {
+		var id int
+		err := db.QueryRowxContext(ctx, `INSERT INTO events (name, available_seats, start_at, location, category, status, price, currency, creator_id, created_at, updated_at)
+			VALUES ($1, 10, $2, 'Hanoi', 'music', 'active', 1000, 'USD', 1, $2, $2) RETURNING id`,
+			name, createdAt.Add(time.Duration(i)*time.Minute)).Scan(&id)
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	t.Cleanup(func() { _, _ = db.ExecContext(ctx, "DELETE FROM events WHERE name = $1", name) })
+
+	// the oldest event was edited last, the numbered pages list it first
+	_, err := db.ExecContext(ctx, "UPDATE events SET updated_at = CURRENT_TIMESTAMP WHERE id = $1", ids[0])
+	assert.NoError(t, err)
+
+	page, err := repo.QueryEvents(ctx, model.EventQuery{Name: name, Pagination: commonmodel.Pagination{Page: 1, Limit: 3}})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{ids[0], ids[2], ids[1]}, eventIDs(page.Items))
+}
+
+func eventIDs(events []model.Event) []int {
+	ids := make([]int, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
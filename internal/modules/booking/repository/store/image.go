@@ -0,0 +1,51 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+
+	"booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+	"booking-event/internal/modules/booking/repository/entity"
+)
+
+type EventImageRepository struct {
+	db *sqlx.DB
+}
+
+func NewEventImageRepository(db *sqlx.DB) *EventImageRepository {
+	return &EventImageRepository{db: db}
+}
+
+func (r *EventImageRepository) CreateEventImage(ctx context.Context, image *model.EventImage) error {
+	return r.db.QueryRowxContext(ctx, "INSERT INTO event_images (event_id, storage_key, url, content_type, size) VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at",
+		image.EventID, image.StorageKey, image.URL, image.ContentType, image.Size).Scan(&image.ID, &image.CreatedAt)
+}
+
+func (r *EventImageRepository) GetEventImagesByEventID(ctx context.Context, eventID int) ([]model.EventImage, error) {
+	var images []entity.EventImage
+	err := r.db.SelectContext(ctx, &images, "SELECT id, event_id, storage_key, url, content_type, size, created_at FROM event_images WHERE event_id = $1 ORDER BY id", eventID)
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertEventImagesToModels(images), nil
+}
+
+func (r *EventImageRepository) GetEventImageByID(ctx context.Context, id int) (*model.EventImage, error) {
+	var image entity.EventImage
+	err := r.db.GetContext(ctx, &image, "SELECT id, event_id, storage_key, url, content_type, size, created_at FROM event_images WHERE id = $1", id)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertEventImageToModel(image), nil
+}
+
+func (r *EventImageRepository) DeleteEventImage(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM event_images WHERE id = $1", id)
+	return err
+}
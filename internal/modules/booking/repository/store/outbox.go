@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	postgresql "booking-event/internal/infra/posgresql"
+	"booking-event/internal/modules/booking/model"
+	"booking-event/internal/modules/booking/repository/entity"
+)
+
+const outboxColumns = "id, task_type, payload, dedup_key, process_at, attempts, last_error, next_attempt_at, published_at, failed_at, created_at"
+
+type OutboxRepository struct {
+	db *sqlx.DB
+}
+
+func NewOutboxRepository(db *sqlx.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// CreateMessagesByTx writes the messages in the transaction of the change they announce. A message whose dedup
+// key was already written is skipped.
+func (r *OutboxRepository) CreateMessagesByTx(ctx context.Context, tx postgresql.ExecerContext, messages []model.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	entities := make([]entity.OutboxMessage, 0, len(messages))
+	for _, message := range messages {
+		entities = append(entities, entity.ConvertOutboxMessageToEntity(message))
+	}
+	_, err := tx.NamedExecContext(ctx, `INSERT INTO outbox (task_type, payload, dedup_key, process_at)
+		VALUES (:task_type, :payload, :dedup_key, :process_at)
+		ON CONFLICT (dedup_key) DO NOTHING`, entities)
+	return err
+}
+
+// CreateEventBookingMessagesByTx writes a task of the type for each confirmed or paid booking of the event, in the
+// transaction of the change of the event. The payload, a booking_id and the sequence of the change like
+// SendEventChangeEmailTask and SyncRemindersTask, is built here so the bookings never leave the database.
+func (r *OutboxRepository) CreateEventBookingMessagesByTx(ctx context.Context, tx postgresql.ExecerContext, taskType model.TaskType, eventID int, sequence int) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO outbox (task_type, payload, dedup_key)
+		SELECT $1, jsonb_build_object('booking_id', id, 'sequence', $3::INTEGER), 'booking:' || id || ':' || $1 || ':' || $3::INTEGER
+		FROM bookings WHERE event_id = $2 AND status IN ($4, $5)
+		ON CONFLICT (dedup_key) DO NOTHING`,
+		taskType, eventID, sequence, model.BookingStatusConfirmed, model.BookingStatusPaid)
+	return err
+}
+
+// ClaimPendingMessages returns the messages due for publishing, oldest first, and leases them until leaseUntil by
+// moving their next attempt: the relays running at the same time skip the locked rows and the leased ones, so a
+// message is only handed to one of them. A relay dying before recording the publishing leaves the message to be
+// claimed again once the lease is over.
+func (r *OutboxRepository) ClaimPendingMessages(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]model.OutboxMessage, error) {
+	var entities []entity.OutboxMessage
+	err := r.db.SelectContext(ctx, &entities, `WITH claimed AS (
+			UPDATE outbox SET next_attempt_at = $2 WHERE id IN (
+				SELECT id FROM outbox
+				WHERE published_at IS NULL AND failed_at IS NULL AND next_attempt_at <= $1
+				ORDER BY next_attempt_at, id LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING `+outboxColumns+`
+		)
+		SELECT `+outboxColumns+` FROM claimed ORDER BY id`, now, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]model.OutboxMessage, 0, len(entities))
+	for _, message := range entities {
+		messages = append(messages, entity.ConvertOutboxMessageToModel(message))
+	}
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkMessagePublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE outbox SET published_at = $2, attempts = attempts + 1 WHERE id = $1", id, publishedAt)
+	return err
+}
+
+// MarkMessageFailed records a failed publishing. The message is retried at nextAttemptAt, or given up when it is
+// nil.
+func (r *OutboxRepository) MarkMessageFailed(ctx context.Context, id int64, lastError string, failedAt time.Time, nextAttemptAt *time.Time) error {
+	if nextAttemptAt == nil {
+		_, err := r.db.ExecContext(ctx, "UPDATE outbox SET attempts = attempts + 1, last_error = $2, failed_at = $3 WHERE id = $1", id, lastError, failedAt)
+		return err
+	}
+	_, err := r.db.ExecContext(ctx, "UPDATE outbox SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1", id, lastError, *nextAttemptAt)
+	return err
+}
@@ -0,0 +1,87 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	postgresql "booking-event/internal/infra/posgresql"
+	"booking-event/internal/modules/booking/model"
+)
+
+type ReminderRepository struct {
+	db         *sqlx.DB
+	outboxRepo OutboxRepositoryForReminder
+}
+
+type OutboxRepositoryForReminder interface {
+	CreateMessagesByTx(ctx context.Context, tx postgresql.ExecerContext, messages []model.OutboxMessage) error
+}
+
+func NewReminderRepository(db *sqlx.DB, outboxRepo OutboxRepositoryForReminder) *ReminderRepository {
+	return &ReminderRepository{db: db, outboxRepo: outboxRepo}
+}
+
+// CreateRemindersByTx tracks the reminders written to the outbox in the same transaction.
+func (r *ReminderRepository) CreateRemindersByTx(ctx context.Context, tx postgresql.ExecerContext, reminders []model.BookingReminder) error {
+	for _, reminder := range reminders {
+		_, err := tx.ExecContext(ctx, "INSERT INTO booking_reminders (task_id, booking_id, remind_at) VALUES ($1, $2, $3) ON CONFLICT (task_id) DO NOTHING",
+			reminder.TaskID, reminder.BookingID, reminder.RemindAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReplaceReminders cancels the reminders of the booking still ahead at now and not among the new ones, then writes
+// the new ones with their messages. The messages of the canceled reminders not published yet are given up, the
+// ids of the canceled tasks are returned for deleting them from the queue.
+func (r *ReminderRepository) ReplaceReminders(ctx context.Context, bookingID int, messages []model.OutboxMessage, reminders []model.BookingReminder, now time.Time) ([]string, error) {
+	keep := make(pq.StringArray, 0, len(reminders))
+	for _, reminder := range reminders {
+		keep = append(keep, reminder.TaskID)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	canceled := []string{}
+	err = tx.SelectContext(ctx, &canceled, `UPDATE booking_reminders SET canceled_at = $2
+		WHERE booking_id = $1 AND canceled_at IS NULL AND remind_at > $2 AND NOT (task_id = ANY($3))
+		RETURNING task_id`, bookingID, now, keep)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if len(canceled) > 0 {
+		_, err = tx.ExecContext(ctx, `UPDATE outbox SET failed_at = $2, last_error = 'reminder canceled'
+			WHERE dedup_key = ANY($1) AND published_at IS NULL AND failed_at IS NULL`, pq.StringArray(canceled), now)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = r.outboxRepo.CreateMessagesByTx(ctx, tx, messages)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	err = r.CreateRemindersByTx(ctx, tx, reminders)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return canceled, nil
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"booking-event/internal/modules/booking/model"
+	"booking-event/internal/modules/booking/repository/entity"
+)
+
+type EventReviewRepository struct {
+	db *sqlx.DB
+}
+
+func NewEventReviewRepository(db *sqlx.DB) *EventReviewRepository {
+	return &EventReviewRepository{db: db}
+}
+
+// TransitionReviewStatus moves the event from review.FromStatus to review.ToStatus and records the
+// transition in the history. It fails with ErrInvalidReviewTransition when the event is no longer
+// in review.FromStatus, e.g. when two admins review the same event concurrently.
+func (r *EventReviewRepository) TransitionReviewStatus(ctx context.Context, review *model.EventReview) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, "UPDATE events SET review_status = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2 AND review_status = $3",
+		string(review.ToStatus), review.EventID, string(review.FromStatus))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if affected == 0 {
+		_ = tx.Rollback()
+		return model.ErrInvalidReviewTransition
+	}
+
+	err = tx.QueryRowxContext(ctx, "INSERT INTO event_review_history (event_id, from_status, to_status, actor_id, reason) VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at",
+		review.EventID, string(review.FromStatus), string(review.ToStatus), review.ActorID, review.Reason).Scan(&review.ID, &review.CreatedAt)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *EventReviewRepository) GetEventReviews(ctx context.Context, eventID int) ([]model.EventReview, error) {
+	reviews := []entity.EventReview{}
+	err := r.db.SelectContext(ctx, &reviews, "SELECT id, event_id, from_status, to_status, actor_id, reason, created_at FROM event_review_history WHERE event_id = $1 ORDER BY id", eventID)
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertEventReviewsToModels(reviews), nil
+}
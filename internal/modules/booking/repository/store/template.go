@@ -0,0 +1,66 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/jmoiron/sqlx"
+
+	"booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+	"booking-event/internal/modules/booking/repository/entity"
+)
+
+type EventTemplateRepository struct {
+	db *sqlx.DB
+}
+
+func NewEventTemplateRepository(db *sqlx.DB) *EventTemplateRepository {
+	return &EventTemplateRepository{db: db}
+}
+
+func (r *EventTemplateRepository) CreateEventTemplate(ctx context.Context, template *model.EventTemplate) error {
+	content, err := json.Marshal(template.Content)
+	if err != nil {
+		return err
+	}
+	return r.db.QueryRowxContext(ctx, "INSERT INTO event_templates (creator_id, name, content) VALUES ($1, $2, $3) RETURNING id, created_at, updated_at",
+		template.CreatorID, template.Name, content).Scan(&template.ID, &template.CreatedAt, &template.UpdatedAt)
+}
+
+func (r *EventTemplateRepository) GetEventTemplateByID(ctx context.Context, id int) (*model.EventTemplate, error) {
+	var template entity.EventTemplate
+	err := r.db.GetContext(ctx, &template, "SELECT id, creator_id, name, content, created_at, updated_at FROM event_templates WHERE id = $1", id)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertEventTemplateToModel(template)
+}
+
+func (r *EventTemplateRepository) ListEventTemplates(ctx context.Context, creatorID int) ([]model.EventTemplate, error) {
+	var templates []entity.EventTemplate
+	err := r.db.SelectContext(ctx, &templates, "SELECT id, creator_id, name, content, created_at, updated_at FROM event_templates WHERE creator_id = $1 ORDER BY name, id", creatorID)
+	if err != nil {
+		return nil, err
+	}
+	return entity.ConvertEventTemplatesToModels(templates)
+}
+
+func (r *EventTemplateRepository) DeleteEventTemplate(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM event_templates WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.ErrNotFound
+	}
+	return nil
+}
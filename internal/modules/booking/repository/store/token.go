@@ -98,8 +98,12 @@ func (r *TokenRepository) ConfirmUsedTokensByTx(ctx context.Context, tx postgres
 	if len(tokens) == 0 {
 		return nil
 	}
+	values := make([]string, len(tokens))
+	for i, token := range tokens {
+		values[i] = token.Token
+	}
 	_, err := tx.NamedExecContext(ctx, "UPDATE event_tokens SET status = :status, holder_id = :holder_id, updated_at = CURRENT_TIMESTAMP WHERE token = ANY(:tokens)", map[string]interface{}{
-		"tokens":    pq.Array(tokens),
+		"tokens":    pq.Array(values),
 		"status":    string(model.TokenStatusUsed),
 		"holder_id": tokens[0].HolderID,
 	})
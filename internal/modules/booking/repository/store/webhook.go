@@ -0,0 +1,344 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"booking-event/internal/common/errors"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	postgresql "booking-event/internal/infra/posgresql"
+	"booking-event/internal/modules/booking/model"
+	"booking-event/internal/modules/booking/repository/entity"
+)
+
+const (
+	webhookSubscriptionColumns = "id, owner_id, service_account_id, url, secret, event_types, consecutive_failures, disabled_at, created_at, updated_at"
+	webhookDeliveryColumns     = "id, subscription_id, webhook_event_id, event_type, payload, status, attempts, failures, next_attempt_at, delivered_at, created_at, updated_at"
+)
+
+type OutboxRepositoryForWebhook interface {
+	CreateMessagesByTx(ctx context.Context, tx postgresql.ExecerContext, messages []model.OutboxMessage) error
+}
+
+type WebhookRepository struct {
+	db         *sqlx.DB
+	outboxRepo OutboxRepositoryForWebhook
+}
+
+func NewWebhookRepository(db *sqlx.DB, outboxRepo OutboxRepositoryForWebhook) *WebhookRepository {
+	return &WebhookRepository{db: db, outboxRepo: outboxRepo}
+}
+
+func (r *WebhookRepository) CreateWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) error {
+	entitySubscription := entity.ConvertWebhookSubscriptionToEntity(*subscription)
+	return r.db.QueryRowxContext(ctx, `INSERT INTO webhook_subscriptions (owner_id, service_account_id, url, secret, event_types)
+		VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at, updated_at`,
+		entitySubscription.OwnerID, entitySubscription.ServiceAccountID, entitySubscription.URL, entitySubscription.Secret, entitySubscription.EventTypes,
+	).Scan(&subscription.ID, &subscription.CreatedAt, &subscription.UpdatedAt)
+}
+
+func (r *WebhookRepository) GetWebhookSubscriptionByID(ctx context.Context, id int) (*model.WebhookSubscription, error) {
+	var subscription entity.WebhookSubscription
+	err := r.db.GetContext(ctx, &subscription, "SELECT "+webhookSubscriptionColumns+" FROM webhook_subscriptions WHERE id = $1", id)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return util.ToPtr(entity.ConvertWebhookSubscriptionToModel(subscription)), nil
+}
+
+// ListWebhookSubscriptions lists the subscriptions of the owner, only those of the service account when it is not 0.
+func (r *WebhookRepository) ListWebhookSubscriptions(ctx context.Context, ownerID int, serviceAccountID int) ([]model.WebhookSubscription, error) {
+	var entities []entity.WebhookSubscription
+	err := r.db.SelectContext(ctx, &entities, "SELECT "+webhookSubscriptionColumns+` FROM webhook_subscriptions
+		WHERE owner_id = $1 AND ($2 = 0 OR service_account_id = $2) ORDER BY id`, ownerID, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions := make([]model.WebhookSubscription, 0, len(entities))
+	for _, subscription := range entities {
+		subscriptions = append(subscriptions, entity.ConvertWebhookSubscriptionToModel(subscription))
+	}
+	return subscriptions, nil
+}
+
+// UpdateWebhookSubscription saves the subscription, the pending deliveries of a disabled one are failed with it.
+func (r *WebhookRepository) UpdateWebhookSubscription(ctx context.Context, subscription model.WebhookSubscription) error {
+	entitySubscription := entity.ConvertWebhookSubscriptionToEntity(subscription)
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	_, err = tx.NamedExecContext(ctx, `UPDATE webhook_subscriptions SET url = :url, event_types = :event_types,
+		consecutive_failures = :consecutive_failures, disabled_at = :disabled_at, updated_at = CURRENT_TIMESTAMP WHERE id = :id`, entitySubscription)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if subscription.DisabledAt != nil {
+		if err := failPendingWebhookDeliveriesByTx(ctx, tx, subscription.ID, *subscription.DisabledAt); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *WebhookRepository) DeleteWebhookSubscription(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM webhook_subscriptions WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.ErrNotFound
+	}
+	return nil
+}
+
+// CreateWebhookDeliveries creates a delivery of the webhook event for each enabled subscription of the organizer of
+// the event taking its type, with the message of its first attempt. A subscription already given the webhook event
+// is skipped. The subscription of a service account is only delivered while the account belongs to the organizer
+// and has a usable API key, an integration whose keys were all revoked or expired gets nothing.
+func (r *WebhookRepository) CreateWebhookDeliveries(ctx context.Context, eventID int, webhookEvent model.WebhookEvent, payload []byte) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	var deliveryIDs []int64
+	err = tx.SelectContext(ctx, &deliveryIDs, `INSERT INTO webhook_deliveries (subscription_id, webhook_event_id, event_type, payload)
+		SELECT s.id, $2, $3, $4::JSONB FROM webhook_subscriptions s JOIN events e ON e.creator_id = s.owner_id
+		LEFT JOIN service_accounts sa ON sa.id = s.service_account_id
+		WHERE e.id = $1 AND s.disabled_at IS NULL AND $3 = ANY(s.event_types)
+			AND (s.service_account_id IS NULL OR (sa.owner_id = e.creator_id AND EXISTS (
+				SELECT 1 FROM api_keys k WHERE k.service_account_id = sa.id AND k.revoked_at IS NULL
+					AND (k.expires_at IS NULL OR k.expires_at > CURRENT_TIMESTAMP))))
+		ON CONFLICT (subscription_id, webhook_event_id) DO NOTHING
+		RETURNING id`, eventID, webhookEvent.ID, string(webhookEvent.Type), string(payload))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	messages := make([]model.OutboxMessage, 0, len(deliveryIDs))
+	for _, deliveryID := range deliveryIDs {
+		message, err := model.NewDeliverWebhookMessage(deliveryID, 1, nil)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		messages = append(messages, *message)
+	}
+	if err := r.outboxRepo.CreateMessagesByTx(ctx, tx, messages); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *WebhookRepository) GetWebhookDeliveryByID(ctx context.Context, id int64) (*model.WebhookDelivery, error) {
+	var delivery entity.WebhookDelivery
+	err := r.db.GetContext(ctx, &delivery, "SELECT "+webhookDeliveryColumns+" FROM webhook_deliveries WHERE id = $1", id)
+	if err == sql.ErrNoRows {
+		return nil, errors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return util.ToPtr(entity.ConvertWebhookDeliveryToModel(delivery)), nil
+}
+
+// ListWebhookDeliveries lists the deliveries of the subscription, newest first.
+func (r *WebhookRepository) ListWebhookDeliveries(ctx context.Context, query model.WebhookDeliveryQuery) (*commonmodel.Page[model.WebhookDelivery], error) {
+	cursor, err := query.Pagination.GetCursor()
+	if err != nil {
+		return nil, err
+	}
+
+	queryString := "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries WHERE subscription_id = :subscription_id"
+	if query.Status != "" {
+		queryString += " AND status = :status"
+	}
+
+	limit := query.Pagination.GetLimit()
+	args := map[string]interface{}{
+		"subscription_id": query.SubscriptionID,
+		"status":          string(query.Status),
+		"limit":           limit,
+		"offset":          query.Pagination.GetOffset(),
+	}
+
+	useCursor := query.Pagination.UseCursor()
+	backward := cursor != nil && cursor.Backward
+	if cursor != nil {
+		if cursor.Time == nil {
+			return nil, commonmodel.ErrInvalidCursor
+		}
+		args["cursor_value"] = *cursor.Time
+		args["cursor_id"] = cursor.ID
+		if backward {
+			queryString += " AND (created_at, id) > (:cursor_value, :cursor_id)"
+		} else {
+			queryString += " AND (created_at, id) < (:cursor_value, :cursor_id)"
+		}
+	}
+	if backward {
+		queryString += " ORDER BY created_at ASC, id ASC"
+	} else {
+		queryString += " ORDER BY created_at DESC, id DESC"
+	}
+	if useCursor {
+		args["limit"] = limit + 1
+		queryString += " LIMIT :limit"
+	} else {
+		queryString += " LIMIT :limit OFFSET :offset"
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, queryString, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	deliveries := []model.WebhookDelivery{}
+	for rows.Next() {
+		var delivery entity.WebhookDelivery
+		if err := rows.StructScan(&delivery); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, entity.ConvertWebhookDeliveryToModel(delivery))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if !useCursor {
+		return &commonmodel.Page[model.WebhookDelivery]{Items: deliveries}, nil
+	}
+	return commonmodel.NewCursorPage(deliveries, limit, cursor, func(delivery model.WebhookDelivery) commonmodel.Cursor {
+		return commonmodel.Cursor{Time: util.ToPtr(delivery.CreatedAt), ID: int(delivery.ID)}
+	}), nil
+}
+
+func (r *WebhookRepository) ListWebhookDeliveryAttempts(ctx context.Context, deliveryID int64) ([]model.WebhookDeliveryAttempt, error) {
+	var entities []entity.WebhookDeliveryAttempt
+	err := r.db.SelectContext(ctx, &entities, `SELECT id, delivery_id, attempt, status_code, error, response_body, duration_ms, created_at
+		FROM webhook_delivery_attempts WHERE delivery_id = $1 ORDER BY attempt`, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	attempts := make([]model.WebhookDeliveryAttempt, 0, len(entities))
+	for _, attempt := range entities {
+		attempts = append(attempts, entity.ConvertWebhookDeliveryAttemptToModel(attempt))
+	}
+	return attempts, nil
+}
+
+// RecordWebhookDeliveryAttempt logs the attempt and moves the delivery to the state of the outcome, writing the
+// message of the retry when there is one. A delivery given up counts as a failure of its subscription, which is
+// disabled after disableAfter of them in a row. An attempt already recorded, by a task published twice, is
+// ignored.
+func (r *WebhookRepository) RecordWebhookDeliveryAttempt(ctx context.Context, outcome model.WebhookDeliveryOutcome, disableAfter int) error {
+	attempt := outcome.Attempt
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var subscriptionID int
+	err = tx.QueryRowxContext(ctx, `UPDATE webhook_deliveries SET status = $2, attempts = $3, next_attempt_at = $4,
+		failures = CASE WHEN $2 = 'succeeded' THEN failures ELSE failures + 1 END,
+		delivered_at = CASE WHEN $2 = 'succeeded' THEN $5 ELSE delivered_at END, updated_at = $5
+		WHERE id = $1 AND status = 'pending' AND attempts = $3 - 1
+		RETURNING subscription_id`,
+		attempt.DeliveryID, string(outcome.Status), attempt.Attempt, outcome.NextAttemptAt, attempt.CreatedAt,
+	).Scan(&subscriptionID)
+	if err == sql.ErrNoRows {
+		return tx.Rollback()
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO webhook_delivery_attempts (delivery_id, attempt, status_code, error, response_body, duration_ms, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		attempt.DeliveryID, attempt.Attempt, attempt.StatusCode, attempt.Error, attempt.ResponseBody, attempt.DurationMs, attempt.CreatedAt)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	switch outcome.Status {
+	case model.WebhookDeliveryStatusSucceeded:
+		_, err = tx.ExecContext(ctx, "UPDATE webhook_subscriptions SET consecutive_failures = 0 WHERE id = $1 AND consecutive_failures > 0", subscriptionID)
+	case model.WebhookDeliveryStatusFailed:
+		err = r.countWebhookFailureByTx(ctx, tx, subscriptionID, disableAfter, attempt.CreatedAt)
+	default:
+		err = r.outboxRepo.CreateMessagesByTx(ctx, tx, []model.OutboxMessage{*outcome.Retry})
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *WebhookRepository) countWebhookFailureByTx(ctx context.Context, tx *sqlx.Tx, subscriptionID int, disableAfter int, now time.Time) error {
+	var disabledAt sql.NullTime
+	err := tx.QueryRowxContext(ctx, `UPDATE webhook_subscriptions SET consecutive_failures = consecutive_failures + 1,
+		disabled_at = CASE WHEN disabled_at IS NULL AND $2 > 0 AND consecutive_failures + 1 >= $2 THEN $3 ELSE disabled_at END
+		WHERE id = $1 RETURNING disabled_at`, subscriptionID, disableAfter, now).Scan(&disabledAt)
+	if err != nil {
+		return err
+	}
+	if !disabledAt.Valid {
+		return nil
+	}
+	return failPendingWebhookDeliveriesByTx(ctx, tx, subscriptionID, now)
+}
+
+// ReplayWebhookDelivery sends the delivery again with a new attempt, its failures are reset so it gets every retry
+// again. A delivery still pending is not replayed.
+func (r *WebhookRepository) ReplayWebhookDelivery(ctx context.Context, deliveryID int64, message model.OutboxMessage, now time.Time) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx, `UPDATE webhook_deliveries SET status = 'pending', failures = 0, next_attempt_at = $2, updated_at = $2
+		WHERE id = $1 AND status <> 'pending'`, deliveryID, now)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if affected == 0 {
+		_ = tx.Rollback()
+		return model.ErrWebhookDeliveryPending
+	}
+	if err := r.outboxRepo.CreateMessagesByTx(ctx, tx, []model.OutboxMessage{message}); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// failPendingWebhookDeliveriesByTx gives up the deliveries of a disabled subscription, their queued attempts are
+// skipped when they run.
+func failPendingWebhookDeliveriesByTx(ctx context.Context, tx postgresql.ExecerContext, subscriptionID int, now time.Time) error {
+	_, err := tx.ExecContext(ctx, `UPDATE webhook_deliveries SET status = 'failed', next_attempt_at = NULL, updated_at = $2
+		WHERE subscription_id = $1 AND status = 'pending'`, subscriptionID, now)
+	return err
+}
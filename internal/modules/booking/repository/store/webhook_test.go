@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestWebhookRepository_CreateWebhookDeliveries_ServiceAccounts(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	repo := NewWebhookRepository(db, NewOutboxRepository(db))
+	suffix := time.Now().UnixNano()
+
+	var organizerID, eventID int
+	err := db.QueryRowxContext(ctx, "INSERT INTO users (email, password, role) VALUES ($1, '', 'organizer') RETURNING id",
+		fmt.Sprintf("organizer-%d@example.com", suffix)).Scan(&organizerID)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _, _ = db.ExecContext(ctx, "DELETE FROM users WHERE id = $1", organizerID) })
+	err = db.QueryRowxContext(ctx, `INSERT INTO events (name, available_seats, start_at, location, category, status, price, currency, creator_id)
+		VALUES ('webhooks', 10, CURRENT_TIMESTAMP, 'Hanoi', 'music', 'active', 1000, 'USD', $1) RETURNING id`, organizerID).Scan(&eventID)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _, _ = db.ExecContext(ctx, "DELETE FROM events WHERE id = $1", eventID) })
+
+	// a service account per state of its key, the key of the revoked one no longer works
+	newServiceAccount := func(name string, revoked bool) int {
+		var accountID int
+		err := db.QueryRowxContext(ctx, "INSERT INTO service_accounts (name, owner_id, created_by) VALUES ($1, $2, $2) RETURNING id", name, organizerID).Scan(&accountID)
+		assert.NoError(t, err)
+		var revokedAt *time.Time
+		if revoked {
+			revokedAt = util.ToPtr(time.Now())
+		}
+		_, err = db.ExecContext(ctx, "INSERT INTO api_keys (service_account_id, key_hash, prefix, revoked_at) VALUES ($1, $2, 'bk_test', $3)",
+			accountID, fmt.Sprintf("%s-%d", name, suffix), revokedAt)
+		assert.NoError(t, err)
+		return accountID
+	}
+	activeAccountID := newServiceAccount("active", false)
+	revokedAccountID := newServiceAccount("revoked", true)
+
+	newSubscription := func(serviceAccountID *int) int {
+		var subscriptionID int
+		err := db.QueryRowxContext(ctx, `INSERT INTO webhook_subscriptions (owner_id, service_account_id, url, secret, event_types)
+			VALUES ($1, $2, 'https://partner.example.com/hooks', 'whsec_test', $3) RETURNING id`,
+			organizerID, serviceAccountID, pq.Array([]string{string(model.WebhookEventBookingConfirmed)})).Scan(&subscriptionID)
+		assert.NoError(t, err)
+		return subscriptionID
+	}
+	organizerSubscriptionID := newSubscription(nil)
+	activeSubscriptionID := newSubscription(&activeAccountID)
+	newSubscription(&revokedAccountID)
+
+	webhookEvent := model.WebhookEvent{ID: fmt.Sprintf("evt-%d", suffix), Type: model.WebhookEventBookingConfirmed}
+	err = repo.CreateWebhookDeliveries(ctx, eventID, webhookEvent, []byte(`{}`))
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, `DELETE FROM outbox WHERE dedup_key IN (
+			SELECT 'webhook_delivery:' || id || ':1' FROM webhook_deliveries WHERE webhook_event_id = $1)`, webhookEvent.ID)
+	})
+
+	var subscriptionIDs []int
+	err = db.SelectContext(ctx, &subscriptionIDs, "SELECT subscription_id FROM webhook_deliveries WHERE webhook_event_id = $1 ORDER BY subscription_id", webhookEvent.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{organizerSubscriptionID, activeSubscriptionID}, subscriptionIDs)
+
+	subscriptions, err := repo.ListWebhookSubscriptions(ctx, organizerID, activeAccountID)
+	assert.NoError(t, err)
+	if assert.Len(t, subscriptions, 1) {
+		assert.Equal(t, activeSubscriptionID, subscriptions[0].ID)
+	}
+	subscriptions, err = repo.ListWebhookSubscriptions(ctx, organizerID, 0)
+	assert.NoError(t, err)
+	assert.Len(t, subscriptions, 3)
+}
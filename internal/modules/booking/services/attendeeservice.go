@@ -0,0 +1,86 @@
+//go:generate mockgen -source=attendeeservice.go -destination=attendeeservice_mock.go -package=services
+package services
+
+import (
+	"context"
+	"time"
+
+	_errors "booking-event/internal/common/errors"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/booking/model"
+)
+
+type AttendeeRepository interface {
+	StreamAttendees(ctx context.Context, query model.AttendeeQuery, startID int, limit int, fn func(model.Attendee) error) error
+	GetAttendeeIDAfter(ctx context.Context, query model.AttendeeQuery, startID int, limit int) (int, error)
+	CheckIn(ctx context.Context, eventID int, token string) (*time.Time, error)
+}
+
+type EventRepositoryForAttendee interface {
+	GetEventByID(ctx context.Context, id int) (*model.Event, error)
+}
+
+type AttendeeService struct {
+	attendeeRepo AttendeeRepository
+	eventRepo    EventRepositoryForAttendee
+}
+
+func NewAttendeeService(attendeeRepo AttendeeRepository, eventRepo EventRepositoryForAttendee) *AttendeeService {
+	return &AttendeeService{attendeeRepo: attendeeRepo, eventRepo: eventRepo}
+}
+
+// ExportAttendees streams the attendees of the event to the writer. Without a limit the whole
+// list is exported, otherwise one page is exported and the writer gets the cursor of the next one.
+func (s *AttendeeService) ExportAttendees(ctx context.Context, query model.AttendeeQuery, writer model.AttendeeWriter) error {
+	if err := s.checkEventAccess(ctx, query.EventID, query.ExecutorID, query.ExecutorIsAdmin); err != nil {
+		return err
+	}
+	cursor, err := query.Pagination.GetCursor()
+	if err != nil {
+		return err
+	}
+	startID := 0
+	if cursor != nil {
+		startID = cursor.ID
+	}
+
+	nextCursor := ""
+	limit := query.Pagination.Limit
+	if limit > 0 {
+		nextID, err := s.attendeeRepo.GetAttendeeIDAfter(ctx, query, startID, limit)
+		if err != nil {
+			return err
+		}
+		if nextID > 0 {
+			// attendees are sorted by ticket id, which is both the sort value and the tie breaker
+			nextCursor = commonmodel.EncodeCursor(commonmodel.Cursor{Number: util.ToPtr(float64(nextID)), ID: nextID})
+		}
+	}
+
+	if err := writer.Begin(nextCursor); err != nil {
+		return err
+	}
+	if err := s.attendeeRepo.StreamAttendees(ctx, query, startID, limit, writer.Write); err != nil {
+		return err
+	}
+	return writer.End()
+}
+
+func (s *AttendeeService) CheckIn(ctx context.Context, params model.CheckInRequest) (*time.Time, error) {
+	if err := s.checkEventAccess(ctx, params.EventID, params.ExecutorID, params.ExecutorIsAdmin); err != nil {
+		return nil, err
+	}
+	return s.attendeeRepo.CheckIn(ctx, params.EventID, params.Token)
+}
+
+func (s *AttendeeService) checkEventAccess(ctx context.Context, eventID int, executorID int, executorIsAdmin bool) error {
+	event, err := s.eventRepo.GetEventByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if event.CreatorID != executorID && !executorIsAdmin {
+		return _errors.ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attendeeservice.go
+//
+// Generated by this command:
+//
+//	mockgen -source=attendeeservice.go -destination=attendeeservice_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttendeeRepository is a mock of AttendeeRepository interface.
+type MockAttendeeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendeeRepositoryMockRecorder
+}
+
+// MockAttendeeRepositoryMockRecorder is the mock recorder for MockAttendeeRepository.
+type MockAttendeeRepositoryMockRecorder struct {
+	mock *MockAttendeeRepository
+}
+
+// NewMockAttendeeRepository creates a new mock instance.
+func NewMockAttendeeRepository(ctrl *gomock.Controller) *MockAttendeeRepository {
+	mock := &MockAttendeeRepository{ctrl: ctrl}
+	mock.recorder = &MockAttendeeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendeeRepository) EXPECT() *MockAttendeeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CheckIn mocks base method.
+func (m *MockAttendeeRepository) CheckIn(ctx context.Context, eventID int, token string) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, eventID, token)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockAttendeeRepositoryMockRecorder) CheckIn(ctx, eventID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockAttendeeRepository)(nil).CheckIn), ctx, eventID, token)
+}
+
+// GetAttendeeIDAfter mocks base method.
+func (m *MockAttendeeRepository) GetAttendeeIDAfter(ctx context.Context, query model.AttendeeQuery, startID, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendeeIDAfter", ctx, query, startID, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendeeIDAfter indicates an expected call of GetAttendeeIDAfter.
+func (mr *MockAttendeeRepositoryMockRecorder) GetAttendeeIDAfter(ctx, query, startID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendeeIDAfter", reflect.TypeOf((*MockAttendeeRepository)(nil).GetAttendeeIDAfter), ctx, query, startID, limit)
+}
+
+// StreamAttendees mocks base method.
+func (m *MockAttendeeRepository) StreamAttendees(ctx context.Context, query model.AttendeeQuery, startID, limit int, fn func(model.Attendee) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamAttendees", ctx, query, startID, limit, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamAttendees indicates an expected call of StreamAttendees.
+func (mr *MockAttendeeRepositoryMockRecorder) StreamAttendees(ctx, query, startID, limit, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAttendees", reflect.TypeOf((*MockAttendeeRepository)(nil).StreamAttendees), ctx, query, startID, limit, fn)
+}
+
+// MockEventRepositoryForAttendee is a mock of EventRepositoryForAttendee interface.
+type MockEventRepositoryForAttendee struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepositoryForAttendeeMockRecorder
+}
+
+// MockEventRepositoryForAttendeeMockRecorder is the mock recorder for MockEventRepositoryForAttendee.
+type MockEventRepositoryForAttendeeMockRecorder struct {
+	mock *MockEventRepositoryForAttendee
+}
+
+// NewMockEventRepositoryForAttendee creates a new mock instance.
+func NewMockEventRepositoryForAttendee(ctrl *gomock.Controller) *MockEventRepositoryForAttendee {
+	mock := &MockEventRepositoryForAttendee{ctrl: ctrl}
+	mock.recorder = &MockEventRepositoryForAttendeeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventRepositoryForAttendee) EXPECT() *MockEventRepositoryForAttendeeMockRecorder {
+	return m.recorder
+}
+
+// GetEventByID mocks base method.
+func (m *MockEventRepositoryForAttendee) GetEventByID(ctx context.Context, id int) (*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventByID", ctx, id)
+	ret0, _ := ret[0].(*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventByID indicates an expected call of GetEventByID.
+func (mr *MockEventRepositoryForAttendeeMockRecorder) GetEventByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByID", reflect.TypeOf((*MockEventRepositoryForAttendee)(nil).GetEventByID), ctx, id)
+}
+
+// MockAttendeeWriter is a mock of AttendeeWriter interface.
+type MockAttendeeWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendeeWriterMockRecorder
+}
+
+// MockAttendeeWriterMockRecorder is the mock recorder for MockAttendeeWriter.
+type MockAttendeeWriterMockRecorder struct {
+	mock *MockAttendeeWriter
+}
+
+// NewMockAttendeeWriter creates a new mock instance.
+func NewMockAttendeeWriter(ctrl *gomock.Controller) *MockAttendeeWriter {
+	mock := &MockAttendeeWriter{ctrl: ctrl}
+	mock.recorder = &MockAttendeeWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendeeWriter) EXPECT() *MockAttendeeWriterMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockAttendeeWriter) Begin(nextCursor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", nextCursor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockAttendeeWriterMockRecorder) Begin(nextCursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockAttendeeWriter)(nil).Begin), nextCursor)
+}
+
+// End mocks base method.
+func (m *MockAttendeeWriter) End() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "End")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// End indicates an expected call of End.
+func (mr *MockAttendeeWriterMockRecorder) End() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "End", reflect.TypeOf((*MockAttendeeWriter)(nil).End))
+}
+
+// Write mocks base method.
+func (m *MockAttendeeWriter) Write(attendee model.Attendee) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", attendee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockAttendeeWriterMockRecorder) Write(attendee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockAttendeeWriter)(nil).Write), attendee)
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	_errors "booking-event/internal/common/errors"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/booking/model"
+)
+
+type recordingAttendeeWriter struct {
+	nextCursor string
+	attendees  []model.Attendee
+	ended      bool
+}
+
+func (w *recordingAttendeeWriter) Begin(nextCursor string) error {
+	w.nextCursor = nextCursor
+	return nil
+}
+
+func (w *recordingAttendeeWriter) Write(attendee model.Attendee) error {
+	w.attendees = append(w.attendees, attendee)
+	return nil
+}
+
+func (w *recordingAttendeeWriter) End() error {
+	w.ended = true
+	return nil
+}
+
+func TestAttendeeService_ExportAttendees(t *testing.T) {
+	t.Parallel()
+	streamTwo := func(_ context.Context, _ model.AttendeeQuery, _ int, _ int, fn func(model.Attendee) error) error {
+		for _, id := range []int{5, 6} {
+			if err := fn(model.Attendee{TicketID: id}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name               string
+		query              model.AttendeeQuery
+		mockAttendeeRepo   func(ctrl *gomock.Controller) *MockAttendeeRepository
+		event              *model.Event
+		expectedError      error
+		expectedNextCursor string
+		expectedAttendees  int
+	}{
+		{
+			name:  "Full export by the creator",
+			query: model.AttendeeQuery{EventID: 1, ExecutorID: 2},
+			event: &model.Event{ID: 1, CreatorID: 2},
+			mockAttendeeRepo: func(ctrl *gomock.Controller) *MockAttendeeRepository {
+				mock := NewMockAttendeeRepository(ctrl)
+				mock.EXPECT().StreamAttendees(gomock.Any(), gomock.Any(), 0, 0, gomock.Any()).DoAndReturn(streamTwo)
+				return mock
+			},
+			expectedAttendees: 2,
+		},
+		{
+			name: "Page export by an admin",
+			query: model.AttendeeQuery{
+				EventID:         1,
+				ExecutorID:      9,
+				ExecutorIsAdmin: true,
+				Pagination:      commonmodel.Pagination{Limit: 2, Cursor: commonmodel.EncodeCursor(commonmodel.Cursor{Number: util.ToPtr(5.0), ID: 5})},
+			},
+			event: &model.Event{ID: 1, CreatorID: 2},
+			mockAttendeeRepo: func(ctrl *gomock.Controller) *MockAttendeeRepository {
+				mock := NewMockAttendeeRepository(ctrl)
+				mock.EXPECT().GetAttendeeIDAfter(gomock.Any(), gomock.Any(), 5, 2).Return(7, nil)
+				mock.EXPECT().StreamAttendees(gomock.Any(), gomock.Any(), 5, 2, gomock.Any()).DoAndReturn(streamTwo)
+				return mock
+			},
+			expectedNextCursor: commonmodel.EncodeCursor(commonmodel.Cursor{Number: util.ToPtr(7.0), ID: 7}),
+			expectedAttendees:  2,
+		},
+		{
+			name:  "Last page",
+			query: model.AttendeeQuery{EventID: 1, ExecutorID: 2, Pagination: commonmodel.Pagination{Limit: 10}},
+			event: &model.Event{ID: 1, CreatorID: 2},
+			mockAttendeeRepo: func(ctrl *gomock.Controller) *MockAttendeeRepository {
+				mock := NewMockAttendeeRepository(ctrl)
+				mock.EXPECT().GetAttendeeIDAfter(gomock.Any(), gomock.Any(), 0, 10).Return(0, nil)
+				mock.EXPECT().StreamAttendees(gomock.Any(), gomock.Any(), 0, 10, gomock.Any()).DoAndReturn(streamTwo)
+				return mock
+			},
+			expectedAttendees: 2,
+		},
+		{
+			name:  "Not the event creator",
+			query: model.AttendeeQuery{EventID: 1, ExecutorID: 3},
+			event: &model.Event{ID: 1, CreatorID: 2},
+			mockAttendeeRepo: func(ctrl *gomock.Controller) *MockAttendeeRepository {
+				return NewMockAttendeeRepository(ctrl)
+			},
+			expectedError: _errors.ErrForbidden,
+		},
+		{
+			name:  "Invalid cursor",
+			query: model.AttendeeQuery{EventID: 1, ExecutorID: 2, Pagination: commonmodel.Pagination{Limit: 2, Cursor: "%%"}},
+			event: &model.Event{ID: 1, CreatorID: 2},
+			mockAttendeeRepo: func(ctrl *gomock.Controller) *MockAttendeeRepository {
+				return NewMockAttendeeRepository(ctrl)
+			},
+			expectedError: commonmodel.ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			eventRepo := NewMockEventRepositoryForAttendee(ctrl)
+			eventRepo.EXPECT().GetEventByID(gomock.Any(), tt.query.EventID).Return(tt.event, nil)
+
+			writer := &recordingAttendeeWriter{}
+			service := NewAttendeeService(tt.mockAttendeeRepo(ctrl), eventRepo)
+			err := service.ExportAttendees(context.Background(), tt.query, writer)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Empty(t, writer.attendees)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedNextCursor, writer.nextCursor)
+				assert.Len(t, writer.attendees, tt.expectedAttendees)
+				assert.True(t, writer.ended)
+			}
+		})
+	}
+}
@@ -4,9 +4,12 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"strconv"
 	"time"
 
+	commonmodel "booking-event/internal/common/model"
 	"booking-event/internal/infra/paymentgateway"
 	"booking-event/internal/modules/booking/model"
 )
@@ -15,8 +18,9 @@ type BookingRepository interface {
 	CreateBooking(ctx context.Context, booking *model.Booking, bookingItems []model.BookingItem) error
 	CountBookingByUserID(ctx context.Context, eventID int, userID int) (int, error)
 	GetBookingByID(ctx context.Context, id int) (*model.Booking, error)
-	ConfirmBooking(ctx context.Context, booking *model.Booking, event *model.Event, bookingItems []model.BookingItem) error
-	CancelBooking(ctx context.Context, bookingID int) error
+	ListBookings(ctx context.Context, query model.BookingQuery) (*commonmodel.Page[model.Booking], error)
+	ConfirmBooking(ctx context.Context, booking *model.Booking, event *model.Event, bookingItems []model.BookingItem, messages []model.OutboxMessage, reminders []model.BookingReminder) error
+	CancelBooking(ctx context.Context, bookingID int, messages []model.OutboxMessage) error
 }
 
 type BookingItemRepository interface {
@@ -32,14 +36,21 @@ type BookingEventTokenService interface {
 	SelectAvailableToken(ctx context.Context, holderID int32, eventID int, quantity int) ([]string, error)
 }
 
+type UserRepositoryForBooking interface {
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+}
+
 type BookingConfig struct {
 	MaxBookingPerUser int
+	// Reminders schedules the reminders written with the confirmation, those already past are left out.
+	Reminders ReminderPolicy
 }
 
 type BookingService struct {
 	eventService      EventServiceForBooking
 	eventTokenService BookingEventTokenService
 
+	userRepository        UserRepositoryForBooking
 	bookingRepository     BookingRepository
 	bookingItemRepository BookingItemRepository
 	cfg                   BookingConfig
@@ -48,6 +59,7 @@ type BookingService struct {
 func NewBookingService(
 	eventService EventServiceForBooking,
 	eventTokenService BookingEventTokenService,
+	userRepo UserRepositoryForBooking,
 	bookingRepo BookingRepository,
 	bookingItemRepo BookingItemRepository,
 	paymentService paymentgateway.PaymentGateway,
@@ -56,6 +68,7 @@ func NewBookingService(
 	return &BookingService{
 		eventService:          eventService,
 		eventTokenService:     eventTokenService,
+		userRepository:        userRepo,
 		bookingRepository:     bookingRepo,
 		bookingItemRepository: bookingItemRepo,
 		cfg:                   cfg,
@@ -72,6 +85,23 @@ func (s *BookingService) CreateBooking(ctx context.Context, booking model.Create
 		return nil, errors.New("event is not active")
 	}
 
+	user, err := s.userRepository.GetUserByID(ctx, booking.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != model.UserStatusActive {
+		return nil, model.ErrUserNotVerified
+	}
+
+	if event.MinAge > 0 {
+		if user.Birthdate == nil {
+			return nil, errors.New("birthdate is required to book this event")
+		}
+		if user.AgeAt(event.StartAt) < event.MinAge {
+			return nil, errors.New("user does not meet the minimum age of the event")
+		}
+	}
+
 	count, err := s.bookingRepository.CountBookingByUserID(ctx, booking.EventID, booking.UserID)
 	if err != nil {
 		log.Println("error counting booking by user id", err)
@@ -97,6 +127,7 @@ func (s *BookingService) CreateBooking(ctx context.Context, booking model.Create
 		EventID:         booking.EventID,
 		InitialQuantity: booking.Quantity,
 		Quantity:        len(tokens),
+		UnitPrice:       event.Price,
 	}
 	bookingItems := make([]model.BookingItem, len(tokens))
 	for i, token := range tokens {
@@ -133,6 +164,11 @@ func (s *BookingService) ConfirmBooking(ctx context.Context, userID int, booking
 		return err
 	}
 
+	user, err := s.userRepository.GetUserByID(ctx, booking.UserID)
+	if err != nil {
+		return err
+	}
+
 	booking.Status = model.BookingStatusConfirmed
 
 	bookingItems, err := s.bookingItemRepository.GetBookingItemsByBookingID(ctx, booking.ID)
@@ -140,13 +176,47 @@ func (s *BookingService) ConfirmBooking(ctx context.Context, userID int, booking
 		return err
 	}
 
-	return s.bookingRepository.ConfirmBooking(ctx, booking, event, bookingItems)
+	messages, reminders, err := s.confirmationMessages(user, event, booking, bookingItems)
+	if err != nil {
+		return err
+	}
+
+	return s.bookingRepository.ConfirmBooking(ctx, booking, event, bookingItems, messages, reminders)
+}
+
+// confirmationMessages builds the confirmation email of a booking, its reminders and its webhook event, written with
+// the confirmation.
+func (s *BookingService) confirmationMessages(user *model.User, event *model.Event, booking *model.Booking, items []model.BookingItem) ([]model.OutboxMessage, []model.BookingReminder, error) {
+	now := time.Now()
+	confirmation, err := model.NewOutboxMessage(
+		model.TaskTypeSendConfirmationEmail,
+		fmt.Sprintf("booking:%d:confirmation", booking.ID),
+		model.SendConfirmationEmailTask{User: *user, Event: *event, Booking: *booking, Items: items},
+		nil,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	webhookEvent, err := model.NewWebhookEventMessage(model.WebhookEventBookingConfirmed, booking.EventID, strconv.Itoa(booking.ID), booking, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reminderMessages, reminders, err := s.cfg.Reminders.Reminders(*user, *event, *booking, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append([]model.OutboxMessage{*confirmation, *webhookEvent}, reminderMessages...), reminders, nil
 }
 
 func (s *BookingService) GetBookingByID(ctx context.Context, id int) (*model.Booking, error) {
 	return s.bookingRepository.GetBookingByID(ctx, id)
 }
 
+func (s *BookingService) ListBookings(ctx context.Context, query model.BookingQuery) (*commonmodel.Page[model.Booking], error) {
+	return s.bookingRepository.ListBookings(ctx, query)
+}
+
 func (s *BookingService) CancelBooking(ctx context.Context, id int, executorID int) error {
 	booking, err := s.bookingRepository.GetBookingByID(ctx, id)
 	if err != nil {
@@ -168,5 +238,35 @@ func (s *BookingService) CancelBooking(ctx context.Context, id int, executorID i
 		return errors.New("event is already started")
 	}
 
-	return s.bookingRepository.CancelBooking(ctx, booking.ID)
+	user, err := s.userRepository.GetUserByID(ctx, booking.UserID)
+	if err != nil {
+		return err
+	}
+
+	booking.Status = model.BookingStatusCanceled
+	cancellation, err := model.NewOutboxMessage(
+		model.TaskTypeSendCancellationEmail,
+		fmt.Sprintf("booking:%d:cancellation", booking.ID),
+		model.SendCancellationEmailTask{User: *user, Event: *event, Booking: *booking},
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	// the worker deletes the reminders of the booking from the queue
+	syncReminders, err := model.NewOutboxMessage(
+		model.TaskTypeSyncReminders,
+		fmt.Sprintf("booking:%d:%s:canceled", booking.ID, model.TaskTypeSyncReminders),
+		model.SyncRemindersTask{BookingID: booking.ID, Sequence: event.Sequence},
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	webhookEvent, err := model.NewWebhookEventMessage(model.WebhookEventBookingCancelled, booking.EventID, strconv.Itoa(booking.ID), booking, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return s.bookingRepository.CancelBooking(ctx, booking.ID, []model.OutboxMessage{*cancellation, *syncReminders, *webhookEvent})
 }
@@ -10,7 +10,8 @@
 package services
 
 import (
-	model "booking-event/internal/modules/booking/model"
+	model "booking-event/internal/common/model"
+	model0 "booking-event/internal/modules/booking/model"
 	context "context"
 	reflect "reflect"
 
@@ -41,31 +42,31 @@ func (m *MockBookingRepository) EXPECT() *MockBookingRepositoryMockRecorder {
 }
 
 // CancelBooking mocks base method.
-func (m *MockBookingRepository) CancelBooking(ctx context.Context, bookingID int) error {
+func (m *MockBookingRepository) CancelBooking(ctx context.Context, bookingID int, messages []model0.OutboxMessage) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelBooking", ctx, bookingID)
+	ret := m.ctrl.Call(m, "CancelBooking", ctx, bookingID, messages)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CancelBooking indicates an expected call of CancelBooking.
-func (mr *MockBookingRepositoryMockRecorder) CancelBooking(ctx, bookingID any) *gomock.Call {
+func (mr *MockBookingRepositoryMockRecorder) CancelBooking(ctx, bookingID, messages any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBooking", reflect.TypeOf((*MockBookingRepository)(nil).CancelBooking), ctx, bookingID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBooking", reflect.TypeOf((*MockBookingRepository)(nil).CancelBooking), ctx, bookingID, messages)
 }
 
 // ConfirmBooking mocks base method.
-func (m *MockBookingRepository) ConfirmBooking(ctx context.Context, booking *model.Booking, event *model.Event, bookingItems []model.BookingItem) error {
+func (m *MockBookingRepository) ConfirmBooking(ctx context.Context, booking *model0.Booking, event *model0.Event, bookingItems []model0.BookingItem, messages []model0.OutboxMessage, reminders []model0.BookingReminder) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ConfirmBooking", ctx, booking, event, bookingItems)
+	ret := m.ctrl.Call(m, "ConfirmBooking", ctx, booking, event, bookingItems, messages, reminders)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ConfirmBooking indicates an expected call of ConfirmBooking.
-func (mr *MockBookingRepositoryMockRecorder) ConfirmBooking(ctx, booking, event, bookingItems any) *gomock.Call {
+func (mr *MockBookingRepositoryMockRecorder) ConfirmBooking(ctx, booking, event, bookingItems, messages, reminders any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmBooking", reflect.TypeOf((*MockBookingRepository)(nil).ConfirmBooking), ctx, booking, event, bookingItems)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmBooking", reflect.TypeOf((*MockBookingRepository)(nil).ConfirmBooking), ctx, booking, event, bookingItems, messages, reminders)
 }
 
 // CountBookingByUserID mocks base method.
@@ -84,7 +85,7 @@ func (mr *MockBookingRepositoryMockRecorder) CountBookingByUserID(ctx, eventID,
 }
 
 // CreateBooking mocks base method.
-func (m *MockBookingRepository) CreateBooking(ctx context.Context, booking *model.Booking, bookingItems []model.BookingItem) error {
+func (m *MockBookingRepository) CreateBooking(ctx context.Context, booking *model0.Booking, bookingItems []model0.BookingItem) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateBooking", ctx, booking, bookingItems)
 	ret0, _ := ret[0].(error)
@@ -98,10 +99,10 @@ func (mr *MockBookingRepositoryMockRecorder) CreateBooking(ctx, booking, booking
 }
 
 // GetBookingByID mocks base method.
-func (m *MockBookingRepository) GetBookingByID(ctx context.Context, id int) (*model.Booking, error) {
+func (m *MockBookingRepository) GetBookingByID(ctx context.Context, id int) (*model0.Booking, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBookingByID", ctx, id)
-	ret0, _ := ret[0].(*model.Booking)
+	ret0, _ := ret[0].(*model0.Booking)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -112,6 +113,21 @@ func (mr *MockBookingRepositoryMockRecorder) GetBookingByID(ctx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByID", reflect.TypeOf((*MockBookingRepository)(nil).GetBookingByID), ctx, id)
 }
 
+// ListBookings mocks base method.
+func (m *MockBookingRepository) ListBookings(ctx context.Context, query model0.BookingQuery) (*model.Page[model0.Booking], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookings", ctx, query)
+	ret0, _ := ret[0].(*model.Page[model0.Booking])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBookings indicates an expected call of ListBookings.
+func (mr *MockBookingRepositoryMockRecorder) ListBookings(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookings", reflect.TypeOf((*MockBookingRepository)(nil).ListBookings), ctx, query)
+}
+
 // MockBookingItemRepository is a mock of BookingItemRepository interface.
 type MockBookingItemRepository struct {
 	ctrl     *gomock.Controller
@@ -136,7 +152,7 @@ func (m *MockBookingItemRepository) EXPECT() *MockBookingItemRepositoryMockRecor
 }
 
 // CreateBookingItems mocks base method.
-func (m *MockBookingItemRepository) CreateBookingItems(ctx context.Context, bookingItems []model.BookingItem) error {
+func (m *MockBookingItemRepository) CreateBookingItems(ctx context.Context, bookingItems []model0.BookingItem) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateBookingItems", ctx, bookingItems)
 	ret0, _ := ret[0].(error)
@@ -150,10 +166,10 @@ func (mr *MockBookingItemRepositoryMockRecorder) CreateBookingItems(ctx, booking
 }
 
 // GetBookingItemsByBookingID mocks base method.
-func (m *MockBookingItemRepository) GetBookingItemsByBookingID(ctx context.Context, bookingID int) ([]model.BookingItem, error) {
+func (m *MockBookingItemRepository) GetBookingItemsByBookingID(ctx context.Context, bookingID int) ([]model0.BookingItem, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBookingItemsByBookingID", ctx, bookingID)
-	ret0, _ := ret[0].([]model.BookingItem)
+	ret0, _ := ret[0].([]model0.BookingItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -188,10 +204,10 @@ func (m *MockEventServiceForBooking) EXPECT() *MockEventServiceForBookingMockRec
 }
 
 // GetEventByID mocks base method.
-func (m *MockEventServiceForBooking) GetEventByID(ctx context.Context, id int) (*model.Event, error) {
+func (m *MockEventServiceForBooking) GetEventByID(ctx context.Context, id int) (*model0.Event, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEventByID", ctx, id)
-	ret0, _ := ret[0].(*model.Event)
+	ret0, _ := ret[0].(*model0.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -239,3 +255,41 @@ func (mr *MockBookingEventTokenServiceMockRecorder) SelectAvailableToken(ctx, ho
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAvailableToken", reflect.TypeOf((*MockBookingEventTokenService)(nil).SelectAvailableToken), ctx, holderID, eventID, quantity)
 }
+
+// MockUserRepositoryForBooking is a mock of UserRepositoryForBooking interface.
+type MockUserRepositoryForBooking struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryForBookingMockRecorder
+}
+
+// MockUserRepositoryForBookingMockRecorder is the mock recorder for MockUserRepositoryForBooking.
+type MockUserRepositoryForBookingMockRecorder struct {
+	mock *MockUserRepositoryForBooking
+}
+
+// NewMockUserRepositoryForBooking creates a new mock instance.
+func NewMockUserRepositoryForBooking(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+	mock := &MockUserRepositoryForBooking{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryForBookingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepositoryForBooking) EXPECT() *MockUserRepositoryForBookingMockRecorder {
+	return m.recorder
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserRepositoryForBooking) GetUserByID(ctx context.Context, id int) (*model0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryForBookingMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepositoryForBooking)(nil).GetUserByID), ctx, id)
+}
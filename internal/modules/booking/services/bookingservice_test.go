@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -18,6 +19,7 @@ func TestBookingService_CreateBooking(t *testing.T) {
 		name                  string
 		request               model.CreateBookingRequest
 		mockEventService      func(ctrl *gomock.Controller) *MockEventServiceForBooking
+		mockUserRepo          func(ctrl *gomock.Controller) *MockUserRepositoryForBooking
 		mockBookingRepo       func(ctrl *gomock.Controller) *MockBookingRepository
 		mockEventTokenService func(ctrl *gomock.Controller) *MockBookingEventTokenService
 		expectedResponse      *model.Booking
@@ -32,7 +34,12 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			},
 			mockEventService: func(ctrl *gomock.Controller) *MockEventServiceForBooking {
 				mock := NewMockEventServiceForBooking(ctrl)
-				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{Status: model.EventStatusActive}, nil)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{Status: model.EventStatusActive, Price: 150000}, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				mock := NewMockUserRepositoryForBooking(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Status: model.UserStatusActive}, nil)
 				return mock
 			},
 			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
@@ -44,6 +51,7 @@ func TestBookingService_CreateBooking(t *testing.T) {
 					EventID:         1,
 					InitialQuantity: 2,
 					Quantity:        2,
+					UnitPrice:       150000,
 				}, []model.BookingItem{
 					{Token: "token1"},
 					{Token: "token2"},
@@ -59,7 +67,7 @@ func TestBookingService_CreateBooking(t *testing.T) {
 				return mock
 			},
 
-			expectedResponse: &model.Booking{ID: 1, Status: model.BookingStatusPending, UserID: 1, EventID: 1, InitialQuantity: 2, Quantity: 2},
+			expectedResponse: &model.Booking{ID: 1, Status: model.BookingStatusPending, UserID: 1, EventID: 1, InitialQuantity: 2, Quantity: 2, UnitPrice: 150000},
 			expectedError:    nil,
 		},
 		{
@@ -76,6 +84,68 @@ func TestBookingService_CreateBooking(t *testing.T) {
 			},
 			expectedError: errors.New("event is not active"),
 		},
+		{
+			name: "Unverified user",
+			request: model.CreateBookingRequest{
+				EventID:  1,
+				UserID:   1,
+				Quantity: 2,
+			},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventServiceForBooking {
+				mock := NewMockEventServiceForBooking(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{Status: model.EventStatusActive}, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				mock := NewMockUserRepositoryForBooking(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Status: "unverified"}, nil)
+				return mock
+			},
+			expectedError: model.ErrUserNotVerified,
+		},
+		{
+			name: "Birthdate required for age restricted event",
+			request: model.CreateBookingRequest{
+				EventID:  1,
+				UserID:   1,
+				Quantity: 2,
+			},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventServiceForBooking {
+				mock := NewMockEventServiceForBooking(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{Status: model.EventStatusActive, MinAge: 18}, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				mock := NewMockUserRepositoryForBooking(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Status: model.UserStatusActive}, nil)
+				return mock
+			},
+			expectedError: errors.New("birthdate is required to book this event"),
+		},
+		{
+			name: "User under the minimum age on the event day",
+			request: model.CreateBookingRequest{
+				EventID:  1,
+				UserID:   1,
+				Quantity: 2,
+			},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventServiceForBooking {
+				mock := NewMockEventServiceForBooking(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{
+					Status:  model.EventStatusActive,
+					MinAge:  18,
+					StartAt: time.Date(2030, 6, 1, 20, 0, 0, 0, time.UTC),
+				}, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				mock := NewMockUserRepositoryForBooking(ctrl)
+				birthdate := time.Date(2012, 6, 2, 0, 0, 0, 0, time.UTC)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Status: model.UserStatusActive, Birthdate: &birthdate}, nil)
+				return mock
+			},
+			expectedError: errors.New("user does not meet the minimum age of the event"),
+		},
 		{
 			name: "Max booking per user reached",
 			request: model.CreateBookingRequest{
@@ -88,6 +158,11 @@ func TestBookingService_CreateBooking(t *testing.T) {
 				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{Status: model.EventStatusActive}, nil)
 				return mock
 			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				mock := NewMockUserRepositoryForBooking(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Status: model.UserStatusActive}, nil)
+				return mock
+			},
 			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
 				mock := NewMockBookingRepository(ctrl)
 				mock.EXPECT().CountBookingByUserID(gomock.Any(), 1, 1).Return(2, nil)
@@ -115,9 +190,15 @@ func TestBookingService_CreateBooking(t *testing.T) {
 				mockBookingRepo = tt.mockBookingRepo(ctrl)
 			}
 
+			var mockUserRepo *MockUserRepositoryForBooking
+			if tt.mockUserRepo != nil {
+				mockUserRepo = tt.mockUserRepo(ctrl)
+			}
+
 			service := NewBookingService(
 				mockEventService,
 				mockEventTokenService,
+				mockUserRepo,
 				mockBookingRepo,
 				nil,
 				nil,
@@ -136,12 +217,14 @@ func TestBookingService_CreateBooking(t *testing.T) {
 
 func TestBookingService_ConfirmBooking(t *testing.T) {
 	t.Parallel()
+	startAt := time.Now().Add(72 * time.Hour).Truncate(time.Second)
 
 	tests := []struct {
 		name                string
 		userID              int
 		bookingID           int
 		mockEventService    func(ctrl *gomock.Controller) *MockEventServiceForBooking
+		mockUserRepo        func(ctrl *gomock.Controller) *MockUserRepositoryForBooking
 		mockBookingRepo     func(ctrl *gomock.Controller) *MockBookingRepository
 		mockBookingItemRepo func(ctrl *gomock.Controller) *MockBookingItemRepository
 		expectedError       error
@@ -152,13 +235,71 @@ func TestBookingService_ConfirmBooking(t *testing.T) {
 			bookingID: 1,
 			mockEventService: func(ctrl *gomock.Controller) *MockEventServiceForBooking {
 				mock := NewMockEventServiceForBooking(ctrl)
-				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{}, nil)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, StartAt: startAt}, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				mock := NewMockUserRepositoryForBooking(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Email: "user@example.com"}, nil)
 				return mock
 			},
 			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
 				mock := NewMockBookingRepository(ctrl)
 				mock.EXPECT().GetBookingByID(gomock.Any(), 1).Return(&model.Booking{ID: 1, UserID: 1, EventID: 1, Status: model.BookingStatusPending}, nil)
-				mock.EXPECT().ConfirmBooking(gomock.Any(), &model.Booking{ID: 1, UserID: 1, EventID: 1, Status: model.BookingStatusConfirmed}, &model.Event{}, []model.BookingItem{}).Return(nil)
+				mock.EXPECT().ConfirmBooking(gomock.Any(), &model.Booking{ID: 1, UserID: 1, EventID: 1, Status: model.BookingStatusConfirmed}, &model.Event{ID: 1, StartAt: startAt}, []model.BookingItem{{ID: 1, BookingID: 1, Token: "token-1"}}, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, booking *model.Booking, event *model.Event, bookingItems []model.BookingItem, messages []model.OutboxMessage, reminders []model.BookingReminder) error {
+						assert.Len(t, messages, 3)
+						assert.Equal(t, model.TaskTypeSendConfirmationEmail, messages[0].TaskType)
+						assert.Equal(t, "booking:1:confirmation", messages[0].DedupKey)
+						assert.Nil(t, messages[0].ProcessAt)
+						var task model.SendConfirmationEmailTask
+						assert.NoError(t, json.Unmarshal(messages[0].Payload, &task))
+						assert.Equal(t, "user@example.com", task.User.Email)
+						assert.Equal(t, model.BookingStatusConfirmed, task.Booking.Status)
+						assert.Equal(t, []model.BookingItem{{ID: 1, BookingID: 1, Token: "token-1"}}, task.Items)
+
+						assert.Equal(t, model.TaskTypeDispatchWebhookEvent, messages[1].TaskType)
+						assert.Equal(t, "webhook:booking.confirmed:1", messages[1].DedupKey)
+
+						assert.Equal(t, model.TaskTypeSendReminderEmail, messages[2].TaskType)
+						assert.Equal(t, "booking:1:reminder:1440m:0", messages[2].DedupKey)
+						assert.Equal(t, startAt.Add(-24*time.Hour), *messages[2].ProcessAt)
+						assert.Equal(t, []model.BookingReminder{{TaskID: "booking:1:reminder:1440m:0", BookingID: 1, RemindAt: startAt.Add(-24 * time.Hour)}}, reminders)
+						return nil
+					})
+				return mock
+			},
+			mockBookingItemRepo: func(ctrl *gomock.Controller) *MockBookingItemRepository {
+				mock := NewMockBookingItemRepository(ctrl)
+				mock.EXPECT().GetBookingItemsByBookingID(gomock.Any(), 1).Return([]model.BookingItem{{ID: 1, BookingID: 1, Token: "token-1"}}, nil)
+				return mock
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "No reminder for an event starting soon",
+			userID:    1,
+			bookingID: 1,
+			mockEventService: func(ctrl *gomock.Controller) *MockEventServiceForBooking {
+				mock := NewMockEventServiceForBooking(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, StartAt: time.Now().Add(time.Hour)}, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				mock := NewMockUserRepositoryForBooking(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Email: "user@example.com"}, nil)
+				return mock
+			},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 1).Return(&model.Booking{ID: 1, UserID: 1, EventID: 1, Status: model.BookingStatusPending}, nil)
+				mock.EXPECT().ConfirmBooking(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, booking *model.Booking, event *model.Event, bookingItems []model.BookingItem, messages []model.OutboxMessage, reminders []model.BookingReminder) error {
+						assert.Len(t, messages, 2)
+						assert.Empty(t, reminders)
+						assert.Equal(t, model.TaskTypeSendConfirmationEmail, messages[0].TaskType)
+						return nil
+					})
 				return mock
 			},
 			mockBookingItemRepo: func(ctrl *gomock.Controller) *MockBookingItemRepository {
@@ -190,6 +331,10 @@ func TestBookingService_ConfirmBooking(t *testing.T) {
 			if tt.mockEventService != nil {
 				mockEventService = tt.mockEventService(ctrl)
 			}
+			var mockUserRepo *MockUserRepositoryForBooking
+			if tt.mockUserRepo != nil {
+				mockUserRepo = tt.mockUserRepo(ctrl)
+			}
 			var mockBookingRepo *MockBookingRepository
 			if tt.mockBookingRepo != nil {
 				mockBookingRepo = tt.mockBookingRepo(ctrl)
@@ -202,10 +347,11 @@ func TestBookingService_ConfirmBooking(t *testing.T) {
 			service := NewBookingService(
 				mockEventService,
 				nil,
+				mockUserRepo,
 				mockBookingRepo,
 				mockBookingItemRepo,
 				nil,
-				BookingConfig{},
+				BookingConfig{Reminders: ReminderPolicy{Schedule: []time.Duration{24 * time.Hour}}},
 			)
 
 			err := service.ConfirmBooking(context.Background(), tt.userID, tt.bookingID)
@@ -223,11 +369,13 @@ func TestBookingService_CancelBooking(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockEventService := NewMockEventServiceForBooking(ctrl)
+	mockUserRepo := NewMockUserRepositoryForBooking(ctrl)
 	mockBookingRepo := NewMockBookingRepository(ctrl)
 
 	service := NewBookingService(
 		mockEventService,
 		nil,
+		mockUserRepo,
 		mockBookingRepo,
 		nil,
 		nil,
@@ -247,8 +395,19 @@ func TestBookingService_CancelBooking(t *testing.T) {
 			executorID: 1,
 			setupMocks: func() {
 				mockBookingRepo.EXPECT().GetBookingByID(gomock.Any(), 1).Return(&model.Booking{ID: 1, UserID: 1, EventID: 1, Status: model.BookingStatusConfirmed}, nil)
-				mockEventService.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{StartAt: time.Now().Add(24 * time.Hour)}, nil)
-				mockBookingRepo.EXPECT().CancelBooking(gomock.Any(), 1).Return(nil)
+				mockEventService.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{StartAt: time.Now().Add(24 * time.Hour), Sequence: 2}, nil)
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&model.User{ID: 1, Email: "user@example.com"}, nil)
+				mockBookingRepo.EXPECT().CancelBooking(gomock.Any(), 1, gomock.Any()).DoAndReturn(func(ctx context.Context, bookingID int, messages []model.OutboxMessage) error {
+					assert.Len(t, messages, 3)
+					assert.Equal(t, model.TaskTypeSendCancellationEmail, messages[0].TaskType)
+					assert.Equal(t, "booking:1:cancellation", messages[0].DedupKey)
+					assert.Equal(t, model.TaskTypeSyncReminders, messages[1].TaskType)
+					assert.Equal(t, "booking:1:sync_reminders:canceled", messages[1].DedupKey)
+					assert.JSONEq(t, `{"booking_id":1,"sequence":2}`, string(messages[1].Payload))
+					assert.Equal(t, model.TaskTypeDispatchWebhookEvent, messages[2].TaskType)
+					assert.Equal(t, "webhook:booking.cancelled:1", messages[2].DedupKey)
+					return nil
+				})
 			},
 			expectedError: nil,
 		},
@@ -0,0 +1,108 @@
+//go:generate mockgen -source=calendarservice.go -destination=calendarservice_mock.go -package=services
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	_errors "booking-event/internal/common/errors"
+	"booking-event/internal/infra/icalendar"
+	"booking-event/internal/modules/booking/model"
+)
+
+// calendarFeedName is the name the calendar apps show for the subscribed feed.
+const calendarFeedName = "My bookings"
+
+type CalendarFeedRepository interface {
+	SaveCalendarFeed(ctx context.Context, feed *model.CalendarFeed) error
+	GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*model.CalendarFeed, error)
+	DeleteCalendarFeed(ctx context.Context, userID int) error
+	ListCalendarEntries(ctx context.Context, userID int, from time.Time) ([]model.CalendarEntry, error)
+}
+
+type CalendarConfig struct {
+	// FeedURL is the base URL of the feeds, usually webcal:// so the links open in the calendar apps.
+	FeedURL         string
+	EventDuration   time.Duration
+	RefreshInterval time.Duration
+}
+
+// CalendarService serves the private calendar feed of the bookings of a user. The URL of the feed is its only
+// credential, so it is only shown when created and creating it again replaces it.
+type CalendarService struct {
+	feedRepo CalendarFeedRepository
+	nowFn    func() time.Time
+	cfg      CalendarConfig
+}
+
+func NewCalendarService(feedRepo CalendarFeedRepository, nowFn func() time.Time, cfg CalendarConfig) *CalendarService {
+	return &CalendarService{feedRepo: feedRepo, nowFn: nowFn, cfg: cfg}
+}
+
+func (s *CalendarService) CreateFeed(ctx context.Context, userID int) (*model.CalendarFeedResponse, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(token)
+	err := s.feedRepo.SaveCalendarFeed(ctx, &model.CalendarFeed{UserID: userID, TokenHash: hashFeedToken(encoded)})
+	if err != nil {
+		return nil, err
+	}
+	return &model.CalendarFeedResponse{URL: fmt.Sprintf("%s/%s.ics", strings.TrimSuffix(s.cfg.FeedURL, "/"), encoded)}, nil
+}
+
+func (s *CalendarService) DeleteFeed(ctx context.Context, userID int) error {
+	return s.feedRepo.DeleteCalendarFeed(ctx, userID)
+}
+
+// RenderFeed writes the upcoming bookings of the owner of the token, the events started less than their duration
+// ago are still listed.
+func (s *CalendarService) RenderFeed(ctx context.Context, token string) ([]byte, error) {
+	feed, err := s.feedRepo.GetCalendarFeedByTokenHash(ctx, hashFeedToken(token))
+	if errors.Is(err, _errors.ErrNotFound) {
+		return nil, model.ErrCalendarFeedNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := s.nowFn()
+	entries, err := s.feedRepo.ListCalendarEntries(ctx, feed.UserID, now.Add(-s.cfg.EventDuration))
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := icalendar.Calendar{
+		ProdID:          model.CalendarProdID,
+		Name:            calendarFeedName,
+		RefreshInterval: s.cfg.RefreshInterval,
+		Events:          make([]icalendar.Event, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		calendar.Events = append(calendar.Events, icalendar.Event{
+			UID:         model.BookingCalendarUID(entry.Booking.ID),
+			Sequence:    entry.Event.Sequence,
+			Start:       entry.Event.StartAt,
+			End:         entry.Event.StartAt.Add(s.cfg.EventDuration),
+			Summary:     entry.Event.Name,
+			Location:    entry.Event.Location,
+			Description: fmt.Sprintf("Booking #%d, %d ticket(s).", entry.Booking.ID, entry.Booking.Quantity),
+			Status:      icalendar.StatusConfirmed,
+			Latitude:    entry.Event.Latitude,
+			Longitude:   entry.Event.Longitude,
+		})
+	}
+	return calendar.Encode(now), nil
+}
+
+func hashFeedToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
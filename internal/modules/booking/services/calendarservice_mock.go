@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: calendarservice.go
+//
+// Generated by this command:
+//
+//	mockgen -source=calendarservice.go -destination=calendarservice_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCalendarFeedRepository is a mock of CalendarFeedRepository interface.
+type MockCalendarFeedRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarFeedRepositoryMockRecorder
+}
+
+// MockCalendarFeedRepositoryMockRecorder is the mock recorder for MockCalendarFeedRepository.
+type MockCalendarFeedRepositoryMockRecorder struct {
+	mock *MockCalendarFeedRepository
+}
+
+// NewMockCalendarFeedRepository creates a new mock instance.
+func NewMockCalendarFeedRepository(ctrl *gomock.Controller) *MockCalendarFeedRepository {
+	mock := &MockCalendarFeedRepository{ctrl: ctrl}
+	mock.recorder = &MockCalendarFeedRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarFeedRepository) EXPECT() *MockCalendarFeedRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteCalendarFeed mocks base method.
+func (m *MockCalendarFeedRepository) DeleteCalendarFeed(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCalendarFeed", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCalendarFeed indicates an expected call of DeleteCalendarFeed.
+func (mr *MockCalendarFeedRepositoryMockRecorder) DeleteCalendarFeed(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCalendarFeed", reflect.TypeOf((*MockCalendarFeedRepository)(nil).DeleteCalendarFeed), ctx, userID)
+}
+
+// GetCalendarFeedByTokenHash mocks base method.
+func (m *MockCalendarFeedRepository) GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*model.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendarFeedByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*model.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendarFeedByTokenHash indicates an expected call of GetCalendarFeedByTokenHash.
+func (mr *MockCalendarFeedRepositoryMockRecorder) GetCalendarFeedByTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendarFeedByTokenHash", reflect.TypeOf((*MockCalendarFeedRepository)(nil).GetCalendarFeedByTokenHash), ctx, tokenHash)
+}
+
+// ListCalendarEntries mocks base method.
+func (m *MockCalendarFeedRepository) ListCalendarEntries(ctx context.Context, userID int, from time.Time) ([]model.CalendarEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCalendarEntries", ctx, userID, from)
+	ret0, _ := ret[0].([]model.CalendarEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCalendarEntries indicates an expected call of ListCalendarEntries.
+func (mr *MockCalendarFeedRepositoryMockRecorder) ListCalendarEntries(ctx, userID, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCalendarEntries", reflect.TypeOf((*MockCalendarFeedRepository)(nil).ListCalendarEntries), ctx, userID, from)
+}
+
+// SaveCalendarFeed mocks base method.
+func (m *MockCalendarFeedRepository) SaveCalendarFeed(ctx context.Context, feed *model.CalendarFeed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCalendarFeed", ctx, feed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCalendarFeed indicates an expected call of SaveCalendarFeed.
+func (mr *MockCalendarFeedRepositoryMockRecorder) SaveCalendarFeed(ctx, feed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCalendarFeed", reflect.TypeOf((*MockCalendarFeedRepository)(nil).SaveCalendarFeed), ctx, feed)
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	_errors "booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestCalendarService_CreateFeed(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	cfg := CalendarConfig{FeedURL: "webcal://localhost:8080/api/v1/calendar/", EventDuration: 2 * time.Hour, RefreshInterval: time.Hour}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	feedRepo := NewMockCalendarFeedRepository(ctrl)
+	var saved model.CalendarFeed
+	feedRepo.EXPECT().SaveCalendarFeed(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, feed *model.CalendarFeed) error {
+		saved = *feed
+		return nil
+	})
+
+	service := NewCalendarService(feedRepo, func() time.Time { return now }, cfg)
+	feed, err := service.CreateFeed(context.Background(), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, saved.UserID)
+	assert.True(t, strings.HasPrefix(feed.URL, "webcal://localhost:8080/api/v1/calendar/"))
+	assert.True(t, strings.HasSuffix(feed.URL, ".ics"))
+
+	// only the hash of the token is stored
+	token := strings.TrimSuffix(strings.TrimPrefix(feed.URL, "webcal://localhost:8080/api/v1/calendar/"), ".ics")
+	assert.NotContains(t, token, "/")
+	assert.Equal(t, hashFeedToken(token), saved.TokenHash)
+	assert.NotEqual(t, token, saved.TokenHash)
+}
+
+func TestCalendarService_RenderFeed(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	cfg := CalendarConfig{FeedURL: "webcal://localhost:8080/api/v1/calendar", EventDuration: 2 * time.Hour, RefreshInterval: time.Hour}
+	entries := []model.CalendarEntry{{
+		Booking: model.Booking{ID: 7, Quantity: 2, Status: model.BookingStatusConfirmed},
+		Event:   model.Event{ID: 3, Name: "Go Conf", StartAt: now.Add(24 * time.Hour), Location: "Hall A", Sequence: 1},
+	}}
+
+	tests := []struct {
+		name          string
+		mockFeedRepo  func(ctrl *gomock.Controller) *MockCalendarFeedRepository
+		expected      []string
+		expectedError error
+	}{
+		{
+			name: "Upcoming bookings listed",
+			mockFeedRepo: func(ctrl *gomock.Controller) *MockCalendarFeedRepository {
+				mock := NewMockCalendarFeedRepository(ctrl)
+				mock.EXPECT().GetCalendarFeedByTokenHash(gomock.Any(), hashFeedToken("abc")).Return(&model.CalendarFeed{UserID: 5}, nil)
+				mock.EXPECT().ListCalendarEntries(gomock.Any(), 5, now.Add(-2*time.Hour)).Return(entries, nil)
+				return mock
+			},
+			expected: []string{
+				"\r\nX-WR-CALNAME:My bookings\r\n",
+				"\r\nREFRESH-INTERVAL;VALUE=DURATION:PT60M\r\n",
+				"\r\nUID:booking-7@booking-event\r\nSEQUENCE:1\r\n",
+				"\r\nDTSTART:20261002T100000Z\r\nDTEND:20261002T120000Z\r\n",
+				"\r\nSUMMARY:Go Conf\r\n",
+			},
+		},
+		{
+			name: "Unknown token",
+			mockFeedRepo: func(ctrl *gomock.Controller) *MockCalendarFeedRepository {
+				mock := NewMockCalendarFeedRepository(ctrl)
+				mock.EXPECT().GetCalendarFeedByTokenHash(gomock.Any(), hashFeedToken("abc")).Return(nil, _errors.ErrNotFound)
+				return mock
+			},
+			expectedError: model.ErrCalendarFeedNotFound,
+		},
+		{
+			name: "Listing error",
+			mockFeedRepo: func(ctrl *gomock.Controller) *MockCalendarFeedRepository {
+				mock := NewMockCalendarFeedRepository(ctrl)
+				mock.EXPECT().GetCalendarFeedByTokenHash(gomock.Any(), hashFeedToken("abc")).Return(&model.CalendarFeed{UserID: 5}, nil)
+				mock.EXPECT().ListCalendarEntries(gomock.Any(), 5, gomock.Any()).Return(nil, assert.AnError)
+				return mock
+			},
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewCalendarService(tt.mockFeedRepo(ctrl), func() time.Time { return now }, cfg)
+			calendar, err := service.RenderFeed(context.Background(), "abc")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			for _, expected := range tt.expected {
+				assert.Contains(t, string(calendar), expected)
+			}
+			assert.NotContains(t, string(calendar), "METHOD:")
+		})
+	}
+}
@@ -0,0 +1,56 @@
+//go:generate mockgen -source=categoryservice.go -destination=categoryservice_mock.go -package=services
+package services
+
+import (
+	"context"
+
+	"booking-event/internal/modules/booking/model"
+)
+
+type CategoryRepository interface {
+	ListCategories(ctx context.Context) ([]model.Category, error)
+	CreateCategory(ctx context.Context, category *model.Category) error
+	UpdateCategory(ctx context.Context, category *model.Category) error
+	DeleteCategory(ctx context.Context, id int) error
+}
+
+type CategoryService struct {
+	categoryRepo CategoryRepository
+}
+
+func NewCategoryService(categoryRepo CategoryRepository) *CategoryService {
+	return &CategoryService{categoryRepo: categoryRepo}
+}
+
+func (s *CategoryService) ListCategories(ctx context.Context) ([]model.Category, error) {
+	return s.categoryRepo.ListCategories(ctx)
+}
+
+func (s *CategoryService) CreateCategory(ctx context.Context, params model.UpsertCategoryRequest) (*model.Category, error) {
+	category := &model.Category{
+		Slug:        params.Slug,
+		Name:        params.Name,
+		Description: params.Description,
+	}
+	if err := s.categoryRepo.CreateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+func (s *CategoryService) UpdateCategory(ctx context.Context, params model.UpsertCategoryRequest) (*model.Category, error) {
+	category := &model.Category{
+		ID:          params.ID,
+		Slug:        params.Slug,
+		Name:        params.Name,
+		Description: params.Description,
+	}
+	if err := s.categoryRepo.UpdateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+func (s *CategoryService) DeleteCategory(ctx context.Context, id int) error {
+	return s.categoryRepo.DeleteCategory(ctx, id)
+}
@@ -0,0 +1,108 @@
+//go:generate mockgen -source=dashboardservice.go -destination=dashboardservice_mock.go -package=services
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	_errors "booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+)
+
+type SalesStatsRepository interface {
+	GetLastRefreshedAt(ctx context.Context) (time.Time, error)
+	RefreshSalesStats(ctx context.Context, since time.Time) (int, error)
+	GetEventSalesStats(ctx context.Context, eventID int) (*model.EventSalesStats, error)
+	ListEventSalesStats(ctx context.Context, query model.SalesStatsQuery) ([]model.EventSalesStats, error)
+	GetSalesTimeSeries(ctx context.Context, query model.SalesTimeSeriesQuery) ([]model.SalesDataPoint, error)
+}
+
+type EventRepositoryForDashboard interface {
+	GetEventByID(ctx context.Context, id int) (*model.Event, error)
+}
+
+type DashboardConfig struct {
+	// RefreshLookback is subtracted from the last refresh time when looking for changed events,
+	// it covers the transactions which were still in flight during the previous refresh.
+	RefreshLookback time.Duration
+	// MaxTimeSeriesRange bounds the range of a time series request.
+	MaxTimeSeriesRange time.Duration
+}
+
+type DashboardService struct {
+	statsRepo SalesStatsRepository
+	eventRepo EventRepositoryForDashboard
+	nowFn     func() time.Time
+	cfg       DashboardConfig
+}
+
+func NewDashboardService(
+	statsRepo SalesStatsRepository,
+	eventRepo EventRepositoryForDashboard,
+	nowFn func() time.Time,
+	cfg DashboardConfig,
+) *DashboardService {
+	return &DashboardService{
+		statsRepo: statsRepo,
+		eventRepo: eventRepo,
+		nowFn:     nowFn,
+		cfg:       cfg,
+	}
+}
+
+// RefreshSalesStats is run periodically by the worker to keep the aggregated tables up to date.
+func (s *DashboardService) RefreshSalesStats(ctx context.Context) error {
+	lastRefreshedAt, err := s.statsRepo.GetLastRefreshedAt(ctx)
+	if err != nil {
+		return err
+	}
+	var since time.Time
+	if !lastRefreshedAt.IsZero() {
+		since = lastRefreshedAt.Add(-s.cfg.RefreshLookback)
+	}
+	refreshed, err := s.statsRepo.RefreshSalesStats(ctx, since)
+	if err != nil {
+		return err
+	}
+	log.Printf("refreshed sales stats of %d events", refreshed)
+	return nil
+}
+
+func (s *DashboardService) ListEventSalesStats(ctx context.Context, query model.SalesStatsQuery) ([]model.EventSalesStats, error) {
+	return s.statsRepo.ListEventSalesStats(ctx, query)
+}
+
+func (s *DashboardService) GetEventSalesStats(ctx context.Context, eventID int, executorID int) (*model.EventSalesStats, error) {
+	if err := s.checkEventOwner(ctx, eventID, executorID); err != nil {
+		return nil, err
+	}
+	return s.statsRepo.GetEventSalesStats(ctx, eventID)
+}
+
+func (s *DashboardService) GetSalesTimeSeries(ctx context.Context, query model.SalesTimeSeriesQuery) ([]model.SalesDataPoint, error) {
+	if err := s.checkEventOwner(ctx, query.EventID, query.ExecutorID); err != nil {
+		return nil, err
+	}
+	if query.Granularity == "" {
+		query.Granularity = model.SalesGranularityHour
+	}
+	if query.To.IsZero() {
+		query.To = s.nowFn()
+	}
+	if query.From.IsZero() || query.To.Sub(query.From) > s.cfg.MaxTimeSeriesRange {
+		query.From = query.To.Add(-s.cfg.MaxTimeSeriesRange)
+	}
+	return s.statsRepo.GetSalesTimeSeries(ctx, query)
+}
+
+func (s *DashboardService) checkEventOwner(ctx context.Context, eventID int, executorID int) error {
+	event, err := s.eventRepo.GetEventByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if event.CreatorID != executorID {
+		return _errors.ErrForbidden
+	}
+	return nil
+}
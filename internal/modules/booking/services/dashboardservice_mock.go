@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dashboardservice.go
+//
+// Generated by this command:
+//
+//	mockgen -source=dashboardservice.go -destination=dashboardservice_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSalesStatsRepository is a mock of SalesStatsRepository interface.
+type MockSalesStatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSalesStatsRepositoryMockRecorder
+}
+
+// MockSalesStatsRepositoryMockRecorder is the mock recorder for MockSalesStatsRepository.
+type MockSalesStatsRepositoryMockRecorder struct {
+	mock *MockSalesStatsRepository
+}
+
+// NewMockSalesStatsRepository creates a new mock instance.
+func NewMockSalesStatsRepository(ctrl *gomock.Controller) *MockSalesStatsRepository {
+	mock := &MockSalesStatsRepository{ctrl: ctrl}
+	mock.recorder = &MockSalesStatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSalesStatsRepository) EXPECT() *MockSalesStatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetEventSalesStats mocks base method.
+func (m *MockSalesStatsRepository) GetEventSalesStats(ctx context.Context, eventID int) (*model.EventSalesStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventSalesStats", ctx, eventID)
+	ret0, _ := ret[0].(*model.EventSalesStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventSalesStats indicates an expected call of GetEventSalesStats.
+func (mr *MockSalesStatsRepositoryMockRecorder) GetEventSalesStats(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSalesStats", reflect.TypeOf((*MockSalesStatsRepository)(nil).GetEventSalesStats), ctx, eventID)
+}
+
+// GetLastRefreshedAt mocks base method.
+func (m *MockSalesStatsRepository) GetLastRefreshedAt(ctx context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastRefreshedAt", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastRefreshedAt indicates an expected call of GetLastRefreshedAt.
+func (mr *MockSalesStatsRepositoryMockRecorder) GetLastRefreshedAt(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastRefreshedAt", reflect.TypeOf((*MockSalesStatsRepository)(nil).GetLastRefreshedAt), ctx)
+}
+
+// GetSalesTimeSeries mocks base method.
+func (m *MockSalesStatsRepository) GetSalesTimeSeries(ctx context.Context, query model.SalesTimeSeriesQuery) ([]model.SalesDataPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSalesTimeSeries", ctx, query)
+	ret0, _ := ret[0].([]model.SalesDataPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSalesTimeSeries indicates an expected call of GetSalesTimeSeries.
+func (mr *MockSalesStatsRepositoryMockRecorder) GetSalesTimeSeries(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalesTimeSeries", reflect.TypeOf((*MockSalesStatsRepository)(nil).GetSalesTimeSeries), ctx, query)
+}
+
+// ListEventSalesStats mocks base method.
+func (m *MockSalesStatsRepository) ListEventSalesStats(ctx context.Context, query model.SalesStatsQuery) ([]model.EventSalesStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventSalesStats", ctx, query)
+	ret0, _ := ret[0].([]model.EventSalesStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventSalesStats indicates an expected call of ListEventSalesStats.
+func (mr *MockSalesStatsRepositoryMockRecorder) ListEventSalesStats(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSalesStats", reflect.TypeOf((*MockSalesStatsRepository)(nil).ListEventSalesStats), ctx, query)
+}
+
+// RefreshSalesStats mocks base method.
+func (m *MockSalesStatsRepository) RefreshSalesStats(ctx context.Context, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSalesStats", ctx, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshSalesStats indicates an expected call of RefreshSalesStats.
+func (mr *MockSalesStatsRepositoryMockRecorder) RefreshSalesStats(ctx, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSalesStats", reflect.TypeOf((*MockSalesStatsRepository)(nil).RefreshSalesStats), ctx, since)
+}
+
+// MockEventRepositoryForDashboard is a mock of EventRepositoryForDashboard interface.
+type MockEventRepositoryForDashboard struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepositoryForDashboardMockRecorder
+}
+
+// MockEventRepositoryForDashboardMockRecorder is the mock recorder for MockEventRepositoryForDashboard.
+type MockEventRepositoryForDashboardMockRecorder struct {
+	mock *MockEventRepositoryForDashboard
+}
+
+// NewMockEventRepositoryForDashboard creates a new mock instance.
+func NewMockEventRepositoryForDashboard(ctrl *gomock.Controller) *MockEventRepositoryForDashboard {
+	mock := &MockEventRepositoryForDashboard{ctrl: ctrl}
+	mock.recorder = &MockEventRepositoryForDashboardMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventRepositoryForDashboard) EXPECT() *MockEventRepositoryForDashboardMockRecorder {
+	return m.recorder
+}
+
+// GetEventByID mocks base method.
+func (m *MockEventRepositoryForDashboard) GetEventByID(ctx context.Context, id int) (*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventByID", ctx, id)
+	ret0, _ := ret[0].(*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventByID indicates an expected call of GetEventByID.
+func (mr *MockEventRepositoryForDashboardMockRecorder) GetEventByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByID", reflect.TypeOf((*MockEventRepositoryForDashboard)(nil).GetEventByID), ctx, id)
+}
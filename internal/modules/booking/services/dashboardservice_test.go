@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	_errors "booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestDashboardService_RefreshSalesStats(t *testing.T) {
+	t.Parallel()
+	lastRefreshedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		mockStatsRepo func(ctrl *gomock.Controller) *MockSalesStatsRepository
+		expectedError error
+	}{
+		{
+			name: "First refresh covers every event",
+			mockStatsRepo: func(ctrl *gomock.Controller) *MockSalesStatsRepository {
+				mock := NewMockSalesStatsRepository(ctrl)
+				mock.EXPECT().GetLastRefreshedAt(gomock.Any()).Return(time.Time{}, nil)
+				mock.EXPECT().RefreshSalesStats(gomock.Any(), time.Time{}).Return(3, nil)
+				return mock
+			},
+		},
+		{
+			name: "Later refreshes look back from the last refresh",
+			mockStatsRepo: func(ctrl *gomock.Controller) *MockSalesStatsRepository {
+				mock := NewMockSalesStatsRepository(ctrl)
+				mock.EXPECT().GetLastRefreshedAt(gomock.Any()).Return(lastRefreshedAt, nil)
+				mock.EXPECT().RefreshSalesStats(gomock.Any(), lastRefreshedAt.Add(-5*time.Minute)).Return(1, nil)
+				return mock
+			},
+		},
+		{
+			name: "Refresh failure",
+			mockStatsRepo: func(ctrl *gomock.Controller) *MockSalesStatsRepository {
+				mock := NewMockSalesStatsRepository(ctrl)
+				mock.EXPECT().GetLastRefreshedAt(gomock.Any()).Return(lastRefreshedAt, nil)
+				mock.EXPECT().RefreshSalesStats(gomock.Any(), gomock.Any()).Return(0, assert.AnError)
+				return mock
+			},
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewDashboardService(tt.mockStatsRepo(ctrl), NewMockEventRepositoryForDashboard(ctrl), time.Now, DashboardConfig{RefreshLookback: 5 * time.Minute})
+			err := service.RefreshSalesStats(context.Background())
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDashboardService_GetSalesTimeSeries(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		query         model.SalesTimeSeriesQuery
+		mockEventRepo func(ctrl *gomock.Controller) *MockEventRepositoryForDashboard
+		mockStatsRepo func(ctrl *gomock.Controller) *MockSalesStatsRepository
+		expectedError error
+	}{
+		{
+			name:  "Defaults to hourly buckets over the max range",
+			query: model.SalesTimeSeriesQuery{EventID: 1, ExecutorID: 2},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForDashboard {
+				mock := NewMockEventRepositoryForDashboard(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, CreatorID: 2}, nil)
+				return mock
+			},
+			mockStatsRepo: func(ctrl *gomock.Controller) *MockSalesStatsRepository {
+				mock := NewMockSalesStatsRepository(ctrl)
+				mock.EXPECT().GetSalesTimeSeries(gomock.Any(), model.SalesTimeSeriesQuery{
+					EventID:     1,
+					ExecutorID:  2,
+					Granularity: model.SalesGranularityHour,
+					From:        now.Add(-24 * time.Hour),
+					To:          now,
+				}).Return([]model.SalesDataPoint{{Bucket: now.Add(-time.Hour), TicketsSold: 2, GrossRevenue: 200}}, nil)
+				return mock
+			},
+		},
+		{
+			name:  "Daily buckets within the requested range",
+			query: model.SalesTimeSeriesQuery{EventID: 1, ExecutorID: 2, Granularity: model.SalesGranularityDay, From: now.Add(-2 * time.Hour), To: now},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForDashboard {
+				mock := NewMockEventRepositoryForDashboard(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, CreatorID: 2}, nil)
+				return mock
+			},
+			mockStatsRepo: func(ctrl *gomock.Controller) *MockSalesStatsRepository {
+				mock := NewMockSalesStatsRepository(ctrl)
+				mock.EXPECT().GetSalesTimeSeries(gomock.Any(), model.SalesTimeSeriesQuery{
+					EventID:     1,
+					ExecutorID:  2,
+					Granularity: model.SalesGranularityDay,
+					From:        now.Add(-2 * time.Hour),
+					To:          now,
+				}).Return([]model.SalesDataPoint{}, nil)
+				return mock
+			},
+		},
+		{
+			name:  "Not the event creator",
+			query: model.SalesTimeSeriesQuery{EventID: 1, ExecutorID: 3},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForDashboard {
+				mock := NewMockEventRepositoryForDashboard(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, CreatorID: 2}, nil)
+				return mock
+			},
+			mockStatsRepo: func(ctrl *gomock.Controller) *MockSalesStatsRepository {
+				return NewMockSalesStatsRepository(ctrl)
+			},
+			expectedError: _errors.ErrForbidden,
+		},
+		{
+			name:  "Event not found",
+			query: model.SalesTimeSeriesQuery{EventID: 1, ExecutorID: 2},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForDashboard {
+				mock := NewMockEventRepositoryForDashboard(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(nil, _errors.ErrNotFound)
+				return mock
+			},
+			mockStatsRepo: func(ctrl *gomock.Controller) *MockSalesStatsRepository {
+				return NewMockSalesStatsRepository(ctrl)
+			},
+			expectedError: _errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewDashboardService(tt.mockStatsRepo(ctrl), tt.mockEventRepo(ctrl), func() time.Time { return now }, DashboardConfig{MaxTimeSeriesRange: 24 * time.Hour})
+			points, err := service.GetSalesTimeSeries(context.Background(), tt.query)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, points)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, points)
+			}
+		})
+	}
+}
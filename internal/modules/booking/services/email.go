@@ -1,28 +1,94 @@
+//go:generate mockgen -source=email.go -destination=email_mock.go -package=services
 package services
 
 import (
 	"booking-event/internal/modules/booking/model"
 	"context"
+	"log"
 )
 
 type EmailService struct {
 	bookingRepo BookingRepository
+	eventRepo   EventRepository
+	userRepo    UserRepositoryForBooking
 	emailClient BookingEmailRepository
 }
 
 type BookingEmailRepository interface {
 	SendReminderEmail(ctx context.Context, task model.SendReminderEmailTask) error
 	SendConfirmationEmail(ctx context.Context, task model.SendConfirmationEmailTask) error
+	SendCancellationEmail(ctx context.Context, task model.SendCancellationEmailTask) error
+	SendEventReviewEmail(ctx context.Context, task model.SendEventReviewEmailTask) error
+	SendEventChangeEmail(ctx context.Context, user model.User, event model.Event, booking model.Booking) error
 }
 
-func NewEmailService(bookingRepo BookingRepository, emailClient BookingEmailRepository) *EmailService {
-	return &EmailService{bookingRepo: bookingRepo, emailClient: emailClient}
+func NewEmailService(
+	bookingRepo BookingRepository,
+	eventRepo EventRepository,
+	userRepo UserRepositoryForBooking,
+	emailClient BookingEmailRepository,
+) *EmailService {
+	return &EmailService{bookingRepo: bookingRepo, eventRepo: eventRepo, userRepo: userRepo, emailClient: emailClient}
 }
 
+// SendReminderEmail sends the reminder scheduled at the confirmation, unless the booking was canceled since.
 func (s *EmailService) SendReminderEmail(ctx context.Context, task model.SendReminderEmailTask) error {
+	booking, err := s.bookingRepo.GetBookingByID(ctx, task.Booking.ID)
+	if err != nil {
+		return err
+	}
+	if booking.Status != model.BookingStatusConfirmed && booking.Status != model.BookingStatusPaid {
+		log.Println("skipping reminder of booking", booking.ID, booking.Status)
+		return nil
+	}
+	// a reminder of an event moved or canceled since is replaced by the sync of the change, or has no reason to be
+	event, err := s.eventRepo.GetEventByID(ctx, booking.EventID)
+	if err != nil {
+		return err
+	}
+	if event.Sequence != task.Event.Sequence || event.Status != model.EventStatusActive {
+		log.Println("skipping outdated reminder of booking", booking.ID, task.Event.Sequence, event.Sequence)
+		return nil
+	}
+	task.Event = *event
+	task.Booking = *booking
 	return s.emailClient.SendReminderEmail(ctx, task)
 }
 
 func (s *EmailService) SendConfirmationEmail(ctx context.Context, task model.SendConfirmationEmailTask) error {
 	return s.emailClient.SendConfirmationEmail(ctx, task)
 }
+
+func (s *EmailService) SendCancellationEmail(ctx context.Context, task model.SendCancellationEmailTask) error {
+	return s.emailClient.SendCancellationEmail(ctx, task)
+}
+
+func (s *EmailService) SendEventReviewEmail(ctx context.Context, task model.SendEventReviewEmailTask) error {
+	return s.emailClient.SendEventReviewEmail(ctx, task)
+}
+
+// SendEventChangeEmail sends the event as it is now to a booking still confirmed or paid. A task of an older change
+// is dropped, the email of the newer one carries the same state with a higher sequence.
+func (s *EmailService) SendEventChangeEmail(ctx context.Context, task model.SendEventChangeEmailTask) error {
+	booking, err := s.bookingRepo.GetBookingByID(ctx, task.BookingID)
+	if err != nil {
+		return err
+	}
+	if booking.Status != model.BookingStatusConfirmed && booking.Status != model.BookingStatusPaid {
+		log.Println("skipping event change of booking", booking.ID, booking.Status)
+		return nil
+	}
+	event, err := s.eventRepo.GetEventByID(ctx, booking.EventID)
+	if err != nil {
+		return err
+	}
+	if task.Sequence < event.Sequence {
+		log.Println("skipping outdated event change of booking", booking.ID, task.Sequence, event.Sequence)
+		return nil
+	}
+	user, err := s.userRepo.GetUserByID(ctx, booking.UserID)
+	if err != nil {
+		return err
+	}
+	return s.emailClient.SendEventChangeEmail(ctx, *user, *event, *booking)
+}
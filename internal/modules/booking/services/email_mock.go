@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: email.go
+//
+// Generated by this command:
+//
+//	mockgen -source=email.go -destination=email_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBookingEmailRepository is a mock of BookingEmailRepository interface.
+type MockBookingEmailRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingEmailRepositoryMockRecorder
+}
+
+// MockBookingEmailRepositoryMockRecorder is the mock recorder for MockBookingEmailRepository.
+type MockBookingEmailRepositoryMockRecorder struct {
+	mock *MockBookingEmailRepository
+}
+
+// NewMockBookingEmailRepository creates a new mock instance.
+func NewMockBookingEmailRepository(ctrl *gomock.Controller) *MockBookingEmailRepository {
+	mock := &MockBookingEmailRepository{ctrl: ctrl}
+	mock.recorder = &MockBookingEmailRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingEmailRepository) EXPECT() *MockBookingEmailRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SendCancellationEmail mocks base method.
+func (m *MockBookingEmailRepository) SendCancellationEmail(ctx context.Context, task model.SendCancellationEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCancellationEmail", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCancellationEmail indicates an expected call of SendCancellationEmail.
+func (mr *MockBookingEmailRepositoryMockRecorder) SendCancellationEmail(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCancellationEmail", reflect.TypeOf((*MockBookingEmailRepository)(nil).SendCancellationEmail), ctx, task)
+}
+
+// SendConfirmationEmail mocks base method.
+func (m *MockBookingEmailRepository) SendConfirmationEmail(ctx context.Context, task model.SendConfirmationEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendConfirmationEmail", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendConfirmationEmail indicates an expected call of SendConfirmationEmail.
+func (mr *MockBookingEmailRepositoryMockRecorder) SendConfirmationEmail(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendConfirmationEmail", reflect.TypeOf((*MockBookingEmailRepository)(nil).SendConfirmationEmail), ctx, task)
+}
+
+// SendEventChangeEmail mocks base method.
+func (m *MockBookingEmailRepository) SendEventChangeEmail(ctx context.Context, user model.User, event model.Event, booking model.Booking) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEventChangeEmail", ctx, user, event, booking)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEventChangeEmail indicates an expected call of SendEventChangeEmail.
+func (mr *MockBookingEmailRepositoryMockRecorder) SendEventChangeEmail(ctx, user, event, booking any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEventChangeEmail", reflect.TypeOf((*MockBookingEmailRepository)(nil).SendEventChangeEmail), ctx, user, event, booking)
+}
+
+// SendEventReviewEmail mocks base method.
+func (m *MockBookingEmailRepository) SendEventReviewEmail(ctx context.Context, task model.SendEventReviewEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEventReviewEmail", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEventReviewEmail indicates an expected call of SendEventReviewEmail.
+func (mr *MockBookingEmailRepositoryMockRecorder) SendEventReviewEmail(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEventReviewEmail", reflect.TypeOf((*MockBookingEmailRepository)(nil).SendEventReviewEmail), ctx, task)
+}
+
+// SendReminderEmail mocks base method.
+func (m *MockBookingEmailRepository) SendReminderEmail(ctx context.Context, task model.SendReminderEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendReminderEmail", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendReminderEmail indicates an expected call of SendReminderEmail.
+func (mr *MockBookingEmailRepositoryMockRecorder) SendReminderEmail(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendReminderEmail", reflect.TypeOf((*MockBookingEmailRepository)(nil).SendReminderEmail), ctx, task)
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestEmailService_SendEventChangeEmail(t *testing.T) {
+	t.Parallel()
+	booking := &model.Booking{ID: 7, EventID: 3, UserID: 5, Quantity: 2, Status: model.BookingStatusConfirmed}
+	event := &model.Event{ID: 3, Name: "Go Conf", Status: model.EventStatusInactive, Sequence: 2}
+	user := &model.User{ID: 5, Email: "user@example.com"}
+
+	tests := []struct {
+		name            string
+		task            model.SendEventChangeEmailTask
+		mockBookingRepo func(ctrl *gomock.Controller) *MockBookingRepository
+		mockEventRepo   func(ctrl *gomock.Controller) *MockEventRepository
+		mockUserRepo    func(ctrl *gomock.Controller) *MockUserRepositoryForBooking
+		mockEmailClient func(ctrl *gomock.Controller) *MockBookingEmailRepository
+		expectedError   error
+	}{
+		{
+			name: "Change sent",
+			task: model.SendEventChangeEmailTask{BookingID: 7, Sequence: 2},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(booking, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				mock := NewMockEventRepository(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 3).Return(event, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				mock := NewMockUserRepositoryForBooking(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 5).Return(user, nil)
+				return mock
+			},
+			mockEmailClient: func(ctrl *gomock.Controller) *MockBookingEmailRepository {
+				mock := NewMockBookingEmailRepository(ctrl)
+				mock.EXPECT().SendEventChangeEmail(gomock.Any(), *user, *event, *booking).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Canceled booking skipped",
+			task: model.SendEventChangeEmailTask{BookingID: 7, Sequence: 2},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(&model.Booking{ID: 7, Status: model.BookingStatusCanceled}, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				return NewMockEventRepository(ctrl)
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				return NewMockUserRepositoryForBooking(ctrl)
+			},
+			mockEmailClient: func(ctrl *gomock.Controller) *MockBookingEmailRepository {
+				return NewMockBookingEmailRepository(ctrl)
+			},
+		},
+		{
+			name: "Outdated change skipped",
+			task: model.SendEventChangeEmailTask{BookingID: 7, Sequence: 1},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(booking, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				mock := NewMockEventRepository(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 3).Return(event, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				return NewMockUserRepositoryForBooking(ctrl)
+			},
+			mockEmailClient: func(ctrl *gomock.Controller) *MockBookingEmailRepository {
+				return NewMockBookingEmailRepository(ctrl)
+			},
+		},
+		{
+			name: "Booking error",
+			task: model.SendEventChangeEmailTask{BookingID: 7, Sequence: 2},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(nil, assert.AnError)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				return NewMockEventRepository(ctrl)
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				return NewMockUserRepositoryForBooking(ctrl)
+			},
+			mockEmailClient: func(ctrl *gomock.Controller) *MockBookingEmailRepository {
+				return NewMockBookingEmailRepository(ctrl)
+			},
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewEmailService(tt.mockBookingRepo(ctrl), tt.mockEventRepo(ctrl), tt.mockUserRepo(ctrl), tt.mockEmailClient(ctrl))
+			err := service.SendEventChangeEmail(context.Background(), tt.task)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEmailService_SendReminderEmail(t *testing.T) {
+	t.Parallel()
+	booking := &model.Booking{ID: 7, EventID: 3, UserID: 5, Quantity: 2, Status: model.BookingStatusConfirmed}
+	event := &model.Event{ID: 3, Name: "Go Conf", Status: model.EventStatusActive, Sequence: 2}
+	user := model.User{ID: 5, Email: "user@example.com"}
+
+	tests := []struct {
+		name            string
+		task            model.SendReminderEmailTask
+		mockBookingRepo func(ctrl *gomock.Controller) *MockBookingRepository
+		mockEventRepo   func(ctrl *gomock.Controller) *MockEventRepository
+		mockEmailClient func(ctrl *gomock.Controller) *MockBookingEmailRepository
+		expectedError   error
+	}{
+		{
+			name: "Reminder sent with the current event",
+			task: model.SendReminderEmailTask{User: user, Event: model.Event{ID: 3, Name: "Old name", Sequence: 2}, Booking: model.Booking{ID: 7}},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(booking, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				mock := NewMockEventRepository(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 3).Return(event, nil)
+				return mock
+			},
+			mockEmailClient: func(ctrl *gomock.Controller) *MockBookingEmailRepository {
+				mock := NewMockBookingEmailRepository(ctrl)
+				mock.EXPECT().SendReminderEmail(gomock.Any(), model.SendReminderEmailTask{User: user, Event: *event, Booking: *booking}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Reminder of a moved event skipped",
+			task: model.SendReminderEmailTask{User: user, Event: model.Event{ID: 3, Sequence: 1}, Booking: model.Booking{ID: 7}},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(booking, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				mock := NewMockEventRepository(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 3).Return(event, nil)
+				return mock
+			},
+			mockEmailClient: func(ctrl *gomock.Controller) *MockBookingEmailRepository {
+				return NewMockBookingEmailRepository(ctrl)
+			},
+		},
+		{
+			name: "Reminder of an inactive event skipped",
+			task: model.SendReminderEmailTask{User: user, Event: model.Event{ID: 3, Sequence: 2}, Booking: model.Booking{ID: 7}},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(booking, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				mock := NewMockEventRepository(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 3).Return(&model.Event{ID: 3, Status: model.EventStatusInactive, Sequence: 2}, nil)
+				return mock
+			},
+			mockEmailClient: func(ctrl *gomock.Controller) *MockBookingEmailRepository {
+				return NewMockBookingEmailRepository(ctrl)
+			},
+		},
+		{
+			name: "Canceled booking skipped",
+			task: model.SendReminderEmailTask{User: user, Event: model.Event{ID: 3, Sequence: 2}, Booking: model.Booking{ID: 7}},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(&model.Booking{ID: 7, Status: model.BookingStatusCanceled}, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				return NewMockEventRepository(ctrl)
+			},
+			mockEmailClient: func(ctrl *gomock.Controller) *MockBookingEmailRepository {
+				return NewMockBookingEmailRepository(ctrl)
+			},
+		},
+		{
+			name: "Event error",
+			task: model.SendReminderEmailTask{User: user, Event: model.Event{ID: 3, Sequence: 2}, Booking: model.Booking{ID: 7}},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(booking, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				mock := NewMockEventRepository(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 3).Return(nil, assert.AnError)
+				return mock
+			},
+			mockEmailClient: func(ctrl *gomock.Controller) *MockBookingEmailRepository {
+				return NewMockBookingEmailRepository(ctrl)
+			},
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewEmailService(tt.mockBookingRepo(ctrl), tt.mockEventRepo(ctrl), NewMockUserRepositoryForBooking(ctrl), tt.mockEmailClient(ctrl))
+			err := service.SendReminderEmail(context.Background(), tt.task)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
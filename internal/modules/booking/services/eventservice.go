@@ -2,33 +2,85 @@
 package services
 
 import (
+	"bufio"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"slices"
+	"time"
 
 	"github.com/Rhymond/go-money"
 
+	_errors "booking-event/internal/common/errors"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/infra/blobstorage"
 	"booking-event/internal/modules/booking/model"
 )
 
+// imageExtensions are the accepted image content types, sniffed from the uploaded bytes.
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
 type EventRepository interface {
 	GetEventByID(ctx context.Context, id int) (*model.Event, error)
-	QueryEvents(ctx context.Context, query model.EventQuery) ([]model.Event, error)
-	CreateEvent(ctx context.Context, event model.Event, tokens []model.EventToken) error
-	UpdateEvent(ctx context.Context, event model.Event) error
+	QueryEvents(ctx context.Context, query model.EventQuery) (*commonmodel.Page[model.Event], error)
+	CreateEvent(ctx context.Context, event *model.Event, tokens []model.EventToken) error
+	UpdateEventAndNotifyBookings(ctx context.Context, event model.Event, taskTypes []model.TaskType, messages []model.OutboxMessage) error
 }
 
 type EventTokenServiceForEvent interface {
 	CreateEventToken(ctx context.Context, eventID int, userID int) (string, error)
 }
 
+type CategoryRepositoryForEvent interface {
+	GetCategoriesBySlugs(ctx context.Context, slugs []model.EventCategory) ([]model.Category, error)
+}
+
+type EventImageRepository interface {
+	CreateEventImage(ctx context.Context, image *model.EventImage) error
+	GetEventImagesByEventID(ctx context.Context, eventID int) ([]model.EventImage, error)
+	GetEventImageByID(ctx context.Context, id int) (*model.EventImage, error)
+	DeleteEventImage(ctx context.Context, id int) error
+}
+
+type EventConfig struct {
+	Currency     string
+	MaxImageSize int64
+}
+
 type EventService struct {
-	eventRepo EventRepository
-	currency  string
-	uuidFn    func() string
+	eventRepo    EventRepository
+	categoryRepo CategoryRepositoryForEvent
+	imageRepo    EventImageRepository
+	blobStorage  blobstorage.BlobStorage
+	uuidFn       func() string
+	cfg          EventConfig
 }
 
-func NewEventService(eventRepo EventRepository, currency string, uuidFn func() string) *EventService {
-	return &EventService{eventRepo: eventRepo, currency: currency, uuidFn: uuidFn}
+func NewEventService(
+	eventRepo EventRepository,
+	categoryRepo CategoryRepositoryForEvent,
+	imageRepo EventImageRepository,
+	blobStorage blobstorage.BlobStorage,
+	uuidFn func() string,
+	cfg EventConfig,
+) *EventService {
+	return &EventService{
+		eventRepo:    eventRepo,
+		categoryRepo: categoryRepo,
+		imageRepo:    imageRepo,
+		blobStorage:  blobStorage,
+		uuidFn:       uuidFn,
+		cfg:          cfg,
+	}
 }
 
 func (s *EventService) RetrieveEventDetail(ctx context.Context, eventID int) (*model.Event, error) {
@@ -36,10 +88,14 @@ func (s *EventService) RetrieveEventDetail(ctx context.Context, eventID int) (*m
 	if err != nil {
 		return nil, err
 	}
+	event.Images, err = s.imageRepo.GetEventImagesByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
 	return event, nil
 }
 
-func (s *EventService) QueryEvents(ctx context.Context, query model.EventQuery) ([]model.Event, error) {
+func (s *EventService) QueryEvents(ctx context.Context, query model.EventQuery) (*commonmodel.Page[model.Event], error) {
 	events, err := s.eventRepo.QueryEvents(ctx, query)
 	if err != nil {
 		return nil, err
@@ -47,26 +103,104 @@ func (s *EventService) QueryEvents(ctx context.Context, query model.EventQuery)
 	return events, nil
 }
 
-func (s *EventService) CreateEvent(ctx context.Context, params model.CreateEventRequest) error {
-	m := money.NewFromFloat(params.Price, s.currency)
-	event := model.Event{
-		Name:           params.Name,
-		AvailableSeats: params.AvailableSeats,
-		StartAt:        params.StartAt,
-		Location:       params.Location,
-		Category:       params.Category,
-		Status:         model.EventStatusInactive,
-		Currency:       s.currency,
-		Price:          m.Amount(),
-		CreatorID:      params.ExecutorID,
-	}
-	tokens := make([]model.EventToken, params.AvailableSeats)
-	for i := 0; i < params.AvailableSeats; i++ {
+func (s *EventService) CreateEvent(ctx context.Context, params model.CreateEventRequest) (*model.Event, error) {
+	m := money.NewFromFloat(params.Price, s.cfg.Currency)
+	event := &model.Event{
+		Name:            params.Name,
+		AvailableSeats:  params.AvailableSeats,
+		StartAt:         params.StartAt,
+		Location:        params.Location,
+		Categories:      params.Categories,
+		Description:     params.Description,
+		Tags:            params.Tags,
+		MinAge:          params.MinAge,
+		Currency:        s.cfg.Currency,
+		Price:           m.Amount(),
+		CreatorID:       params.ExecutorID,
+		Latitude:        params.Latitude,
+		Longitude:       params.Longitude,
+		ReminderMinutes: params.ReminderMinutes,
+	}
+	if err := s.CreateDraftEvent(ctx, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// CreateDraftEvent stores the event as an inactive draft and mints a fresh token for each of its seats.
+func (s *EventService) CreateDraftEvent(ctx context.Context, event *model.Event) error {
+	categories, err := s.categoryRepo.GetCategoriesBySlugs(ctx, event.Categories)
+	if err != nil {
+		return err
+	}
+	event.Categories = uniqueCategories(event.Categories)
+	if len(categories) != len(event.Categories) {
+		return model.ErrUnknownCategory
+	}
+
+	event.Status = model.EventStatusInactive
+	event.ReviewStatus = model.EventReviewStatusDraft
+	if event.Currency == "" {
+		event.Currency = s.cfg.Currency
+	}
+	if event.Tags == nil {
+		event.Tags = []string{}
+	}
+	tokens := make([]model.EventToken, event.AvailableSeats)
+	for i := 0; i < event.AvailableSeats; i++ {
 		tokens[i] = model.EventToken{EventID: event.ID, Token: s.uuidFn(), Status: model.TokenStatusActive}
 	}
 	return s.eventRepo.CreateEvent(ctx, event, tokens)
 }
 
+// DuplicateEvent clones the configuration and images of an event into a new draft starting at another time.
+func (s *EventService) DuplicateEvent(ctx context.Context, params model.DuplicateEventRequest) (*model.Event, error) {
+	source, err := s.eventRepo.GetEventByID(ctx, params.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if source.CreatorID != params.ExecutorID {
+		return nil, _errors.ErrForbidden
+	}
+	images, err := s.imageRepo.GetEventImagesByEventID(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := source.TemplateContent().NewEvent(params.StartAt, params.ExecutorID)
+	if params.Name != "" {
+		event.Name = params.Name
+	}
+	if err := s.CreateDraftEvent(ctx, event); err != nil {
+		return nil, err
+	}
+
+	// the draft is usable without its images, a failed copy is reported but does not undo the duplication
+	event.Images = make([]model.EventImage, 0, len(images))
+	for _, image := range images {
+		key := fmt.Sprintf("events/%d/%s%s", event.ID, s.uuidFn(), path.Ext(image.StorageKey))
+		object, err := s.blobStorage.Copy(ctx, image.StorageKey, key, image.ContentType)
+		if err != nil {
+			log.Println("error copying event image", image.ID, err)
+			continue
+		}
+		copied := model.EventImage{
+			EventID:     event.ID,
+			StorageKey:  object.Key,
+			URL:         object.URL,
+			ContentType: object.ContentType,
+			Size:        object.Size,
+		}
+		if err := s.imageRepo.CreateEventImage(ctx, &copied); err != nil {
+			log.Println("error creating event image", image.ID, err)
+			_ = s.blobStorage.Delete(ctx, object.Key)
+			continue
+		}
+		event.Images = append(event.Images, copied)
+	}
+	return event, nil
+}
+
 func (s *EventService) UpdateEvent(ctx context.Context, params model.UpdateEventRequest) error {
 	event, err := s.eventRepo.GetEventByID(ctx, params.EventID)
 	if err != nil {
@@ -75,6 +209,119 @@ func (s *EventService) UpdateEvent(ctx context.Context, params model.UpdateEvent
 	if event.CreatorID != params.ExecutorID {
 		return errors.New("unauthorized to update this event")
 	}
-	event.Status = params.Status
-	return s.eventRepo.UpdateEvent(ctx, *event)
+	if params.Status == model.EventStatusActive && event.ReviewStatus != model.EventReviewStatusApproved {
+		return model.ErrEventNotApproved
+	}
+
+	var taskTypes []model.TaskType
+	switch {
+	case params.Status != "" && params.Status != event.Status, params.StartAt != nil && !params.StartAt.Equal(event.StartAt):
+		// the attendees get the change with a higher sequence so their calendars replace or cancel the entry
+		taskTypes = []model.TaskType{model.TaskTypeSendEventChangeEmail, model.TaskTypeSyncReminders}
+	case params.ReminderMinutes != nil && (event.ReminderMinutes == nil || !slices.Equal(*params.ReminderMinutes, event.ReminderMinutes)):
+		taskTypes = []model.TaskType{model.TaskTypeSyncReminders}
+	case params.ResetReminderMinutes && event.ReminderMinutes != nil:
+		taskTypes = []model.TaskType{model.TaskTypeSyncReminders}
+	}
+	if params.Status != "" {
+		event.Status = params.Status
+	}
+	if params.StartAt != nil {
+		event.StartAt = *params.StartAt
+	}
+	if params.ReminderMinutes != nil {
+		event.ReminderMinutes = *params.ReminderMinutes
+	}
+	if params.ResetReminderMinutes {
+		event.ReminderMinutes = nil
+	}
+	if len(taskTypes) > 0 {
+		event.Sequence++
+	}
+	webhookEvent, err := model.NewWebhookEventMessage(model.WebhookEventEventUpdated, event.ID, s.uuidFn(), event, time.Now())
+	if err != nil {
+		return err
+	}
+	return s.eventRepo.UpdateEventAndNotifyBookings(ctx, *event, taskTypes, []model.OutboxMessage{*webhookEvent})
+}
+
+func (s *EventService) UploadEventImage(ctx context.Context, params model.UploadEventImageRequest) (*model.EventImage, error) {
+	event, err := s.eventRepo.GetEventByID(ctx, params.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.CreatorID != params.ExecutorID {
+		return nil, _errors.ErrForbidden
+	}
+	if params.Size > s.cfg.MaxImageSize {
+		return nil, model.ErrImageTooLarge
+	}
+
+	// the declared content type can not be trusted, sniff the first bytes instead
+	content := bufio.NewReader(io.LimitReader(params.Content, s.cfg.MaxImageSize+1))
+	head, err := content.Peek(512)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	contentType := http.DetectContentType(head)
+	extension, ok := imageExtensions[contentType]
+	if !ok {
+		return nil, model.ErrUnsupportedImageType
+	}
+
+	key := fmt.Sprintf("events/%d/%s%s", event.ID, s.uuidFn(), extension)
+	object, err := s.blobStorage.Put(ctx, key, content, contentType)
+	if err != nil {
+		return nil, err
+	}
+	if object.Size > s.cfg.MaxImageSize {
+		_ = s.blobStorage.Delete(ctx, object.Key)
+		return nil, model.ErrImageTooLarge
+	}
+
+	image := &model.EventImage{
+		EventID:     event.ID,
+		StorageKey:  object.Key,
+		URL:         object.URL,
+		ContentType: object.ContentType,
+		Size:        object.Size,
+	}
+	if err := s.imageRepo.CreateEventImage(ctx, image); err != nil {
+		_ = s.blobStorage.Delete(ctx, object.Key)
+		return nil, err
+	}
+	return image, nil
+}
+
+func (s *EventService) DeleteEventImage(ctx context.Context, params model.DeleteEventImageRequest) error {
+	event, err := s.eventRepo.GetEventByID(ctx, params.EventID)
+	if err != nil {
+		return err
+	}
+	if event.CreatorID != params.ExecutorID {
+		return _errors.ErrForbidden
+	}
+	image, err := s.imageRepo.GetEventImageByID(ctx, params.ImageID)
+	if err != nil {
+		return err
+	}
+	if image.EventID != event.ID {
+		return _errors.ErrNotFound
+	}
+	if err := s.imageRepo.DeleteEventImage(ctx, image.ID); err != nil {
+		return err
+	}
+	return s.blobStorage.Delete(ctx, image.StorageKey)
+}
+
+func uniqueCategories(categories []model.EventCategory) []model.EventCategory {
+	seen := make(map[model.EventCategory]bool, len(categories))
+	out := make([]model.EventCategory, 0, len(categories))
+	for _, category := range categories {
+		if !seen[category] {
+			seen[category] = true
+			out = append(out, category)
+		}
+	}
+	return out
 }
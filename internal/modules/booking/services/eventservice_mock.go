@@ -10,7 +10,8 @@
 package services
 
 import (
-	model "booking-event/internal/modules/booking/model"
+	model "booking-event/internal/common/model"
+	model0 "booking-event/internal/modules/booking/model"
 	context "context"
 	reflect "reflect"
 
@@ -41,7 +42,7 @@ func (m *MockEventRepository) EXPECT() *MockEventRepositoryMockRecorder {
 }
 
 // CreateEvent mocks base method.
-func (m *MockEventRepository) CreateEvent(ctx context.Context, event model.Event, tokens []model.EventToken) error {
+func (m *MockEventRepository) CreateEvent(ctx context.Context, event *model0.Event, tokens []model0.EventToken) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateEvent", ctx, event, tokens)
 	ret0, _ := ret[0].(error)
@@ -55,10 +56,10 @@ func (mr *MockEventRepositoryMockRecorder) CreateEvent(ctx, event, tokens any) *
 }
 
 // GetEventByID mocks base method.
-func (m *MockEventRepository) GetEventByID(ctx context.Context, id int) (*model.Event, error) {
+func (m *MockEventRepository) GetEventByID(ctx context.Context, id int) (*model0.Event, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEventByID", ctx, id)
-	ret0, _ := ret[0].(*model.Event)
+	ret0, _ := ret[0].(*model0.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -70,10 +71,10 @@ func (mr *MockEventRepositoryMockRecorder) GetEventByID(ctx, id any) *gomock.Cal
 }
 
 // QueryEvents mocks base method.
-func (m *MockEventRepository) QueryEvents(ctx context.Context, query model.EventQuery) ([]model.Event, error) {
+func (m *MockEventRepository) QueryEvents(ctx context.Context, query model0.EventQuery) (*model.Page[model0.Event], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryEvents", ctx, query)
-	ret0, _ := ret[0].([]model.Event)
+	ret0, _ := ret[0].(*model.Page[model0.Event])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -84,18 +85,18 @@ func (mr *MockEventRepositoryMockRecorder) QueryEvents(ctx, query any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryEvents", reflect.TypeOf((*MockEventRepository)(nil).QueryEvents), ctx, query)
 }
 
-// UpdateEvent mocks base method.
-func (m *MockEventRepository) UpdateEvent(ctx context.Context, event model.Event) error {
+// UpdateEventAndNotifyBookings mocks base method.
+func (m *MockEventRepository) UpdateEventAndNotifyBookings(ctx context.Context, event model0.Event, taskTypes []model0.TaskType, messages []model0.OutboxMessage) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEvent", ctx, event)
+	ret := m.ctrl.Call(m, "UpdateEventAndNotifyBookings", ctx, event, taskTypes, messages)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateEvent indicates an expected call of UpdateEvent.
-func (mr *MockEventRepositoryMockRecorder) UpdateEvent(ctx, event any) *gomock.Call {
+// UpdateEventAndNotifyBookings indicates an expected call of UpdateEventAndNotifyBookings.
+func (mr *MockEventRepositoryMockRecorder) UpdateEventAndNotifyBookings(ctx, event, taskTypes, messages any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventRepository)(nil).UpdateEvent), ctx, event)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventAndNotifyBookings", reflect.TypeOf((*MockEventRepository)(nil).UpdateEventAndNotifyBookings), ctx, event, taskTypes, messages)
 }
 
 // MockEventTokenServiceForEvent is a mock of EventTokenServiceForEvent interface.
@@ -135,3 +136,122 @@ func (mr *MockEventTokenServiceForEventMockRecorder) CreateEventToken(ctx, event
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventToken", reflect.TypeOf((*MockEventTokenServiceForEvent)(nil).CreateEventToken), ctx, eventID, userID)
 }
+
+// MockCategoryRepositoryForEvent is a mock of CategoryRepositoryForEvent interface.
+type MockCategoryRepositoryForEvent struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryRepositoryForEventMockRecorder
+}
+
+// MockCategoryRepositoryForEventMockRecorder is the mock recorder for MockCategoryRepositoryForEvent.
+type MockCategoryRepositoryForEventMockRecorder struct {
+	mock *MockCategoryRepositoryForEvent
+}
+
+// NewMockCategoryRepositoryForEvent creates a new mock instance.
+func NewMockCategoryRepositoryForEvent(ctrl *gomock.Controller) *MockCategoryRepositoryForEvent {
+	mock := &MockCategoryRepositoryForEvent{ctrl: ctrl}
+	mock.recorder = &MockCategoryRepositoryForEventMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryRepositoryForEvent) EXPECT() *MockCategoryRepositoryForEventMockRecorder {
+	return m.recorder
+}
+
+// GetCategoriesBySlugs mocks base method.
+func (m *MockCategoryRepositoryForEvent) GetCategoriesBySlugs(ctx context.Context, slugs []model0.EventCategory) ([]model0.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoriesBySlugs", ctx, slugs)
+	ret0, _ := ret[0].([]model0.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoriesBySlugs indicates an expected call of GetCategoriesBySlugs.
+func (mr *MockCategoryRepositoryForEventMockRecorder) GetCategoriesBySlugs(ctx, slugs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoriesBySlugs", reflect.TypeOf((*MockCategoryRepositoryForEvent)(nil).GetCategoriesBySlugs), ctx, slugs)
+}
+
+// MockEventImageRepository is a mock of EventImageRepository interface.
+type MockEventImageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventImageRepositoryMockRecorder
+}
+
+// MockEventImageRepositoryMockRecorder is the mock recorder for MockEventImageRepository.
+type MockEventImageRepositoryMockRecorder struct {
+	mock *MockEventImageRepository
+}
+
+// NewMockEventImageRepository creates a new mock instance.
+func NewMockEventImageRepository(ctrl *gomock.Controller) *MockEventImageRepository {
+	mock := &MockEventImageRepository{ctrl: ctrl}
+	mock.recorder = &MockEventImageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventImageRepository) EXPECT() *MockEventImageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateEventImage mocks base method.
+func (m *MockEventImageRepository) CreateEventImage(ctx context.Context, image *model0.EventImage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventImage", ctx, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEventImage indicates an expected call of CreateEventImage.
+func (mr *MockEventImageRepositoryMockRecorder) CreateEventImage(ctx, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventImage", reflect.TypeOf((*MockEventImageRepository)(nil).CreateEventImage), ctx, image)
+}
+
+// DeleteEventImage mocks base method.
+func (m *MockEventImageRepository) DeleteEventImage(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventImage", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventImage indicates an expected call of DeleteEventImage.
+func (mr *MockEventImageRepositoryMockRecorder) DeleteEventImage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventImage", reflect.TypeOf((*MockEventImageRepository)(nil).DeleteEventImage), ctx, id)
+}
+
+// GetEventImageByID mocks base method.
+func (m *MockEventImageRepository) GetEventImageByID(ctx context.Context, id int) (*model0.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventImageByID", ctx, id)
+	ret0, _ := ret[0].(*model0.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventImageByID indicates an expected call of GetEventImageByID.
+func (mr *MockEventImageRepositoryMockRecorder) GetEventImageByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventImageByID", reflect.TypeOf((*MockEventImageRepository)(nil).GetEventImageByID), ctx, id)
+}
+
+// GetEventImagesByEventID mocks base method.
+func (m *MockEventImageRepository) GetEventImagesByEventID(ctx context.Context, eventID int) ([]model0.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventImagesByEventID", ctx, eventID)
+	ret0, _ := ret[0].([]model0.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventImagesByEventID indicates an expected call of GetEventImagesByEventID.
+func (mr *MockEventImageRepositoryMockRecorder) GetEventImagesByEventID(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventImagesByEventID", reflect.TypeOf((*MockEventImageRepository)(nil).GetEventImagesByEventID), ctx, eventID)
+}
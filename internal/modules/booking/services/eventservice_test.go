@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestEventService_UpdateEventReminderMinutes(t *testing.T) {
+	t.Parallel()
+	startAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name                    string
+		current                 []int
+		params                  model.UpdateEventRequest
+		expectedReminderMinutes []int
+		expectedTaskTypes       []model.TaskType
+		expectedSequence        int
+	}{
+		{
+			name:                    "Own schedule replaces the global one",
+			params:                  model.UpdateEventRequest{ReminderMinutes: &[]int{30}},
+			expectedReminderMinutes: []int{30},
+			expectedTaskTypes:       []model.TaskType{model.TaskTypeSyncReminders},
+			expectedSequence:        3,
+		},
+		{
+			name:                    "Empty schedule turns the reminders off",
+			current:                 []int{30},
+			params:                  model.UpdateEventRequest{ReminderMinutes: &[]int{}},
+			expectedReminderMinutes: []int{},
+			expectedTaskTypes:       []model.TaskType{model.TaskTypeSyncReminders},
+			expectedSequence:        3,
+		},
+		{
+			name:              "Reset back to the global schedule",
+			current:           []int{30},
+			params:            model.UpdateEventRequest{ResetReminderMinutes: true},
+			expectedTaskTypes: []model.TaskType{model.TaskTypeSyncReminders},
+			expectedSequence:  3,
+		},
+		{
+			name:              "Reset of an event already on the global schedule",
+			params:            model.UpdateEventRequest{ResetReminderMinutes: true},
+			expectedTaskTypes: nil,
+			expectedSequence:  2,
+		},
+		{
+			name:                    "Unchanged schedule",
+			current:                 []int{30},
+			params:                  model.UpdateEventRequest{ReminderMinutes: &[]int{30}},
+			expectedReminderMinutes: []int{30},
+			expectedTaskTypes:       nil,
+			expectedSequence:        2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			eventRepo := NewMockEventRepository(ctrl)
+			eventRepo.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{
+				ID:              1,
+				CreatorID:       2,
+				Status:          model.EventStatusActive,
+				ReviewStatus:    model.EventReviewStatusApproved,
+				StartAt:         startAt,
+				Sequence:        2,
+				ReminderMinutes: tt.current,
+			}, nil)
+			eventRepo.EXPECT().UpdateEventAndNotifyBookings(gomock.Any(), gomock.Any(), tt.expectedTaskTypes, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, event model.Event, taskTypes []model.TaskType, messages []model.OutboxMessage) error {
+					// nil follows the global schedule while an empty list disables the reminders
+					assert.Equal(t, tt.expectedReminderMinutes, event.ReminderMinutes)
+					assert.Equal(t, tt.expectedSequence, event.Sequence)
+					return nil
+				})
+
+			service := NewEventService(eventRepo, nil, nil, nil, func() string { return "webhook-1" }, EventConfig{})
+			params := tt.params
+			params.EventID, params.ExecutorID = 1, 2
+			assert.NoError(t, service.UpdateEvent(context.Background(), params))
+		})
+	}
+}
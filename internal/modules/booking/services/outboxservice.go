@@ -0,0 +1,90 @@
+//go:generate mockgen -source=outboxservice.go -destination=outboxservice_mock.go -package=services
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"booking-event/internal/modules/booking/model"
+)
+
+type OutboxRepository interface {
+	ClaimPendingMessages(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]model.OutboxMessage, error)
+	MarkMessagePublished(ctx context.Context, id int64, publishedAt time.Time) error
+	MarkMessageFailed(ctx context.Context, id int64, lastError string, failedAt time.Time, nextAttemptAt *time.Time) error
+}
+
+type OutboxPublisher interface {
+	PublishOutboxMessage(ctx context.Context, message model.OutboxMessage) error
+}
+
+type OutboxConfig struct {
+	BatchSize   int
+	MaxAttempts int // a message failing this many times is given up
+	// RetryBackoff is the wait before the first retry, it doubles with each attempt up to MaxRetryBackoff.
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	// LeaseDuration is how long the messages claimed by a relay are hidden from the others, it must be longer than
+	// the publishing of a batch.
+	LeaseDuration time.Duration
+}
+
+// OutboxService relays the outbox messages to the task queue. It is run periodically by the worker, each batch is
+// claimed so the relays running at the same time do not publish the same messages, and the queue drops the
+// duplicates left by a relay dying mid-batch by their dedup key.
+type OutboxService struct {
+	outboxRepo OutboxRepository
+	publisher  OutboxPublisher
+	nowFn      func() time.Time
+	cfg        OutboxConfig
+}
+
+func NewOutboxService(outboxRepo OutboxRepository, publisher OutboxPublisher, nowFn func() time.Time, cfg OutboxConfig) *OutboxService {
+	return &OutboxService{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		nowFn:      nowFn,
+		cfg:        cfg,
+	}
+}
+
+// RelayMessages publishes the pending messages batch by batch until none is left.
+func (s *OutboxService) RelayMessages(ctx context.Context) error {
+	for {
+		now := s.nowFn()
+		messages, err := s.outboxRepo.ClaimPendingMessages(ctx, now, now.Add(s.cfg.LeaseDuration), s.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for _, message := range messages {
+			if err := s.relayMessage(ctx, message); err != nil {
+				return err
+			}
+		}
+		if len(messages) == 0 || len(messages) < s.cfg.BatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *OutboxService) relayMessage(ctx context.Context, message model.OutboxMessage) error {
+	publishErr := s.publisher.PublishOutboxMessage(ctx, message)
+	now := s.nowFn()
+	if publishErr == nil {
+		return s.outboxRepo.MarkMessagePublished(ctx, message.ID, now)
+	}
+
+	attempts := message.Attempts + 1
+	if attempts >= s.cfg.MaxAttempts {
+		log.Println("giving up outbox message", message.ID, message.TaskType, publishErr)
+		return s.outboxRepo.MarkMessageFailed(ctx, message.ID, publishErr.Error(), now, nil)
+	}
+	backoff := s.cfg.RetryBackoff << (attempts - 1)
+	if backoff > s.cfg.MaxRetryBackoff || backoff <= 0 {
+		backoff = s.cfg.MaxRetryBackoff
+	}
+	nextAttemptAt := now.Add(backoff)
+	log.Println("error publishing outbox message", message.ID, message.TaskType, publishErr)
+	return s.outboxRepo.MarkMessageFailed(ctx, message.ID, publishErr.Error(), now, &nextAttemptAt)
+}
@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outboxservice.go
+//
+// Generated by this command:
+//
+//	mockgen -source=outboxservice.go -destination=outboxservice_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxRepository is a mock of OutboxRepository interface.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+}
+
+// MockOutboxRepositoryMockRecorder is the mock recorder for MockOutboxRepository.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock instance.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimPendingMessages mocks base method.
+func (m *MockOutboxRepository) ClaimPendingMessages(ctx context.Context, now, leaseUntil time.Time, limit int) ([]model.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPendingMessages", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]model.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPendingMessages indicates an expected call of ClaimPendingMessages.
+func (mr *MockOutboxRepositoryMockRecorder) ClaimPendingMessages(ctx, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPendingMessages", reflect.TypeOf((*MockOutboxRepository)(nil).ClaimPendingMessages), ctx, now, leaseUntil, limit)
+}
+
+// MarkMessageFailed mocks base method.
+func (m *MockOutboxRepository) MarkMessageFailed(ctx context.Context, id int64, lastError string, failedAt time.Time, nextAttemptAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMessageFailed", ctx, id, lastError, failedAt, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkMessageFailed indicates an expected call of MarkMessageFailed.
+func (mr *MockOutboxRepositoryMockRecorder) MarkMessageFailed(ctx, id, lastError, failedAt, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMessageFailed", reflect.TypeOf((*MockOutboxRepository)(nil).MarkMessageFailed), ctx, id, lastError, failedAt, nextAttemptAt)
+}
+
+// MarkMessagePublished mocks base method.
+func (m *MockOutboxRepository) MarkMessagePublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMessagePublished", ctx, id, publishedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkMessagePublished indicates an expected call of MarkMessagePublished.
+func (mr *MockOutboxRepositoryMockRecorder) MarkMessagePublished(ctx, id, publishedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMessagePublished", reflect.TypeOf((*MockOutboxRepository)(nil).MarkMessagePublished), ctx, id, publishedAt)
+}
+
+// MockOutboxPublisher is a mock of OutboxPublisher interface.
+type MockOutboxPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxPublisherMockRecorder
+}
+
+// MockOutboxPublisherMockRecorder is the mock recorder for MockOutboxPublisher.
+type MockOutboxPublisherMockRecorder struct {
+	mock *MockOutboxPublisher
+}
+
+// NewMockOutboxPublisher creates a new mock instance.
+func NewMockOutboxPublisher(ctrl *gomock.Controller) *MockOutboxPublisher {
+	mock := &MockOutboxPublisher{ctrl: ctrl}
+	mock.recorder = &MockOutboxPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxPublisher) EXPECT() *MockOutboxPublisherMockRecorder {
+	return m.recorder
+}
+
+// PublishOutboxMessage mocks base method.
+func (m *MockOutboxPublisher) PublishOutboxMessage(ctx context.Context, message model.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishOutboxMessage", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishOutboxMessage indicates an expected call of PublishOutboxMessage.
+func (mr *MockOutboxPublisherMockRecorder) PublishOutboxMessage(ctx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishOutboxMessage", reflect.TypeOf((*MockOutboxPublisher)(nil).PublishOutboxMessage), ctx, message)
+}
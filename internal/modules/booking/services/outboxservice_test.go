@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestOutboxService_RelayMessages(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	cfg := OutboxConfig{
+		BatchSize:       2,
+		MaxAttempts:     3,
+		RetryBackoff:    10 * time.Second,
+		MaxRetryBackoff: 15 * time.Second,
+		LeaseDuration:   time.Minute,
+	}
+	publishErr := errors.New("redis unavailable")
+
+	tests := []struct {
+		name          string
+		mockRepo      func(ctrl *gomock.Controller) *MockOutboxRepository
+		mockPublisher func(ctrl *gomock.Controller) *MockOutboxPublisher
+		expectedError error
+	}{
+		{
+			name: "Messages published until none is left",
+			mockRepo: func(ctrl *gomock.Controller) *MockOutboxRepository {
+				mock := NewMockOutboxRepository(ctrl)
+				gomock.InOrder(
+					mock.EXPECT().ClaimPendingMessages(gomock.Any(), now, now.Add(time.Minute), 2).Return([]model.OutboxMessage{{ID: 1}, {ID: 2}}, nil),
+					mock.EXPECT().ClaimPendingMessages(gomock.Any(), now, now.Add(time.Minute), 2).Return([]model.OutboxMessage{{ID: 3}}, nil),
+				)
+				mock.EXPECT().MarkMessagePublished(gomock.Any(), int64(1), now).Return(nil)
+				mock.EXPECT().MarkMessagePublished(gomock.Any(), int64(2), now).Return(nil)
+				mock.EXPECT().MarkMessagePublished(gomock.Any(), int64(3), now).Return(nil)
+				return mock
+			},
+			mockPublisher: func(ctrl *gomock.Controller) *MockOutboxPublisher {
+				mock := NewMockOutboxPublisher(ctrl)
+				mock.EXPECT().PublishOutboxMessage(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+				return mock
+			},
+		},
+		{
+			name: "Failed message retried with a backoff",
+			mockRepo: func(ctrl *gomock.Controller) *MockOutboxRepository {
+				mock := NewMockOutboxRepository(ctrl)
+				mock.EXPECT().ClaimPendingMessages(gomock.Any(), now, now.Add(time.Minute), 2).Return([]model.OutboxMessage{{ID: 1}, {ID: 2, Attempts: 1}}, nil)
+				mock.EXPECT().MarkMessageFailed(gomock.Any(), int64(1), "redis unavailable", now, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, id int64, lastError string, failedAt time.Time, nextAttemptAt *time.Time) error {
+						assert.Equal(t, now.Add(10*time.Second), *nextAttemptAt)
+						return nil
+					})
+				// the doubled backoff is capped
+				mock.EXPECT().MarkMessageFailed(gomock.Any(), int64(2), "redis unavailable", now, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, id int64, lastError string, failedAt time.Time, nextAttemptAt *time.Time) error {
+						assert.Equal(t, now.Add(15*time.Second), *nextAttemptAt)
+						return nil
+					})
+				mock.EXPECT().ClaimPendingMessages(gomock.Any(), now, now.Add(time.Minute), 2).Return(nil, nil)
+				return mock
+			},
+			mockPublisher: func(ctrl *gomock.Controller) *MockOutboxPublisher {
+				mock := NewMockOutboxPublisher(ctrl)
+				mock.EXPECT().PublishOutboxMessage(gomock.Any(), gomock.Any()).Return(publishErr).Times(2)
+				return mock
+			},
+		},
+		{
+			name: "Message given up after the last attempt",
+			mockRepo: func(ctrl *gomock.Controller) *MockOutboxRepository {
+				mock := NewMockOutboxRepository(ctrl)
+				mock.EXPECT().ClaimPendingMessages(gomock.Any(), now, now.Add(time.Minute), 2).Return([]model.OutboxMessage{{ID: 1, Attempts: 2}}, nil)
+				mock.EXPECT().MarkMessageFailed(gomock.Any(), int64(1), "redis unavailable", now, nil).Return(nil)
+				return mock
+			},
+			mockPublisher: func(ctrl *gomock.Controller) *MockOutboxPublisher {
+				mock := NewMockOutboxPublisher(ctrl)
+				mock.EXPECT().PublishOutboxMessage(gomock.Any(), model.OutboxMessage{ID: 1, Attempts: 2}).Return(publishErr)
+				return mock
+			},
+		},
+		{
+			name: "Claim error",
+			mockRepo: func(ctrl *gomock.Controller) *MockOutboxRepository {
+				mock := NewMockOutboxRepository(ctrl)
+				mock.EXPECT().ClaimPendingMessages(gomock.Any(), now, now.Add(time.Minute), 2).Return(nil, assert.AnError)
+				return mock
+			},
+			mockPublisher: func(ctrl *gomock.Controller) *MockOutboxPublisher {
+				return NewMockOutboxPublisher(ctrl)
+			},
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewOutboxService(tt.mockRepo(ctrl), tt.mockPublisher(ctrl), func() time.Time { return now }, cfg)
+			err := service.RelayMessages(context.Background())
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// leasingOutboxRepository claims the messages like the store: a claimed message is hidden until its lease is over.
+type leasingOutboxRepository struct {
+	mu       sync.Mutex
+	messages []model.OutboxMessage
+}
+
+func (r *leasingOutboxRepository) ClaimPendingMessages(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]model.OutboxMessage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var claimed []model.OutboxMessage
+	for i := range r.messages {
+		message := &r.messages[i]
+		if message.PublishedAt != nil || message.NextAttemptAt.After(now) || len(claimed) == limit {
+			continue
+		}
+		message.NextAttemptAt = leaseUntil
+		claimed = append(claimed, *message)
+	}
+	return claimed, nil
+}
+
+func (r *leasingOutboxRepository) MarkMessagePublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages[id-1].PublishedAt = &publishedAt
+	return nil
+}
+
+func (r *leasingOutboxRepository) MarkMessageFailed(ctx context.Context, id int64, lastError string, failedAt time.Time, nextAttemptAt *time.Time) error {
+	return nil
+}
+
+type countingOutboxPublisher struct {
+	mu        sync.Mutex
+	published map[string]int
+}
+
+func (p *countingOutboxPublisher) PublishOutboxMessage(ctx context.Context, message model.OutboxMessage) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.published[message.DedupKey]++
+	return nil
+}
+
+func TestOutboxService_RelayMessages_ConcurrentRelays(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	repo := &leasingOutboxRepository{}
+	for i := 1; i <= 50; i++ {
+		repo.messages = append(repo.messages, model.OutboxMessage{ID: int64(i), DedupKey: fmt.Sprintf("booking:%d:confirmation", i), NextAttemptAt: now})
+	}
+	publisher := &countingOutboxPublisher{published: make(map[string]int)}
+	cfg := OutboxConfig{BatchSize: 3, MaxAttempts: 3, LeaseDuration: time.Minute}
+
+	// two relays overlapping, like a relay outliving its interval, each publish their own claims only
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			service := NewOutboxService(repo, publisher, func() time.Time { return now }, cfg)
+			assert.NoError(t, service.RelayMessages(context.Background()))
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, publisher.published, 50)
+	for key, count := range publisher.published {
+		assert.Equal(t, 1, count, key)
+	}
+}
@@ -0,0 +1,142 @@
+//go:generate mockgen -source=reminderservice.go -destination=reminderservice_mock.go -package=services
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"booking-event/internal/modules/booking/model"
+)
+
+// ReminderPolicy schedules the reminder emails of the bookings, Schedule is how long before the start of the event
+// each is sent unless the event has its own schedule.
+type ReminderPolicy struct {
+	Schedule []time.Duration
+}
+
+// Reminders builds the reminders of the booking still ahead at now, with the messages publishing them. The id of
+// their task changes with the sequence of the event, so the reminders of a moved event are new tasks.
+func (p ReminderPolicy) Reminders(user model.User, event model.Event, booking model.Booking, now time.Time) ([]model.OutboxMessage, []model.BookingReminder, error) {
+	schedule := p.Schedule
+	if event.ReminderMinutes != nil {
+		schedule = make([]time.Duration, 0, len(event.ReminderMinutes))
+		for _, minutes := range event.ReminderMinutes {
+			schedule = append(schedule, time.Duration(minutes)*time.Minute)
+		}
+	}
+
+	var messages []model.OutboxMessage
+	var reminders []model.BookingReminder
+	seen := make(map[string]bool, len(schedule))
+	for _, before := range schedule {
+		remindAt := event.StartAt.Add(-before)
+		taskID := fmt.Sprintf("booking:%d:reminder:%dm:%d", booking.ID, int(before.Minutes()), event.Sequence)
+		if !remindAt.After(now) || seen[taskID] {
+			continue
+		}
+		seen[taskID] = true
+		message, err := model.NewOutboxMessage(
+			model.TaskTypeSendReminderEmail,
+			taskID,
+			model.SendReminderEmailTask{User: user, Event: event, Booking: booking},
+			&remindAt,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		messages = append(messages, *message)
+		reminders = append(reminders, model.BookingReminder{TaskID: taskID, BookingID: booking.ID, RemindAt: remindAt})
+	}
+	return messages, reminders, nil
+}
+
+type EventRepositoryForReminder interface {
+	GetEventByID(ctx context.Context, id int) (*model.Event, error)
+}
+
+type ReminderRepository interface {
+	ReplaceReminders(ctx context.Context, bookingID int, messages []model.OutboxMessage, reminders []model.BookingReminder, now time.Time) ([]string, error)
+}
+
+type ReminderTaskDeleter interface {
+	DeleteTasks(ctx context.Context, taskIDs []string) error
+}
+
+// ReminderService keeps the reminders queued for a booking in line with the booking and its event.
+type ReminderService struct {
+	bookingRepo  BookingRepository
+	eventRepo    EventRepositoryForReminder
+	userRepo     UserRepositoryForBooking
+	reminderRepo ReminderRepository
+	taskDeleter  ReminderTaskDeleter
+	nowFn        func() time.Time
+	policy       ReminderPolicy
+}
+
+func NewReminderService(
+	bookingRepo BookingRepository,
+	eventRepo EventRepositoryForReminder,
+	userRepo UserRepositoryForBooking,
+	reminderRepo ReminderRepository,
+	taskDeleter ReminderTaskDeleter,
+	nowFn func() time.Time,
+	policy ReminderPolicy,
+) *ReminderService {
+	return &ReminderService{
+		bookingRepo:  bookingRepo,
+		eventRepo:    eventRepo,
+		userRepo:     userRepo,
+		reminderRepo: reminderRepo,
+		taskDeleter:  taskDeleter,
+		nowFn:        nowFn,
+		policy:       policy,
+	}
+}
+
+// SyncReminders replaces the reminders of the booking by those of the current start and schedule of its event, or
+// removes them when the booking is canceled or the event no longer active. The replaced tasks are deleted from the
+// queue, one missed is still skipped when it fires since its event sequence is outdated.
+func (s *ReminderService) SyncReminders(ctx context.Context, task model.SyncRemindersTask) error {
+	booking, err := s.bookingRepo.GetBookingByID(ctx, task.BookingID)
+	if err != nil {
+		return err
+	}
+	event, err := s.eventRepo.GetEventByID(ctx, booking.EventID)
+	if err != nil {
+		return err
+	}
+
+	now := s.nowFn()
+	var messages []model.OutboxMessage
+	var reminders []model.BookingReminder
+	if booking.Status == model.BookingStatusConfirmed || booking.Status == model.BookingStatusPaid {
+		if task.Sequence < event.Sequence {
+			// the task of the newer change syncs the booking
+			log.Println("skipping outdated reminder sync of booking", booking.ID, task.Sequence, event.Sequence)
+			return nil
+		}
+		if event.Status == model.EventStatusActive {
+			user, err := s.userRepo.GetUserByID(ctx, booking.UserID)
+			if err != nil {
+				return err
+			}
+			messages, reminders, err = s.policy.Reminders(*user, *event, *booking, now)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	canceled, err := s.reminderRepo.ReplaceReminders(ctx, booking.ID, messages, reminders, now)
+	if err != nil {
+		return err
+	}
+	if len(canceled) > 0 {
+		if err := s.taskDeleter.DeleteTasks(ctx, canceled); err != nil {
+			log.Println("error deleting the reminders of booking", booking.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reminderservice.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reminderservice.go -destination=reminderservice_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventRepositoryForReminder is a mock of EventRepositoryForReminder interface.
+type MockEventRepositoryForReminder struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepositoryForReminderMockRecorder
+}
+
+// MockEventRepositoryForReminderMockRecorder is the mock recorder for MockEventRepositoryForReminder.
+type MockEventRepositoryForReminderMockRecorder struct {
+	mock *MockEventRepositoryForReminder
+}
+
+// NewMockEventRepositoryForReminder creates a new mock instance.
+func NewMockEventRepositoryForReminder(ctrl *gomock.Controller) *MockEventRepositoryForReminder {
+	mock := &MockEventRepositoryForReminder{ctrl: ctrl}
+	mock.recorder = &MockEventRepositoryForReminderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventRepositoryForReminder) EXPECT() *MockEventRepositoryForReminderMockRecorder {
+	return m.recorder
+}
+
+// GetEventByID mocks base method.
+func (m *MockEventRepositoryForReminder) GetEventByID(ctx context.Context, id int) (*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventByID", ctx, id)
+	ret0, _ := ret[0].(*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventByID indicates an expected call of GetEventByID.
+func (mr *MockEventRepositoryForReminderMockRecorder) GetEventByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByID", reflect.TypeOf((*MockEventRepositoryForReminder)(nil).GetEventByID), ctx, id)
+}
+
+// MockReminderRepository is a mock of ReminderRepository interface.
+type MockReminderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderRepositoryMockRecorder
+}
+
+// MockReminderRepositoryMockRecorder is the mock recorder for MockReminderRepository.
+type MockReminderRepositoryMockRecorder struct {
+	mock *MockReminderRepository
+}
+
+// NewMockReminderRepository creates a new mock instance.
+func NewMockReminderRepository(ctrl *gomock.Controller) *MockReminderRepository {
+	mock := &MockReminderRepository{ctrl: ctrl}
+	mock.recorder = &MockReminderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderRepository) EXPECT() *MockReminderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ReplaceReminders mocks base method.
+func (m *MockReminderRepository) ReplaceReminders(ctx context.Context, bookingID int, messages []model.OutboxMessage, reminders []model.BookingReminder, now time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceReminders", ctx, bookingID, messages, reminders, now)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceReminders indicates an expected call of ReplaceReminders.
+func (mr *MockReminderRepositoryMockRecorder) ReplaceReminders(ctx, bookingID, messages, reminders, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceReminders", reflect.TypeOf((*MockReminderRepository)(nil).ReplaceReminders), ctx, bookingID, messages, reminders, now)
+}
+
+// MockReminderTaskDeleter is a mock of ReminderTaskDeleter interface.
+type MockReminderTaskDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderTaskDeleterMockRecorder
+}
+
+// MockReminderTaskDeleterMockRecorder is the mock recorder for MockReminderTaskDeleter.
+type MockReminderTaskDeleterMockRecorder struct {
+	mock *MockReminderTaskDeleter
+}
+
+// NewMockReminderTaskDeleter creates a new mock instance.
+func NewMockReminderTaskDeleter(ctrl *gomock.Controller) *MockReminderTaskDeleter {
+	mock := &MockReminderTaskDeleter{ctrl: ctrl}
+	mock.recorder = &MockReminderTaskDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderTaskDeleter) EXPECT() *MockReminderTaskDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteTasks mocks base method.
+func (m *MockReminderTaskDeleter) DeleteTasks(ctx context.Context, taskIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTasks", ctx, taskIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTasks indicates an expected call of DeleteTasks.
+func (mr *MockReminderTaskDeleterMockRecorder) DeleteTasks(ctx, taskIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTasks", reflect.TypeOf((*MockReminderTaskDeleter)(nil).DeleteTasks), ctx, taskIDs)
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestReminderPolicy_Reminders(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	startAt := now.Add(3 * 24 * time.Hour)
+	policy := ReminderPolicy{Schedule: []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, 2 * time.Hour}}
+	booking := model.Booking{ID: 7}
+
+	tests := []struct {
+		name            string
+		reminderMinutes []int
+		expected        []model.BookingReminder
+	}{
+		{
+			name: "Global schedule without the past reminders",
+			expected: []model.BookingReminder{
+				{TaskID: "booking:7:reminder:1440m:2", BookingID: 7, RemindAt: startAt.Add(-24 * time.Hour)},
+				{TaskID: "booking:7:reminder:120m:2", BookingID: 7, RemindAt: startAt.Add(-2 * time.Hour)},
+			},
+		},
+		{
+			name:            "Schedule of the event",
+			reminderMinutes: []int{30, 30},
+			expected: []model.BookingReminder{
+				{TaskID: "booking:7:reminder:30m:2", BookingID: 7, RemindAt: startAt.Add(-30 * time.Minute)},
+			},
+		},
+		{
+			name:            "Reminders turned off for the event",
+			reminderMinutes: []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := model.Event{ID: 3, StartAt: startAt, Sequence: 2, ReminderMinutes: tt.reminderMinutes}
+			messages, reminders, err := policy.Reminders(model.User{ID: 5}, event, booking, now)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, reminders)
+			assert.Len(t, messages, len(tt.expected))
+			for i, message := range messages {
+				assert.Equal(t, model.TaskTypeSendReminderEmail, message.TaskType)
+				assert.Equal(t, tt.expected[i].TaskID, message.DedupKey)
+				assert.Equal(t, tt.expected[i].RemindAt, *message.ProcessAt)
+			}
+		})
+	}
+}
+
+func TestReminderService_SyncReminders(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	policy := ReminderPolicy{Schedule: []time.Duration{24 * time.Hour}}
+	booking := &model.Booking{ID: 7, EventID: 3, UserID: 5, Status: model.BookingStatusConfirmed}
+	event := &model.Event{ID: 3, Status: model.EventStatusActive, StartAt: now.Add(48 * time.Hour), Sequence: 2}
+
+	tests := []struct {
+		name             string
+		task             model.SyncRemindersTask
+		mockBookingRepo  func(ctrl *gomock.Controller) *MockBookingRepository
+		mockEventRepo    func(ctrl *gomock.Controller) *MockEventRepositoryForReminder
+		mockUserRepo     func(ctrl *gomock.Controller) *MockUserRepositoryForBooking
+		mockReminderRepo func(ctrl *gomock.Controller) *MockReminderRepository
+		mockTaskDeleter  func(ctrl *gomock.Controller) *MockReminderTaskDeleter
+		expectedError    error
+	}{
+		{
+			name: "Reminders of a moved event replaced",
+			task: model.SyncRemindersTask{BookingID: 7, Sequence: 2},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(booking, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReminder {
+				mock := NewMockEventRepositoryForReminder(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 3).Return(event, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				mock := NewMockUserRepositoryForBooking(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 5).Return(&model.User{ID: 5}, nil)
+				return mock
+			},
+			mockReminderRepo: func(ctrl *gomock.Controller) *MockReminderRepository {
+				mock := NewMockReminderRepository(ctrl)
+				mock.EXPECT().ReplaceReminders(gomock.Any(), 7, gomock.Len(1), []model.BookingReminder{
+					{TaskID: "booking:7:reminder:1440m:2", BookingID: 7, RemindAt: now.Add(24 * time.Hour)},
+				}, now).Return([]string{"booking:7:reminder:1440m:1"}, nil)
+				return mock
+			},
+			mockTaskDeleter: func(ctrl *gomock.Controller) *MockReminderTaskDeleter {
+				mock := NewMockReminderTaskDeleter(ctrl)
+				mock.EXPECT().DeleteTasks(gomock.Any(), []string{"booking:7:reminder:1440m:1"}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "Reminders of a canceled booking removed",
+			task: model.SyncRemindersTask{BookingID: 7, Sequence: 2},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(&model.Booking{ID: 7, EventID: 3, Status: model.BookingStatusCanceled}, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReminder {
+				mock := NewMockEventRepositoryForReminder(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 3).Return(event, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				return NewMockUserRepositoryForBooking(ctrl)
+			},
+			mockReminderRepo: func(ctrl *gomock.Controller) *MockReminderRepository {
+				mock := NewMockReminderRepository(ctrl)
+				mock.EXPECT().ReplaceReminders(gomock.Any(), 7, gomock.Nil(), gomock.Nil(), now).Return([]string{"booking:7:reminder:1440m:2"}, nil)
+				return mock
+			},
+			mockTaskDeleter: func(ctrl *gomock.Controller) *MockReminderTaskDeleter {
+				mock := NewMockReminderTaskDeleter(ctrl)
+				// the deletion is best effort, the reminder is skipped anyway when it fires
+				mock.EXPECT().DeleteTasks(gomock.Any(), []string{"booking:7:reminder:1440m:2"}).Return(assert.AnError)
+				return mock
+			},
+		},
+		{
+			name: "Outdated sync skipped",
+			task: model.SyncRemindersTask{BookingID: 7, Sequence: 1},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(booking, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReminder {
+				mock := NewMockEventRepositoryForReminder(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 3).Return(event, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				return NewMockUserRepositoryForBooking(ctrl)
+			},
+			mockReminderRepo: func(ctrl *gomock.Controller) *MockReminderRepository {
+				return NewMockReminderRepository(ctrl)
+			},
+			mockTaskDeleter: func(ctrl *gomock.Controller) *MockReminderTaskDeleter {
+				return NewMockReminderTaskDeleter(ctrl)
+			},
+		},
+		{
+			name: "Replace error",
+			task: model.SyncRemindersTask{BookingID: 7, Sequence: 2},
+			mockBookingRepo: func(ctrl *gomock.Controller) *MockBookingRepository {
+				mock := NewMockBookingRepository(ctrl)
+				mock.EXPECT().GetBookingByID(gomock.Any(), 7).Return(booking, nil)
+				return mock
+			},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReminder {
+				mock := NewMockEventRepositoryForReminder(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 3).Return(&model.Event{ID: 3, Status: model.EventStatusInactive, Sequence: 2}, nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForBooking {
+				return NewMockUserRepositoryForBooking(ctrl)
+			},
+			mockReminderRepo: func(ctrl *gomock.Controller) *MockReminderRepository {
+				mock := NewMockReminderRepository(ctrl)
+				mock.EXPECT().ReplaceReminders(gomock.Any(), 7, gomock.Nil(), gomock.Nil(), now).Return(nil, assert.AnError)
+				return mock
+			},
+			mockTaskDeleter: func(ctrl *gomock.Controller) *MockReminderTaskDeleter {
+				return NewMockReminderTaskDeleter(ctrl)
+			},
+			expectedError: assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewReminderService(
+				tt.mockBookingRepo(ctrl),
+				tt.mockEventRepo(ctrl),
+				tt.mockUserRepo(ctrl),
+				tt.mockReminderRepo(ctrl),
+				tt.mockTaskDeleter(ctrl),
+				func() time.Time { return now },
+				policy,
+			)
+			err := service.SyncReminders(context.Background(), tt.task)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
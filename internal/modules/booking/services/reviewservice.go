@@ -0,0 +1,147 @@
+//go:generate mockgen -source=reviewservice.go -destination=reviewservice_mock.go -package=services
+package services
+
+import (
+	"context"
+	"log"
+
+	_errors "booking-event/internal/common/errors"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/modules/booking/model"
+)
+
+type EventRepositoryForReview interface {
+	GetEventByID(ctx context.Context, id int) (*model.Event, error)
+	QueryEvents(ctx context.Context, query model.EventQuery) (*commonmodel.Page[model.Event], error)
+}
+
+type EventReviewRepository interface {
+	TransitionReviewStatus(ctx context.Context, review *model.EventReview) error
+	GetEventReviews(ctx context.Context, eventID int) ([]model.EventReview, error)
+}
+
+type UserRepositoryForReview interface {
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+}
+
+type EventReviewNotifier interface {
+	EnqueueEventReviewEmail(ctx context.Context, task model.SendEventReviewEmailTask) error
+}
+
+// EventReviewService drives the moderation workflow of events:
+// organizers submit drafts and admins approve or reject them.
+type EventReviewService struct {
+	eventRepo  EventRepositoryForReview
+	reviewRepo EventReviewRepository
+	userRepo   UserRepositoryForReview
+	notifier   EventReviewNotifier
+}
+
+func NewEventReviewService(
+	eventRepo EventRepositoryForReview,
+	reviewRepo EventReviewRepository,
+	userRepo UserRepositoryForReview,
+	notifier EventReviewNotifier,
+) *EventReviewService {
+	return &EventReviewService{
+		eventRepo:  eventRepo,
+		reviewRepo: reviewRepo,
+		userRepo:   userRepo,
+		notifier:   notifier,
+	}
+}
+
+func (s *EventReviewService) SubmitEvent(ctx context.Context, params model.ReviewEventRequest) (*model.EventReview, error) {
+	event, err := s.eventRepo.GetEventByID(ctx, params.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.CreatorID != params.ExecutorID {
+		return nil, _errors.ErrForbidden
+	}
+	return s.transition(ctx, event, model.EventReviewStatusPendingReview, params)
+}
+
+func (s *EventReviewService) ApproveEvent(ctx context.Context, params model.ReviewEventRequest) (*model.EventReview, error) {
+	event, err := s.eventRepo.GetEventByID(ctx, params.EventID)
+	if err != nil {
+		return nil, err
+	}
+	return s.transition(ctx, event, model.EventReviewStatusApproved, params)
+}
+
+func (s *EventReviewService) RejectEvent(ctx context.Context, params model.ReviewEventRequest) (*model.EventReview, error) {
+	if params.Reason == "" {
+		return nil, model.ErrRejectReasonRequired
+	}
+	event, err := s.eventRepo.GetEventByID(ctx, params.EventID)
+	if err != nil {
+		return nil, err
+	}
+	return s.transition(ctx, event, model.EventReviewStatusRejected, params)
+}
+
+func (s *EventReviewService) ListEventsForReview(ctx context.Context, query model.EventReviewQuery) (*commonmodel.Page[model.Event], error) {
+	if query.ReviewStatus == "" {
+		query.ReviewStatus = model.EventReviewStatusPendingReview
+	}
+	return s.eventRepo.QueryEvents(ctx, model.EventQuery{
+		ReviewStatus: query.ReviewStatus,
+		Pagination:   query.Pagination,
+	})
+}
+
+// GetEventReviews returns the review history of the event, organizers can only see their own events.
+func (s *EventReviewService) GetEventReviews(ctx context.Context, eventID int, executorID int) ([]model.EventReview, error) {
+	event, err := s.eventRepo.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.CreatorID != executorID {
+		return nil, _errors.ErrForbidden
+	}
+	return s.reviewRepo.GetEventReviews(ctx, eventID)
+}
+
+// ListEventReviews returns the review history of any event, it is meant for admins.
+func (s *EventReviewService) ListEventReviews(ctx context.Context, eventID int) ([]model.EventReview, error) {
+	if _, err := s.eventRepo.GetEventByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+	return s.reviewRepo.GetEventReviews(ctx, eventID)
+}
+
+func (s *EventReviewService) transition(ctx context.Context, event *model.Event, to model.EventReviewStatus, params model.ReviewEventRequest) (*model.EventReview, error) {
+	if !event.ReviewStatus.CanTransitionTo(to) {
+		return nil, model.ErrInvalidReviewTransition
+	}
+	review := &model.EventReview{
+		EventID:    event.ID,
+		FromStatus: event.ReviewStatus,
+		ToStatus:   to,
+		ActorID:    params.ExecutorID,
+		Reason:     params.Reason,
+	}
+	if err := s.reviewRepo.TransitionReviewStatus(ctx, review); err != nil {
+		return nil, err
+	}
+	event.ReviewStatus = to
+
+	// the transition is already committed, a failed notification must not fail the request
+	if err := s.notifyOrganizer(ctx, event, review); err != nil {
+		log.Println("error notifying organizer about event review", err)
+	}
+	return review, nil
+}
+
+func (s *EventReviewService) notifyOrganizer(ctx context.Context, event *model.Event, review *model.EventReview) error {
+	organizer, err := s.userRepo.GetUserByID(ctx, event.CreatorID)
+	if err != nil {
+		return err
+	}
+	return s.notifier.EnqueueEventReviewEmail(ctx, model.SendEventReviewEmailTask{
+		User:   *organizer,
+		Event:  *event,
+		Review: *review,
+	})
+}
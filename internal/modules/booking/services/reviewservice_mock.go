@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reviewservice.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reviewservice.go -destination=reviewservice_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/common/model"
+	model0 "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventRepositoryForReview is a mock of EventRepositoryForReview interface.
+type MockEventRepositoryForReview struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepositoryForReviewMockRecorder
+}
+
+// MockEventRepositoryForReviewMockRecorder is the mock recorder for MockEventRepositoryForReview.
+type MockEventRepositoryForReviewMockRecorder struct {
+	mock *MockEventRepositoryForReview
+}
+
+// NewMockEventRepositoryForReview creates a new mock instance.
+func NewMockEventRepositoryForReview(ctrl *gomock.Controller) *MockEventRepositoryForReview {
+	mock := &MockEventRepositoryForReview{ctrl: ctrl}
+	mock.recorder = &MockEventRepositoryForReviewMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventRepositoryForReview) EXPECT() *MockEventRepositoryForReviewMockRecorder {
+	return m.recorder
+}
+
+// GetEventByID mocks base method.
+func (m *MockEventRepositoryForReview) GetEventByID(ctx context.Context, id int) (*model0.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventByID", ctx, id)
+	ret0, _ := ret[0].(*model0.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventByID indicates an expected call of GetEventByID.
+func (mr *MockEventRepositoryForReviewMockRecorder) GetEventByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByID", reflect.TypeOf((*MockEventRepositoryForReview)(nil).GetEventByID), ctx, id)
+}
+
+// QueryEvents mocks base method.
+func (m *MockEventRepositoryForReview) QueryEvents(ctx context.Context, query model0.EventQuery) (*model.Page[model0.Event], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryEvents", ctx, query)
+	ret0, _ := ret[0].(*model.Page[model0.Event])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryEvents indicates an expected call of QueryEvents.
+func (mr *MockEventRepositoryForReviewMockRecorder) QueryEvents(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryEvents", reflect.TypeOf((*MockEventRepositoryForReview)(nil).QueryEvents), ctx, query)
+}
+
+// MockEventReviewRepository is a mock of EventReviewRepository interface.
+type MockEventReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventReviewRepositoryMockRecorder
+}
+
+// MockEventReviewRepositoryMockRecorder is the mock recorder for MockEventReviewRepository.
+type MockEventReviewRepositoryMockRecorder struct {
+	mock *MockEventReviewRepository
+}
+
+// NewMockEventReviewRepository creates a new mock instance.
+func NewMockEventReviewRepository(ctrl *gomock.Controller) *MockEventReviewRepository {
+	mock := &MockEventReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockEventReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventReviewRepository) EXPECT() *MockEventReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetEventReviews mocks base method.
+func (m *MockEventReviewRepository) GetEventReviews(ctx context.Context, eventID int) ([]model0.EventReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventReviews", ctx, eventID)
+	ret0, _ := ret[0].([]model0.EventReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventReviews indicates an expected call of GetEventReviews.
+func (mr *MockEventReviewRepositoryMockRecorder) GetEventReviews(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventReviews", reflect.TypeOf((*MockEventReviewRepository)(nil).GetEventReviews), ctx, eventID)
+}
+
+// TransitionReviewStatus mocks base method.
+func (m *MockEventReviewRepository) TransitionReviewStatus(ctx context.Context, review *model0.EventReview) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionReviewStatus", ctx, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransitionReviewStatus indicates an expected call of TransitionReviewStatus.
+func (mr *MockEventReviewRepositoryMockRecorder) TransitionReviewStatus(ctx, review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionReviewStatus", reflect.TypeOf((*MockEventReviewRepository)(nil).TransitionReviewStatus), ctx, review)
+}
+
+// MockUserRepositoryForReview is a mock of UserRepositoryForReview interface.
+type MockUserRepositoryForReview struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryForReviewMockRecorder
+}
+
+// MockUserRepositoryForReviewMockRecorder is the mock recorder for MockUserRepositoryForReview.
+type MockUserRepositoryForReviewMockRecorder struct {
+	mock *MockUserRepositoryForReview
+}
+
+// NewMockUserRepositoryForReview creates a new mock instance.
+func NewMockUserRepositoryForReview(ctrl *gomock.Controller) *MockUserRepositoryForReview {
+	mock := &MockUserRepositoryForReview{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryForReviewMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepositoryForReview) EXPECT() *MockUserRepositoryForReviewMockRecorder {
+	return m.recorder
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserRepositoryForReview) GetUserByID(ctx context.Context, id int) (*model0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryForReviewMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepositoryForReview)(nil).GetUserByID), ctx, id)
+}
+
+// MockEventReviewNotifier is a mock of EventReviewNotifier interface.
+type MockEventReviewNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventReviewNotifierMockRecorder
+}
+
+// MockEventReviewNotifierMockRecorder is the mock recorder for MockEventReviewNotifier.
+type MockEventReviewNotifierMockRecorder struct {
+	mock *MockEventReviewNotifier
+}
+
+// NewMockEventReviewNotifier creates a new mock instance.
+func NewMockEventReviewNotifier(ctrl *gomock.Controller) *MockEventReviewNotifier {
+	mock := &MockEventReviewNotifier{ctrl: ctrl}
+	mock.recorder = &MockEventReviewNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventReviewNotifier) EXPECT() *MockEventReviewNotifierMockRecorder {
+	return m.recorder
+}
+
+// EnqueueEventReviewEmail mocks base method.
+func (m *MockEventReviewNotifier) EnqueueEventReviewEmail(ctx context.Context, task model0.SendEventReviewEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueEventReviewEmail", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueEventReviewEmail indicates an expected call of EnqueueEventReviewEmail.
+func (mr *MockEventReviewNotifierMockRecorder) EnqueueEventReviewEmail(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueEventReviewEmail", reflect.TypeOf((*MockEventReviewNotifier)(nil).EnqueueEventReviewEmail), ctx, task)
+}
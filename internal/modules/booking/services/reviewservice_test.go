@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	_errors "booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestEventReviewService_SubmitEvent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		params         model.ReviewEventRequest
+		mockEventRepo  func(ctrl *gomock.Controller) *MockEventRepositoryForReview
+		mockReviewRepo func(ctrl *gomock.Controller) *MockEventReviewRepository
+		mockUserRepo   func(ctrl *gomock.Controller) *MockUserRepositoryForReview
+		mockNotifier   func(ctrl *gomock.Controller) *MockEventReviewNotifier
+		expectedError  error
+	}{
+		{
+			name:   "Successful submission",
+			params: model.ReviewEventRequest{EventID: 1, ExecutorID: 2},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReview {
+				mock := NewMockEventRepositoryForReview(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, CreatorID: 2, ReviewStatus: model.EventReviewStatusDraft}, nil)
+				return mock
+			},
+			mockReviewRepo: func(ctrl *gomock.Controller) *MockEventReviewRepository {
+				mock := NewMockEventReviewRepository(ctrl)
+				mock.EXPECT().TransitionReviewStatus(gomock.Any(), &model.EventReview{
+					EventID:    1,
+					FromStatus: model.EventReviewStatusDraft,
+					ToStatus:   model.EventReviewStatusPendingReview,
+					ActorID:    2,
+				}).Return(nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForReview {
+				mock := NewMockUserRepositoryForReview(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 2).Return(&model.User{ID: 2, Email: "organizer@example.com"}, nil)
+				return mock
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockEventReviewNotifier {
+				mock := NewMockEventReviewNotifier(ctrl)
+				mock.EXPECT().EnqueueEventReviewEmail(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "Resubmission after rejection",
+			params: model.ReviewEventRequest{EventID: 1, ExecutorID: 2},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReview {
+				mock := NewMockEventRepositoryForReview(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, CreatorID: 2, ReviewStatus: model.EventReviewStatusRejected}, nil)
+				return mock
+			},
+			mockReviewRepo: func(ctrl *gomock.Controller) *MockEventReviewRepository {
+				mock := NewMockEventReviewRepository(ctrl)
+				mock.EXPECT().TransitionReviewStatus(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForReview {
+				mock := NewMockUserRepositoryForReview(ctrl)
+				mock.EXPECT().GetUserByID(gomock.Any(), 2).Return(&model.User{ID: 2}, nil)
+				return mock
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockEventReviewNotifier {
+				mock := NewMockEventReviewNotifier(ctrl)
+				mock.EXPECT().EnqueueEventReviewEmail(gomock.Any(), gomock.Any()).Return(assert.AnError)
+				return mock
+			},
+		},
+		{
+			name:   "Not the event creator",
+			params: model.ReviewEventRequest{EventID: 1, ExecutorID: 3},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReview {
+				mock := NewMockEventRepositoryForReview(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, CreatorID: 2, ReviewStatus: model.EventReviewStatusDraft}, nil)
+				return mock
+			},
+			mockReviewRepo: func(ctrl *gomock.Controller) *MockEventReviewRepository {
+				return NewMockEventReviewRepository(ctrl)
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForReview {
+				return NewMockUserRepositoryForReview(ctrl)
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockEventReviewNotifier {
+				return NewMockEventReviewNotifier(ctrl)
+			},
+			expectedError: _errors.ErrForbidden,
+		},
+		{
+			name:   "Already approved",
+			params: model.ReviewEventRequest{EventID: 1, ExecutorID: 2},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReview {
+				mock := NewMockEventRepositoryForReview(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, CreatorID: 2, ReviewStatus: model.EventReviewStatusApproved}, nil)
+				return mock
+			},
+			mockReviewRepo: func(ctrl *gomock.Controller) *MockEventReviewRepository {
+				return NewMockEventReviewRepository(ctrl)
+			},
+			mockUserRepo: func(ctrl *gomock.Controller) *MockUserRepositoryForReview {
+				return NewMockUserRepositoryForReview(ctrl)
+			},
+			mockNotifier: func(ctrl *gomock.Controller) *MockEventReviewNotifier {
+				return NewMockEventReviewNotifier(ctrl)
+			},
+			expectedError: model.ErrInvalidReviewTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewEventReviewService(tt.mockEventRepo(ctrl), tt.mockReviewRepo(ctrl), tt.mockUserRepo(ctrl), tt.mockNotifier(ctrl))
+			review, err := service.SubmitEvent(context.Background(), tt.params)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, review)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, model.EventReviewStatusPendingReview, review.ToStatus)
+			}
+		})
+	}
+}
+
+func TestEventReviewService_RejectEvent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		params         model.ReviewEventRequest
+		mockEventRepo  func(ctrl *gomock.Controller) *MockEventRepositoryForReview
+		mockReviewRepo func(ctrl *gomock.Controller) *MockEventReviewRepository
+		expectedError  error
+	}{
+		{
+			name:   "Successful rejection",
+			params: model.ReviewEventRequest{EventID: 1, ExecutorID: 9, Reason: "missing venue details"},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReview {
+				mock := NewMockEventRepositoryForReview(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, CreatorID: 2, ReviewStatus: model.EventReviewStatusPendingReview}, nil)
+				return mock
+			},
+			mockReviewRepo: func(ctrl *gomock.Controller) *MockEventReviewRepository {
+				mock := NewMockEventReviewRepository(ctrl)
+				mock.EXPECT().TransitionReviewStatus(gomock.Any(), &model.EventReview{
+					EventID:    1,
+					FromStatus: model.EventReviewStatusPendingReview,
+					ToStatus:   model.EventReviewStatusRejected,
+					ActorID:    9,
+					Reason:     "missing venue details",
+				}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "Missing reason",
+			params: model.ReviewEventRequest{EventID: 1, ExecutorID: 9},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReview {
+				return NewMockEventRepositoryForReview(ctrl)
+			},
+			mockReviewRepo: func(ctrl *gomock.Controller) *MockEventReviewRepository {
+				return NewMockEventReviewRepository(ctrl)
+			},
+			expectedError: model.ErrRejectReasonRequired,
+		},
+		{
+			name:   "Draft can not be rejected",
+			params: model.ReviewEventRequest{EventID: 1, ExecutorID: 9, Reason: "spam"},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReview {
+				mock := NewMockEventRepositoryForReview(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, CreatorID: 2, ReviewStatus: model.EventReviewStatusDraft}, nil)
+				return mock
+			},
+			mockReviewRepo: func(ctrl *gomock.Controller) *MockEventReviewRepository {
+				return NewMockEventReviewRepository(ctrl)
+			},
+			expectedError: model.ErrInvalidReviewTransition,
+		},
+		{
+			name:   "Concurrent review",
+			params: model.ReviewEventRequest{EventID: 1, ExecutorID: 9, Reason: "spam"},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepositoryForReview {
+				mock := NewMockEventRepositoryForReview(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(&model.Event{ID: 1, CreatorID: 2, ReviewStatus: model.EventReviewStatusPendingReview}, nil)
+				return mock
+			},
+			mockReviewRepo: func(ctrl *gomock.Controller) *MockEventReviewRepository {
+				mock := NewMockEventReviewRepository(ctrl)
+				mock.EXPECT().TransitionReviewStatus(gomock.Any(), gomock.Any()).Return(model.ErrInvalidReviewTransition)
+				return mock
+			},
+			expectedError: model.ErrInvalidReviewTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := NewMockUserRepositoryForReview(ctrl)
+			userRepo.EXPECT().GetUserByID(gomock.Any(), 2).Return(&model.User{ID: 2}, nil).AnyTimes()
+			notifier := NewMockEventReviewNotifier(ctrl)
+			notifier.EXPECT().EnqueueEventReviewEmail(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			service := NewEventReviewService(tt.mockEventRepo(ctrl), tt.mockReviewRepo(ctrl), userRepo, notifier)
+			review, err := service.RejectEvent(context.Background(), tt.params)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, review)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, model.EventReviewStatusRejected, review.ToStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+//go:generate mockgen -source=templateservice.go -destination=templateservice_mock.go -package=services
+package services
+
+import (
+	"context"
+
+	_errors "booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+)
+
+type EventTemplateRepository interface {
+	CreateEventTemplate(ctx context.Context, template *model.EventTemplate) error
+	GetEventTemplateByID(ctx context.Context, id int) (*model.EventTemplate, error)
+	ListEventTemplates(ctx context.Context, creatorID int) ([]model.EventTemplate, error)
+	DeleteEventTemplate(ctx context.Context, id int) error
+}
+
+type EventServiceForTemplate interface {
+	CreateDraftEvent(ctx context.Context, event *model.Event) error
+}
+
+type EventTemplateService struct {
+	templateRepo EventTemplateRepository
+	eventRepo    EventRepository
+	eventService EventServiceForTemplate
+}
+
+func NewEventTemplateService(templateRepo EventTemplateRepository, eventRepo EventRepository, eventService EventServiceForTemplate) *EventTemplateService {
+	return &EventTemplateService{
+		templateRepo: templateRepo,
+		eventRepo:    eventRepo,
+		eventService: eventService,
+	}
+}
+
+// SaveEventTemplate saves the configuration of an event of the executor as a named template.
+func (s *EventTemplateService) SaveEventTemplate(ctx context.Context, params model.SaveEventTemplateRequest) (*model.EventTemplate, error) {
+	event, err := s.eventRepo.GetEventByID(ctx, params.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.CreatorID != params.ExecutorID {
+		return nil, _errors.ErrForbidden
+	}
+	template := &model.EventTemplate{
+		CreatorID: params.ExecutorID,
+		Name:      params.Name,
+		Content:   event.TemplateContent(),
+	}
+	if err := s.templateRepo.CreateEventTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (s *EventTemplateService) ListEventTemplates(ctx context.Context, executorID int) ([]model.EventTemplate, error) {
+	return s.templateRepo.ListEventTemplates(ctx, executorID)
+}
+
+func (s *EventTemplateService) GetEventTemplate(ctx context.Context, params model.EventTemplateRequest) (*model.EventTemplate, error) {
+	template, err := s.templateRepo.GetEventTemplateByID(ctx, params.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	if template.CreatorID != params.ExecutorID {
+		return nil, _errors.ErrForbidden
+	}
+	return template, nil
+}
+
+func (s *EventTemplateService) DeleteEventTemplate(ctx context.Context, params model.EventTemplateRequest) error {
+	template, err := s.GetEventTemplate(ctx, params)
+	if err != nil {
+		return err
+	}
+	return s.templateRepo.DeleteEventTemplate(ctx, template.ID)
+}
+
+// CreateEventFromTemplate creates a new draft event from a template of the executor.
+func (s *EventTemplateService) CreateEventFromTemplate(ctx context.Context, params model.CreateEventFromTemplateRequest) (*model.Event, error) {
+	template, err := s.GetEventTemplate(ctx, model.EventTemplateRequest{TemplateID: params.TemplateID, ExecutorID: params.ExecutorID})
+	if err != nil {
+		return nil, err
+	}
+	event := template.Content.NewEvent(params.StartAt, params.ExecutorID)
+	if params.Name != "" {
+		event.Name = params.Name
+	}
+	if err := s.eventService.CreateDraftEvent(ctx, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
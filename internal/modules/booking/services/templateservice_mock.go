@@ -0,0 +1,136 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: templateservice.go
+//
+// Generated by this command:
+//
+//	mockgen -source=templateservice.go -destination=templateservice_mock.go -package=services
+//
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventTemplateRepository is a mock of EventTemplateRepository interface.
+type MockEventTemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventTemplateRepositoryMockRecorder
+}
+
+// MockEventTemplateRepositoryMockRecorder is the mock recorder for MockEventTemplateRepository.
+type MockEventTemplateRepositoryMockRecorder struct {
+	mock *MockEventTemplateRepository
+}
+
+// NewMockEventTemplateRepository creates a new mock instance.
+func NewMockEventTemplateRepository(ctrl *gomock.Controller) *MockEventTemplateRepository {
+	mock := &MockEventTemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockEventTemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventTemplateRepository) EXPECT() *MockEventTemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateEventTemplate mocks base method.
+func (m *MockEventTemplateRepository) CreateEventTemplate(ctx context.Context, template *model.EventTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventTemplate", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEventTemplate indicates an expected call of CreateEventTemplate.
+func (mr *MockEventTemplateRepositoryMockRecorder) CreateEventTemplate(ctx, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventTemplate", reflect.TypeOf((*MockEventTemplateRepository)(nil).CreateEventTemplate), ctx, template)
+}
+
+// DeleteEventTemplate mocks base method.
+func (m *MockEventTemplateRepository) DeleteEventTemplate(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventTemplate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventTemplate indicates an expected call of DeleteEventTemplate.
+func (mr *MockEventTemplateRepositoryMockRecorder) DeleteEventTemplate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventTemplate", reflect.TypeOf((*MockEventTemplateRepository)(nil).DeleteEventTemplate), ctx, id)
+}
+
+// GetEventTemplateByID mocks base method.
+func (m *MockEventTemplateRepository) GetEventTemplateByID(ctx context.Context, id int) (*model.EventTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventTemplateByID", ctx, id)
+	ret0, _ := ret[0].(*model.EventTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventTemplateByID indicates an expected call of GetEventTemplateByID.
+func (mr *MockEventTemplateRepositoryMockRecorder) GetEventTemplateByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventTemplateByID", reflect.TypeOf((*MockEventTemplateRepository)(nil).GetEventTemplateByID), ctx, id)
+}
+
+// ListEventTemplates mocks base method.
+func (m *MockEventTemplateRepository) ListEventTemplates(ctx context.Context, creatorID int) ([]model.EventTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventTemplates", ctx, creatorID)
+	ret0, _ := ret[0].([]model.EventTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventTemplates indicates an expected call of ListEventTemplates.
+func (mr *MockEventTemplateRepositoryMockRecorder) ListEventTemplates(ctx, creatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventTemplates", reflect.TypeOf((*MockEventTemplateRepository)(nil).ListEventTemplates), ctx, creatorID)
+}
+
+// MockEventServiceForTemplate is a mock of EventServiceForTemplate interface.
+type MockEventServiceForTemplate struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventServiceForTemplateMockRecorder
+}
+
+// MockEventServiceForTemplateMockRecorder is the mock recorder for MockEventServiceForTemplate.
+type MockEventServiceForTemplateMockRecorder struct {
+	mock *MockEventServiceForTemplate
+}
+
+// NewMockEventServiceForTemplate creates a new mock instance.
+func NewMockEventServiceForTemplate(ctrl *gomock.Controller) *MockEventServiceForTemplate {
+	mock := &MockEventServiceForTemplate{ctrl: ctrl}
+	mock.recorder = &MockEventServiceForTemplateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventServiceForTemplate) EXPECT() *MockEventServiceForTemplateMockRecorder {
+	return m.recorder
+}
+
+// CreateDraftEvent mocks base method.
+func (m *MockEventServiceForTemplate) CreateDraftEvent(ctx context.Context, event *model.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDraftEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDraftEvent indicates an expected call of CreateDraftEvent.
+func (mr *MockEventServiceForTemplateMockRecorder) CreateDraftEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDraftEvent", reflect.TypeOf((*MockEventServiceForTemplate)(nil).CreateDraftEvent), ctx, event)
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	_errors "booking-event/internal/common/errors"
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestEventTemplateService_SaveEventTemplate(t *testing.T) {
+	t.Parallel()
+	event := &model.Event{
+		ID:             1,
+		Name:           "Concert",
+		AvailableSeats: 100,
+		Location:       "Hanoi",
+		Categories:     []model.EventCategory{"music"},
+		Tags:           []string{"live"},
+		Price:          5000,
+		Currency:       "USD",
+		Status:         model.EventStatusActive,
+		ReviewStatus:   model.EventReviewStatusApproved,
+		CreatorID:      2,
+	}
+	tests := []struct {
+		name             string
+		params           model.SaveEventTemplateRequest
+		mockEventRepo    func(ctrl *gomock.Controller) *MockEventRepository
+		mockTemplateRepo func(ctrl *gomock.Controller) *MockEventTemplateRepository
+		expectedError    error
+	}{
+		{
+			name:   "Successful save",
+			params: model.SaveEventTemplateRequest{EventID: 1, Name: "Monthly concert", ExecutorID: 2},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				mock := NewMockEventRepository(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(event, nil)
+				return mock
+			},
+			mockTemplateRepo: func(ctrl *gomock.Controller) *MockEventTemplateRepository {
+				mock := NewMockEventTemplateRepository(ctrl)
+				mock.EXPECT().CreateEventTemplate(gomock.Any(), &model.EventTemplate{
+					CreatorID: 2,
+					Name:      "Monthly concert",
+					Content: model.EventTemplateContent{
+						Name:           "Concert",
+						AvailableSeats: 100,
+						Location:       "Hanoi",
+						Categories:     []model.EventCategory{"music"},
+						Tags:           []string{"live"},
+						Price:          5000,
+						Currency:       "USD",
+					},
+				}).Return(nil)
+				return mock
+			},
+		},
+		{
+			name:   "Not the event creator",
+			params: model.SaveEventTemplateRequest{EventID: 1, Name: "Monthly concert", ExecutorID: 3},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				mock := NewMockEventRepository(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(event, nil)
+				return mock
+			},
+			mockTemplateRepo: func(ctrl *gomock.Controller) *MockEventTemplateRepository {
+				return NewMockEventTemplateRepository(ctrl)
+			},
+			expectedError: _errors.ErrForbidden,
+		},
+		{
+			name:   "Event not found",
+			params: model.SaveEventTemplateRequest{EventID: 1, Name: "Monthly concert", ExecutorID: 2},
+			mockEventRepo: func(ctrl *gomock.Controller) *MockEventRepository {
+				mock := NewMockEventRepository(ctrl)
+				mock.EXPECT().GetEventByID(gomock.Any(), 1).Return(nil, _errors.ErrNotFound)
+				return mock
+			},
+			mockTemplateRepo: func(ctrl *gomock.Controller) *MockEventTemplateRepository {
+				return NewMockEventTemplateRepository(ctrl)
+			},
+			expectedError: _errors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewEventTemplateService(tt.mockTemplateRepo(ctrl), tt.mockEventRepo(ctrl), NewMockEventServiceForTemplate(ctrl))
+			template, err := service.SaveEventTemplate(context.Background(), tt.params)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, template)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Monthly concert", template.Name)
+			}
+		})
+	}
+}
+
+func TestEventTemplateService_CreateEventFromTemplate(t *testing.T) {
+	t.Parallel()
+	startAt := time.Date(2026, 12, 1, 19, 0, 0, 0, time.UTC)
+	template := &model.EventTemplate{
+		ID:        5,
+		CreatorID: 2,
+		Name:      "Monthly concert",
+		Content: model.EventTemplateContent{
+			Name:           "Concert",
+			AvailableSeats: 100,
+			Location:       "Hanoi",
+			Categories:     []model.EventCategory{"music"},
+			Tags:           []string{"live"},
+			Price:          5000,
+			Currency:       "USD",
+		},
+	}
+	tests := []struct {
+		name             string
+		params           model.CreateEventFromTemplateRequest
+		mockTemplateRepo func(ctrl *gomock.Controller) *MockEventTemplateRepository
+		mockEventService func(ctrl *gomock.Controller) *MockEventServiceForTemplate
+		expectedName     string
+		expectedError    error
+	}{
+		{
+			name:   "Successful creation",
+			params: model.CreateEventFromTemplateRequest{TemplateID: 5, StartAt: startAt, ExecutorID: 2},
+			mockTemplateRepo: func(ctrl *gomock.Controller) *MockEventTemplateRepository {
+				mock := NewMockEventTemplateRepository(ctrl)
+				mock.EXPECT().GetEventTemplateByID(gomock.Any(), 5).Return(template, nil)
+				return mock
+			},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventServiceForTemplate {
+				mock := NewMockEventServiceForTemplate(ctrl)
+				mock.EXPECT().CreateDraftEvent(gomock.Any(), &model.Event{
+					Name:           "Concert",
+					AvailableSeats: 100,
+					StartAt:        startAt,
+					Location:       "Hanoi",
+					Categories:     []model.EventCategory{"music"},
+					Tags:           []string{"live"},
+					Price:          5000,
+					Currency:       "USD",
+					CreatorID:      2,
+				}).Return(nil)
+				return mock
+			},
+			expectedName: "Concert",
+		},
+		{
+			name:   "Name overridden",
+			params: model.CreateEventFromTemplateRequest{TemplateID: 5, Name: "Christmas concert", StartAt: startAt, ExecutorID: 2},
+			mockTemplateRepo: func(ctrl *gomock.Controller) *MockEventTemplateRepository {
+				mock := NewMockEventTemplateRepository(ctrl)
+				mock.EXPECT().GetEventTemplateByID(gomock.Any(), 5).Return(template, nil)
+				return mock
+			},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventServiceForTemplate {
+				mock := NewMockEventServiceForTemplate(ctrl)
+				mock.EXPECT().CreateDraftEvent(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			expectedName: "Christmas concert",
+		},
+		{
+			name:   "Template of another organizer",
+			params: model.CreateEventFromTemplateRequest{TemplateID: 5, StartAt: startAt, ExecutorID: 3},
+			mockTemplateRepo: func(ctrl *gomock.Controller) *MockEventTemplateRepository {
+				mock := NewMockEventTemplateRepository(ctrl)
+				mock.EXPECT().GetEventTemplateByID(gomock.Any(), 5).Return(template, nil)
+				return mock
+			},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventServiceForTemplate {
+				return NewMockEventServiceForTemplate(ctrl)
+			},
+			expectedError: _errors.ErrForbidden,
+		},
+		{
+			name:   "Category removed since the template was saved",
+			params: model.CreateEventFromTemplateRequest{TemplateID: 5, StartAt: startAt, ExecutorID: 2},
+			mockTemplateRepo: func(ctrl *gomock.Controller) *MockEventTemplateRepository {
+				mock := NewMockEventTemplateRepository(ctrl)
+				mock.EXPECT().GetEventTemplateByID(gomock.Any(), 5).Return(template, nil)
+				return mock
+			},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventServiceForTemplate {
+				mock := NewMockEventServiceForTemplate(ctrl)
+				mock.EXPECT().CreateDraftEvent(gomock.Any(), gomock.Any()).Return(model.ErrUnknownCategory)
+				return mock
+			},
+			expectedError: model.ErrUnknownCategory,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewEventTemplateService(tt.mockTemplateRepo(ctrl), NewMockEventRepository(ctrl), tt.mockEventService(ctrl))
+			event, err := service.CreateEventFromTemplate(context.Background(), tt.params)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, event)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedName, event.Name)
+				assert.Equal(t, startAt, event.StartAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,318 @@
+//go:generate mockgen -source=webhookservice.go -destination=webhookservice_mock.go -package=services
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"time"
+
+	_errors "booking-event/internal/common/errors"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/infra/webhook"
+	"booking-event/internal/modules/booking/model"
+)
+
+type WebhookRepository interface {
+	CreateWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) error
+	GetWebhookSubscriptionByID(ctx context.Context, id int) (*model.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context, ownerID int, serviceAccountID int) ([]model.WebhookSubscription, error)
+	UpdateWebhookSubscription(ctx context.Context, subscription model.WebhookSubscription) error
+	DeleteWebhookSubscription(ctx context.Context, id int) error
+	CreateWebhookDeliveries(ctx context.Context, eventID int, webhookEvent model.WebhookEvent, payload []byte) error
+	GetWebhookDeliveryByID(ctx context.Context, id int64) (*model.WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, query model.WebhookDeliveryQuery) (*commonmodel.Page[model.WebhookDelivery], error)
+	ListWebhookDeliveryAttempts(ctx context.Context, deliveryID int64) ([]model.WebhookDeliveryAttempt, error)
+	RecordWebhookDeliveryAttempt(ctx context.Context, outcome model.WebhookDeliveryOutcome, disableAfter int) error
+	ReplayWebhookDelivery(ctx context.Context, deliveryID int64, message model.OutboxMessage, now time.Time) error
+}
+
+type WebhookSender interface {
+	Send(ctx context.Context, request webhook.Request) (*webhook.Response, error)
+	CheckURL(ctx context.Context, rawURL string) error
+}
+
+type WebhookConfig struct {
+	// AllowInsecureURL accepts the http endpoints, for the local setups only.
+	AllowInsecureURL bool
+	MaxAttempts      int // a delivery failing this many times in a row is given up
+	// RetryBackoff is the wait before the first retry, it doubles with each failure up to MaxRetryBackoff.
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	// DisableAfter is the number of deliveries given up in a row disabling the subscription, 0 never disables it.
+	DisableAfter int
+}
+
+// WebhookService manages the webhook subscriptions of the organizers and delivers them the changes of their
+// events. The deliveries run in the worker, each attempt is logged and a failed one is retried from the outbox.
+type WebhookService struct {
+	webhookRepo WebhookRepository
+	sender      WebhookSender
+	nowFn       func() time.Time
+	cfg         WebhookConfig
+}
+
+func NewWebhookService(webhookRepo WebhookRepository, sender WebhookSender, nowFn func() time.Time, cfg WebhookConfig) *WebhookService {
+	return &WebhookService{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+		nowFn:       nowFn,
+		cfg:         cfg,
+	}
+}
+
+// CreateSubscription registers an endpoint of the executor, the secret signing its deliveries is only returned here.
+func (s *WebhookService) CreateSubscription(ctx context.Context, params model.CreateWebhookSubscriptionRequest) (*model.CreatedWebhookSubscription, error) {
+	if err := s.checkURL(ctx, params.URL); err != nil {
+		return nil, err
+	}
+	secret := make([]byte, 24)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	subscription := model.WebhookSubscription{
+		OwnerID:    params.ExecutorID,
+		URL:        params.URL,
+		Secret:     "whsec_" + hex.EncodeToString(secret),
+		EventTypes: params.EventTypes,
+	}
+	if params.ServiceAccountID != 0 {
+		subscription.ServiceAccountID = util.ToPtr(params.ServiceAccountID)
+	}
+	if err := s.webhookRepo.CreateWebhookSubscription(ctx, &subscription); err != nil {
+		return nil, err
+	}
+	return &model.CreatedWebhookSubscription{WebhookSubscription: subscription, Secret: subscription.Secret}, nil
+}
+
+// ListSubscriptions lists the subscriptions of the executor, only those of its service account when it calls with an
+// API key.
+func (s *WebhookService) ListSubscriptions(ctx context.Context, executorID int, serviceAccountID int) ([]model.WebhookSubscription, error) {
+	return s.webhookRepo.ListWebhookSubscriptions(ctx, executorID, serviceAccountID)
+}
+
+func (s *WebhookService) UpdateSubscription(ctx context.Context, params model.UpdateWebhookSubscriptionRequest) (*model.WebhookSubscription, error) {
+	subscription, err := s.getSubscription(ctx, params.SubscriptionID, params.ExecutorID, params.ServiceAccountID)
+	if err != nil {
+		return nil, err
+	}
+	if params.URL != nil {
+		if err := s.checkURL(ctx, *params.URL); err != nil {
+			return nil, err
+		}
+		subscription.URL = *params.URL
+	}
+	if params.EventTypes != nil {
+		subscription.EventTypes = *params.EventTypes
+	}
+	if params.Enabled != nil {
+		switch {
+		case *params.Enabled:
+			subscription.DisabledAt = nil
+			subscription.ConsecutiveFailures = 0
+		case subscription.DisabledAt == nil:
+			subscription.DisabledAt = util.ToPtr(s.nowFn())
+		}
+	}
+	if err := s.webhookRepo.UpdateWebhookSubscription(ctx, *subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (s *WebhookService) DeleteSubscription(ctx context.Context, params model.WebhookSubscriptionRequest) error {
+	subscription, err := s.getSubscription(ctx, params.SubscriptionID, params.ExecutorID, params.ServiceAccountID)
+	if err != nil {
+		return err
+	}
+	return s.webhookRepo.DeleteWebhookSubscription(ctx, subscription.ID)
+}
+
+func (s *WebhookService) ListDeliveries(ctx context.Context, query model.WebhookDeliveryQuery) (*commonmodel.Page[model.WebhookDelivery], error) {
+	if _, err := s.getSubscription(ctx, query.SubscriptionID, query.ExecutorID, query.ServiceAccountID); err != nil {
+		return nil, err
+	}
+	return s.webhookRepo.ListWebhookDeliveries(ctx, query)
+}
+
+func (s *WebhookService) ListDeliveryAttempts(ctx context.Context, params model.WebhookDeliveryRequest) ([]model.WebhookDeliveryAttempt, error) {
+	delivery, err := s.getDelivery(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.webhookRepo.ListWebhookDeliveryAttempts(ctx, delivery.ID)
+}
+
+// ReplayDelivery sends a delivery again, with its original body and webhook event id so the partner can drop it if
+// it was received already.
+func (s *WebhookService) ReplayDelivery(ctx context.Context, params model.WebhookDeliveryRequest) error {
+	subscription, err := s.getSubscription(ctx, params.SubscriptionID, params.ExecutorID, params.ServiceAccountID)
+	if err != nil {
+		return err
+	}
+	if subscription.DisabledAt != nil {
+		return model.ErrWebhookSubscriptionDisabled
+	}
+	delivery, err := s.getDelivery(ctx, params)
+	if err != nil {
+		return err
+	}
+	if delivery.Status == model.WebhookDeliveryStatusPending {
+		return model.ErrWebhookDeliveryPending
+	}
+	message, err := model.NewDeliverWebhookMessage(delivery.ID, delivery.Attempts+1, nil)
+	if err != nil {
+		return err
+	}
+	return s.webhookRepo.ReplayWebhookDelivery(ctx, delivery.ID, *message, s.nowFn())
+}
+
+// DispatchEvent creates the deliveries of a webhook event, run by the worker for the messages written with the
+// changes.
+func (s *WebhookService) DispatchEvent(ctx context.Context, task model.DispatchWebhookEventTask) error {
+	payload, err := json.Marshal(task.Event)
+	if err != nil {
+		return err
+	}
+	return s.webhookRepo.CreateWebhookDeliveries(ctx, task.EventID, task.Event, payload)
+}
+
+// DeliverWebhook runs an attempt of a delivery. Any answer but a 2xx fails it, the failures are retried with a
+// growing delay until MaxAttempts. The error of the endpoint is logged with the attempt, not returned, so the queue
+// does not retry the task itself.
+func (s *WebhookService) DeliverWebhook(ctx context.Context, task model.DeliverWebhookTask) error {
+	delivery, err := s.webhookRepo.GetWebhookDeliveryByID(ctx, task.DeliveryID)
+	if errors.Is(err, _errors.ErrNotFound) {
+		log.Println("skipping webhook delivery of a deleted subscription", task.DeliveryID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if delivery.Status != model.WebhookDeliveryStatusPending || delivery.Attempts >= task.Attempt {
+		log.Println("skipping webhook delivery attempt", delivery.ID, task.Attempt, delivery.Status)
+		return nil
+	}
+	subscription, err := s.webhookRepo.GetWebhookSubscriptionByID(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	if subscription.DisabledAt != nil {
+		log.Println("skipping webhook delivery of a disabled subscription", delivery.ID)
+		return nil
+	}
+
+	start := s.nowFn()
+	response, sendErr := s.sender.Send(ctx, webhook.Request{
+		URL:    subscription.URL,
+		Secret: subscription.Secret,
+		ID:     delivery.WebhookEventID,
+		Event:  string(delivery.EventType),
+		Body:   delivery.Payload,
+	})
+	now := s.nowFn()
+	attempt := model.WebhookDeliveryAttempt{
+		DeliveryID: delivery.ID,
+		Attempt:    task.Attempt,
+		DurationMs: int(now.Sub(start).Milliseconds()),
+		CreatedAt:  now,
+	}
+	switch {
+	case sendErr != nil:
+		attempt.Error = sendErr.Error()
+	default:
+		attempt.StatusCode = response.StatusCode
+		attempt.ResponseBody = response.Body
+		attempt.DurationMs = int(response.Duration.Milliseconds())
+		if response.StatusCode < 200 || response.StatusCode > 299 {
+			attempt.Error = fmt.Sprintf("unexpected status %d", response.StatusCode)
+		}
+	}
+
+	outcome := model.WebhookDeliveryOutcome{Attempt: attempt, Status: model.WebhookDeliveryStatusSucceeded}
+	if attempt.Error != "" {
+		outcome, err = s.failedOutcome(delivery, attempt)
+		if err != nil {
+			return err
+		}
+	}
+	return s.webhookRepo.RecordWebhookDeliveryAttempt(ctx, outcome, s.cfg.DisableAfter)
+}
+
+func (s *WebhookService) failedOutcome(delivery *model.WebhookDelivery, attempt model.WebhookDeliveryAttempt) (model.WebhookDeliveryOutcome, error) {
+	failures := delivery.Failures + 1
+	if failures >= s.cfg.MaxAttempts {
+		log.Println("giving up webhook delivery", delivery.ID, attempt.Error)
+		return model.WebhookDeliveryOutcome{Attempt: attempt, Status: model.WebhookDeliveryStatusFailed}, nil
+	}
+	backoff := s.cfg.RetryBackoff << (failures - 1)
+	if backoff > s.cfg.MaxRetryBackoff || backoff <= 0 {
+		backoff = s.cfg.MaxRetryBackoff
+	}
+	nextAttemptAt := attempt.CreatedAt.Add(backoff)
+	retry, err := model.NewDeliverWebhookMessage(delivery.ID, attempt.Attempt+1, &nextAttemptAt)
+	if err != nil {
+		return model.WebhookDeliveryOutcome{}, err
+	}
+	return model.WebhookDeliveryOutcome{
+		Attempt:       attempt,
+		Status:        model.WebhookDeliveryStatusPending,
+		NextAttemptAt: &nextAttemptAt,
+		Retry:         retry,
+	}, nil
+}
+
+// getSubscription returns a subscription of the executor. An API client only gets the subscriptions of its service
+// account, the organizer gets all of them.
+func (s *WebhookService) getSubscription(ctx context.Context, id int, executorID int, serviceAccountID int) (*model.WebhookSubscription, error) {
+	subscription, err := s.webhookRepo.GetWebhookSubscriptionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.OwnerID != executorID {
+		return nil, _errors.ErrForbidden
+	}
+	if serviceAccountID != 0 && (subscription.ServiceAccountID == nil || *subscription.ServiceAccountID != serviceAccountID) {
+		return nil, _errors.ErrForbidden
+	}
+	return subscription, nil
+}
+
+func (s *WebhookService) getDelivery(ctx context.Context, params model.WebhookDeliveryRequest) (*model.WebhookDelivery, error) {
+	if _, err := s.getSubscription(ctx, params.SubscriptionID, params.ExecutorID, params.ServiceAccountID); err != nil {
+		return nil, err
+	}
+	delivery, err := s.webhookRepo.GetWebhookDeliveryByID(ctx, params.DeliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.SubscriptionID != params.SubscriptionID {
+		return nil, _errors.ErrNotFound
+	}
+	return delivery, nil
+}
+
+// checkURL refuses the plain http endpoints, the deliveries carry the bookings of the attendees, and the hosts
+// resolving to an internal address, the attempts log the responses of the endpoints.
+func (s *WebhookService) checkURL(ctx context.Context, rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "https" && (parsed.Scheme != "http" || !s.cfg.AllowInsecureURL) {
+		return model.ErrInsecureWebhookURL
+	}
+	var dnsErr *net.DNSError
+	err = s.sender.CheckURL(ctx, rawURL)
+	if errors.Is(err, webhook.ErrPrivateAddress) || errors.As(err, &dnsErr) {
+		return model.ErrUnreachableWebhookURL
+	}
+	return err
+}
@@ -0,0 +1,400 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+
+	_errors "booking-event/internal/common/errors"
+	"booking-event/internal/common/util"
+	"booking-event/internal/infra/webhook"
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestWebhookService_CreateSubscription(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		cfg              WebhookConfig
+		params           model.CreateWebhookSubscriptionRequest
+		mockWebhookRepo  func(ctrl *gomock.Controller) *MockWebhookRepository
+		mockSender       func(ctrl *gomock.Controller) *MockWebhookSender
+		expectedError    error
+		expectedAccount  *int
+		expectedSucceeds bool
+	}{
+		{
+			name:   "Subscription of an API client created with a secret",
+			params: model.CreateWebhookSubscriptionRequest{URL: "https://partner.example.com/hooks", EventTypes: []model.WebhookEventType{model.WebhookEventBookingConfirmed}, ServiceAccountID: 3, ExecutorID: 5},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, subscription *model.WebhookSubscription) error {
+					assert.Equal(t, 5, subscription.OwnerID)
+					assert.Equal(t, "https://partner.example.com/hooks", subscription.URL)
+					assert.Len(t, subscription.Secret, len("whsec_")+48)
+					subscription.ID = 4
+					return nil
+				})
+				return mock
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				mock := NewMockWebhookSender(ctrl)
+				mock.EXPECT().CheckURL(gomock.Any(), "https://partner.example.com/hooks").Return(nil)
+				return mock
+			},
+			expectedAccount:  util.ToPtr(3),
+			expectedSucceeds: true,
+		},
+		{
+			name:   "Plain http endpoint refused",
+			params: model.CreateWebhookSubscriptionRequest{URL: "http://partner.example.com/hooks", EventTypes: []model.WebhookEventType{model.WebhookEventBookingConfirmed}, ExecutorID: 5},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				return NewMockWebhookRepository(ctrl)
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				return NewMockWebhookSender(ctrl)
+			},
+			expectedError: model.ErrInsecureWebhookURL,
+		},
+		{
+			name:   "Plain http endpoint allowed for the local setups",
+			cfg:    WebhookConfig{AllowInsecureURL: true},
+			params: model.CreateWebhookSubscriptionRequest{URL: "http://partner.example.com/hooks", EventTypes: []model.WebhookEventType{model.WebhookEventEventUpdated}, ExecutorID: 5},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().CreateWebhookSubscription(gomock.Any(), gomock.Any()).Return(nil)
+				return mock
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				mock := NewMockWebhookSender(ctrl)
+				mock.EXPECT().CheckURL(gomock.Any(), "http://partner.example.com/hooks").Return(nil)
+				return mock
+			},
+			expectedSucceeds: true,
+		},
+		{
+			name:   "Endpoint on an internal address refused",
+			params: model.CreateWebhookSubscriptionRequest{URL: "https://169.254.169.254/latest/meta-data", EventTypes: []model.WebhookEventType{model.WebhookEventBookingCreated}, ExecutorID: 5},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				return NewMockWebhookRepository(ctrl)
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				mock := NewMockWebhookSender(ctrl)
+				mock.EXPECT().CheckURL(gomock.Any(), "https://169.254.169.254/latest/meta-data").Return(webhook.ErrPrivateAddress)
+				return mock
+			},
+			expectedError: model.ErrUnreachableWebhookURL,
+		},
+		{
+			name:   "Endpoint of an unknown host refused",
+			params: model.CreateWebhookSubscriptionRequest{URL: "https://unknown.invalid/hooks", EventTypes: []model.WebhookEventType{model.WebhookEventBookingCreated}, ExecutorID: 5},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				return NewMockWebhookRepository(ctrl)
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				mock := NewMockWebhookSender(ctrl)
+				mock.EXPECT().CheckURL(gomock.Any(), gomock.Any()).Return(&net.DNSError{Err: "no such host", Name: "unknown.invalid", IsNotFound: true})
+				return mock
+			},
+			expectedError: model.ErrUnreachableWebhookURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewWebhookService(tt.mockWebhookRepo(ctrl), tt.mockSender(ctrl), func() time.Time { return now }, tt.cfg)
+			subscription, err := service.CreateSubscription(context.Background(), tt.params)
+			assert.ErrorIs(t, err, tt.expectedError)
+			if !tt.expectedSucceeds {
+				assert.Nil(t, subscription)
+				return
+			}
+			assert.Equal(t, tt.expectedAccount, subscription.ServiceAccountID)
+			assert.NotEmpty(t, subscription.Secret)
+
+			// the secret is only shown when the subscription is created
+			body, err := json.Marshal(subscription.WebhookSubscription)
+			assert.NoError(t, err)
+			assert.NotContains(t, string(body), subscription.Secret)
+		})
+	}
+}
+
+func TestWebhookService_UpdateSubscription(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	webhookRepo := NewMockWebhookRepository(ctrl)
+	webhookRepo.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(&model.WebhookSubscription{ID: 4, OwnerID: 5, URL: "https://partner.example.com/hooks"}, nil)
+	sender := NewMockWebhookSender(ctrl)
+	sender.EXPECT().CheckURL(gomock.Any(), "https://localhost:8443/hooks").Return(webhook.ErrPrivateAddress)
+
+	service := NewWebhookService(webhookRepo, sender, func() time.Time { return now }, WebhookConfig{})
+	_, err := service.UpdateSubscription(context.Background(), model.UpdateWebhookSubscriptionRequest{
+		SubscriptionID: 4,
+		URL:            util.ToPtr("https://localhost:8443/hooks"),
+		ExecutorID:     5,
+	})
+	assert.ErrorIs(t, err, model.ErrUnreachableWebhookURL)
+}
+
+func TestWebhookService_DeliverWebhook(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	cfg := WebhookConfig{MaxAttempts: 3, RetryBackoff: 30 * time.Second, MaxRetryBackoff: 45 * time.Second, DisableAfter: 5}
+	subscription := &model.WebhookSubscription{ID: 4, OwnerID: 5, URL: "https://partner.example.com/hooks", Secret: "whsec_test"}
+	delivery := func(attempts int, failures int) *model.WebhookDelivery {
+		return &model.WebhookDelivery{
+			ID:             9,
+			SubscriptionID: 4,
+			WebhookEventID: "booking.confirmed:7",
+			EventType:      model.WebhookEventBookingConfirmed,
+			Payload:        json.RawMessage(`{"id":"booking.confirmed:7"}`),
+			Status:         model.WebhookDeliveryStatusPending,
+			Attempts:       attempts,
+			Failures:       failures,
+		}
+	}
+	request := webhook.Request{
+		URL:    "https://partner.example.com/hooks",
+		Secret: "whsec_test",
+		ID:     "booking.confirmed:7",
+		Event:  "booking.confirmed",
+		Body:   []byte(`{"id":"booking.confirmed:7"}`),
+	}
+
+	tests := []struct {
+		name            string
+		task            model.DeliverWebhookTask
+		mockWebhookRepo func(ctrl *gomock.Controller) *MockWebhookRepository
+		mockSender      func(ctrl *gomock.Controller) *MockWebhookSender
+		expectedError   error
+	}{
+		{
+			name: "Delivery succeeded",
+			task: model.DeliverWebhookTask{DeliveryID: 9, Attempt: 1},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookDeliveryByID(gomock.Any(), int64(9)).Return(delivery(0, 0), nil)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(subscription, nil)
+				mock.EXPECT().RecordWebhookDeliveryAttempt(gomock.Any(), model.WebhookDeliveryOutcome{
+					Attempt: model.WebhookDeliveryAttempt{DeliveryID: 9, Attempt: 1, StatusCode: 200, ResponseBody: "ok", DurationMs: 120, CreatedAt: now},
+					Status:  model.WebhookDeliveryStatusSucceeded,
+				}, 5).Return(nil)
+				return mock
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				mock := NewMockWebhookSender(ctrl)
+				mock.EXPECT().Send(gomock.Any(), request).Return(&webhook.Response{StatusCode: 200, Body: "ok", Duration: 120 * time.Millisecond}, nil)
+				return mock
+			},
+		},
+		{
+			name: "Failed delivery retried with a growing delay",
+			task: model.DeliverWebhookTask{DeliveryID: 9, Attempt: 2},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookDeliveryByID(gomock.Any(), int64(9)).Return(delivery(1, 1), nil)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(subscription, nil)
+				mock.EXPECT().RecordWebhookDeliveryAttempt(gomock.Any(), gomock.Any(), 5).DoAndReturn(func(ctx context.Context, outcome model.WebhookDeliveryOutcome, disableAfter int) error {
+					assert.Equal(t, model.WebhookDeliveryStatusPending, outcome.Status)
+					assert.Equal(t, 500, outcome.Attempt.StatusCode)
+					assert.Equal(t, "unexpected status 500", outcome.Attempt.Error)
+					// 30s doubled for the second failure, capped at 45s
+					assert.Equal(t, now.Add(45*time.Second), *outcome.NextAttemptAt)
+					assert.Equal(t, model.TaskTypeDeliverWebhook, outcome.Retry.TaskType)
+					assert.Equal(t, "webhook_delivery:9:3", outcome.Retry.DedupKey)
+					assert.Equal(t, now.Add(45*time.Second), *outcome.Retry.ProcessAt)
+					return nil
+				})
+				return mock
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				mock := NewMockWebhookSender(ctrl)
+				mock.EXPECT().Send(gomock.Any(), request).Return(&webhook.Response{StatusCode: 500, Body: "oops"}, nil)
+				return mock
+			},
+		},
+		{
+			name: "Delivery given up after the last attempt",
+			task: model.DeliverWebhookTask{DeliveryID: 9, Attempt: 3},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookDeliveryByID(gomock.Any(), int64(9)).Return(delivery(2, 2), nil)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(subscription, nil)
+				mock.EXPECT().RecordWebhookDeliveryAttempt(gomock.Any(), model.WebhookDeliveryOutcome{
+					Attempt: model.WebhookDeliveryAttempt{DeliveryID: 9, Attempt: 3, Error: assert.AnError.Error(), CreatedAt: now},
+					Status:  model.WebhookDeliveryStatusFailed,
+				}, 5).Return(nil)
+				return mock
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				mock := NewMockWebhookSender(ctrl)
+				mock.EXPECT().Send(gomock.Any(), request).Return(nil, assert.AnError)
+				return mock
+			},
+		},
+		{
+			name: "Attempt already run skipped",
+			task: model.DeliverWebhookTask{DeliveryID: 9, Attempt: 1},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookDeliveryByID(gomock.Any(), int64(9)).Return(delivery(1, 1), nil)
+				return mock
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				return NewMockWebhookSender(ctrl)
+			},
+		},
+		{
+			name: "Delivery of a disabled subscription skipped",
+			task: model.DeliverWebhookTask{DeliveryID: 9, Attempt: 1},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookDeliveryByID(gomock.Any(), int64(9)).Return(delivery(0, 0), nil)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(&model.WebhookSubscription{ID: 4, DisabledAt: &now}, nil)
+				return mock
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				return NewMockWebhookSender(ctrl)
+			},
+		},
+		{
+			name: "Delivery of a deleted subscription skipped",
+			task: model.DeliverWebhookTask{DeliveryID: 9, Attempt: 1},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookDeliveryByID(gomock.Any(), int64(9)).Return(nil, _errors.ErrNotFound)
+				return mock
+			},
+			mockSender: func(ctrl *gomock.Controller) *MockWebhookSender {
+				return NewMockWebhookSender(ctrl)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewWebhookService(tt.mockWebhookRepo(ctrl), tt.mockSender(ctrl), func() time.Time { return now }, cfg)
+			err := service.DeliverWebhook(context.Background(), tt.task)
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestWebhookService_ReplayDelivery(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	params := model.WebhookDeliveryRequest{SubscriptionID: 4, DeliveryID: 9, ExecutorID: 5}
+
+	tests := []struct {
+		name            string
+		params          model.WebhookDeliveryRequest
+		mockWebhookRepo func(ctrl *gomock.Controller) *MockWebhookRepository
+		expectedError   error
+	}{
+		{
+			name:   "Failed delivery replayed with the next attempt",
+			params: params,
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(&model.WebhookSubscription{ID: 4, OwnerID: 5}, nil).Times(2)
+				mock.EXPECT().GetWebhookDeliveryByID(gomock.Any(), int64(9)).Return(&model.WebhookDelivery{ID: 9, SubscriptionID: 4, Status: model.WebhookDeliveryStatusFailed, Attempts: 8}, nil)
+				mock.EXPECT().ReplayWebhookDelivery(gomock.Any(), int64(9), gomock.Any(), now).DoAndReturn(func(ctx context.Context, deliveryID int64, message model.OutboxMessage, now time.Time) error {
+					assert.Equal(t, "webhook_delivery:9:9", message.DedupKey)
+					assert.Nil(t, message.ProcessAt)
+					return nil
+				})
+				return mock
+			},
+		},
+		{
+			name:   "Delivery still pending",
+			params: params,
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(&model.WebhookSubscription{ID: 4, OwnerID: 5}, nil).Times(2)
+				mock.EXPECT().GetWebhookDeliveryByID(gomock.Any(), int64(9)).Return(&model.WebhookDelivery{ID: 9, SubscriptionID: 4, Status: model.WebhookDeliveryStatusPending, Attempts: 1}, nil)
+				return mock
+			},
+			expectedError: model.ErrWebhookDeliveryPending,
+		},
+		{
+			name:   "Disabled subscription",
+			params: params,
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(&model.WebhookSubscription{ID: 4, OwnerID: 5, DisabledAt: &now}, nil)
+				return mock
+			},
+			expectedError: model.ErrWebhookSubscriptionDisabled,
+		},
+		{
+			name:   "Delivery of another subscription",
+			params: params,
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(&model.WebhookSubscription{ID: 4, OwnerID: 5}, nil).Times(2)
+				mock.EXPECT().GetWebhookDeliveryByID(gomock.Any(), int64(9)).Return(&model.WebhookDelivery{ID: 9, SubscriptionID: 6, Status: model.WebhookDeliveryStatusFailed}, nil)
+				return mock
+			},
+			expectedError: _errors.ErrNotFound,
+		},
+		{
+			name:   "Subscription of another organizer",
+			params: params,
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(&model.WebhookSubscription{ID: 4, OwnerID: 6}, nil)
+				return mock
+			},
+			expectedError: _errors.ErrForbidden,
+		},
+		{
+			name:   "Subscription of another service account of the organizer",
+			params: model.WebhookDeliveryRequest{SubscriptionID: 4, DeliveryID: 9, ServiceAccountID: 3, ExecutorID: 5},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(&model.WebhookSubscription{ID: 4, OwnerID: 5, ServiceAccountID: util.ToPtr(7)}, nil)
+				return mock
+			},
+			expectedError: _errors.ErrForbidden,
+		},
+		{
+			name:   "Subscription of the organizer replayed with an API key",
+			params: model.WebhookDeliveryRequest{SubscriptionID: 4, DeliveryID: 9, ServiceAccountID: 3, ExecutorID: 5},
+			mockWebhookRepo: func(ctrl *gomock.Controller) *MockWebhookRepository {
+				mock := NewMockWebhookRepository(ctrl)
+				mock.EXPECT().GetWebhookSubscriptionByID(gomock.Any(), 4).Return(&model.WebhookSubscription{ID: 4, OwnerID: 5}, nil)
+				return mock
+			},
+			expectedError: _errors.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			service := NewWebhookService(tt.mockWebhookRepo(ctrl), NewMockWebhookSender(ctrl), func() time.Time { return now }, WebhookConfig{})
+			err := service.ReplayDelivery(context.Background(), tt.params)
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
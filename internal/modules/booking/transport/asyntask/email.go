@@ -11,6 +11,9 @@ import (
 type EmailService interface {
 	SendReminderEmail(ctx context.Context, task model.SendReminderEmailTask) error
 	SendConfirmationEmail(ctx context.Context, task model.SendConfirmationEmailTask) error
+	SendCancellationEmail(ctx context.Context, task model.SendCancellationEmailTask) error
+	SendEventReviewEmail(ctx context.Context, task model.SendEventReviewEmailTask) error
+	SendEventChangeEmail(ctx context.Context, task model.SendEventChangeEmailTask) error
 }
 
 type EmailTaskHandler struct {
@@ -37,7 +40,34 @@ func (h *EmailTaskHandler) HandleConfirmationEmail(ctx context.Context, t *asynq
 	return h.emailService.SendConfirmationEmail(ctx, task)
 }
 
+func (h *EmailTaskHandler) HandleCancellationEmail(ctx context.Context, t *asynq.Task) error {
+	var task model.SendCancellationEmailTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.emailService.SendCancellationEmail(ctx, task)
+}
+
+func (h *EmailTaskHandler) HandleEventReviewEmail(ctx context.Context, t *asynq.Task) error {
+	var task model.SendEventReviewEmailTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.emailService.SendEventReviewEmail(ctx, task)
+}
+
+func (h *EmailTaskHandler) HandleEventChangeEmail(ctx context.Context, t *asynq.Task) error {
+	var task model.SendEventChangeEmailTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.emailService.SendEventChangeEmail(ctx, task)
+}
+
 func (h *EmailTaskHandler) Register(mux *asynq.ServeMux) {
 	mux.HandleFunc(string(model.TaskTypeSendReminderEmail), h.HandleReminderEmail)
 	mux.HandleFunc(string(model.TaskTypeSendConfirmationEmail), h.HandleConfirmationEmail)
+	mux.HandleFunc(string(model.TaskTypeSendCancellationEmail), h.HandleCancellationEmail)
+	mux.HandleFunc(string(model.TaskTypeSendEventReviewEmail), h.HandleEventReviewEmail)
+	mux.HandleFunc(string(model.TaskTypeSendEventChangeEmail), h.HandleEventChangeEmail)
 }
@@ -0,0 +1,28 @@
+package asyntask
+
+import (
+	"booking-event/internal/modules/booking/model"
+	"context"
+
+	"github.com/hibiken/asynq"
+)
+
+type OutboxRelayService interface {
+	RelayMessages(ctx context.Context) error
+}
+
+type OutboxTaskHandler struct {
+	outboxService OutboxRelayService
+}
+
+func NewOutboxTaskHandler(outboxService OutboxRelayService) *OutboxTaskHandler {
+	return &OutboxTaskHandler{outboxService: outboxService}
+}
+
+func (h *OutboxTaskHandler) HandleRelayOutbox(ctx context.Context, t *asynq.Task) error {
+	return h.outboxService.RelayMessages(ctx)
+}
+
+func (h *OutboxTaskHandler) Register(mux *asynq.ServeMux) {
+	mux.HandleFunc(string(model.TaskTypeRelayOutbox), h.HandleRelayOutbox)
+}
@@ -0,0 +1,33 @@
+package asyntask
+
+import (
+	"booking-event/internal/modules/booking/model"
+	"context"
+	"encoding/json"
+
+	"github.com/hibiken/asynq"
+)
+
+type ReminderService interface {
+	SyncReminders(ctx context.Context, task model.SyncRemindersTask) error
+}
+
+type ReminderTaskHandler struct {
+	reminderService ReminderService
+}
+
+func NewReminderTaskHandler(reminderService ReminderService) *ReminderTaskHandler {
+	return &ReminderTaskHandler{reminderService: reminderService}
+}
+
+func (h *ReminderTaskHandler) HandleSyncReminders(ctx context.Context, t *asynq.Task) error {
+	var task model.SyncRemindersTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.reminderService.SyncReminders(ctx, task)
+}
+
+func (h *ReminderTaskHandler) Register(mux *asynq.ServeMux) {
+	mux.HandleFunc(string(model.TaskTypeSyncReminders), h.HandleSyncReminders)
+}
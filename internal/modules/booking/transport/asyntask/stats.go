@@ -0,0 +1,28 @@
+package asyntask
+
+import (
+	"booking-event/internal/modules/booking/model"
+	"context"
+
+	"github.com/hibiken/asynq"
+)
+
+type SalesStatsService interface {
+	RefreshSalesStats(ctx context.Context) error
+}
+
+type StatsTaskHandler struct {
+	statsService SalesStatsService
+}
+
+func NewStatsTaskHandler(statsService SalesStatsService) *StatsTaskHandler {
+	return &StatsTaskHandler{statsService: statsService}
+}
+
+func (h *StatsTaskHandler) HandleRefreshSalesStats(ctx context.Context, t *asynq.Task) error {
+	return h.statsService.RefreshSalesStats(ctx)
+}
+
+func (h *StatsTaskHandler) Register(mux *asynq.ServeMux) {
+	mux.HandleFunc(string(model.TaskTypeRefreshSalesStats), h.HandleRefreshSalesStats)
+}
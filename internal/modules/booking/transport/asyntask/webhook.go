@@ -0,0 +1,43 @@
+package asyntask
+
+import (
+	"booking-event/internal/modules/booking/model"
+	"context"
+	"encoding/json"
+
+	"github.com/hibiken/asynq"
+)
+
+type WebhookService interface {
+	DispatchEvent(ctx context.Context, task model.DispatchWebhookEventTask) error
+	DeliverWebhook(ctx context.Context, task model.DeliverWebhookTask) error
+}
+
+type WebhookTaskHandler struct {
+	webhookService WebhookService
+}
+
+func NewWebhookTaskHandler(webhookService WebhookService) *WebhookTaskHandler {
+	return &WebhookTaskHandler{webhookService: webhookService}
+}
+
+func (h *WebhookTaskHandler) HandleDispatchWebhookEvent(ctx context.Context, t *asynq.Task) error {
+	var task model.DispatchWebhookEventTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.webhookService.DispatchEvent(ctx, task)
+}
+
+func (h *WebhookTaskHandler) HandleDeliverWebhook(ctx context.Context, t *asynq.Task) error {
+	var task model.DeliverWebhookTask
+	if err := json.Unmarshal(t.Payload(), &task); err != nil {
+		return err
+	}
+	return h.webhookService.DeliverWebhook(ctx, task)
+}
+
+func (h *WebhookTaskHandler) Register(mux *asynq.ServeMux) {
+	mux.HandleFunc(string(model.TaskTypeDispatchWebhookEvent), h.HandleDispatchWebhookEvent)
+	mux.HandleFunc(string(model.TaskTypeDeliverWebhook), h.HandleDeliverWebhook)
+}
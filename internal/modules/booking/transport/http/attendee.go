@@ -0,0 +1,248 @@
+//go:generate mockgen -source=attendee.go -destination=attendee_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/middleware"
+	"booking-event/internal/modules/booking/model"
+)
+
+type AttendeeHandler interface {
+	ExportAttendees(ctx context.Context, query model.AttendeeQuery, writer model.AttendeeWriter) error
+	CheckIn(ctx context.Context, params model.CheckInRequest) (*time.Time, error)
+}
+
+type AttendeeHttpHandler struct {
+	attendeeService AttendeeHandler
+}
+
+func NewAttendeeHandler(attendeeService AttendeeHandler) handler.HttpHandler {
+	return &AttendeeHttpHandler{attendeeService: attendeeService}
+}
+
+func (h *AttendeeHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/events/:event_id/attendees", middleware.RequirePermissions(model.PermissionEventManageOwn), h.ExportAttendees)
+	router.POST("/events/:event_id/check-in", middleware.RequirePermissions(model.PermissionCheckinScan), h.CheckIn)
+}
+
+func (h *AttendeeHttpHandler) ExportAttendees(c *gin.Context) {
+	var query model.AttendeeQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	query.EventID, err = strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	query.ExecutorID = util.GetUserIDContext(c.Request.Context())
+	query.ExecutorIsAdmin = util.GetUserRoleContext(c.Request.Context()) == model.UserRoleAdmin
+
+	var writer model.AttendeeWriter
+	if query.Format == model.AttendeeExportFormatJSON {
+		writer = &jsonAttendeeWriter{c: c}
+	} else {
+		writer = &csvAttendeeWriter{c: c, eventID: query.EventID}
+	}
+
+	err = h.attendeeService.ExportAttendees(c.Request.Context(), query, writer)
+	if err == nil {
+		return
+	}
+	if c.Writer.Written() {
+		// the status is already sent, the truncated body is all the client gets
+		log.Println("error streaming attendees", err)
+		return
+	}
+	status := statusFromError(err)
+	if errors.Is(err, commonmodel.ErrInvalidCursor) {
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, commonmodel.Response{
+		Success: false,
+		Data:    nil,
+		Message: err.Error(),
+	})
+}
+
+func (h *AttendeeHttpHandler) CheckIn(c *gin.Context) {
+	var request model.CheckInRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	request.EventID, err = strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
+	request.ExecutorIsAdmin = util.GetUserRoleContext(c.Request.Context()) == model.UserRoleAdmin
+
+	checkedInAt, err := h.attendeeService.CheckIn(c.Request.Context(), request)
+	if errors.Is(err, model.ErrAlreadyCheckedIn) || errors.Is(err, model.ErrTicketNotValid) {
+		c.JSON(http.StatusConflict, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    gin.H{"token": request.Token, "checked_in_at": checkedInAt},
+		Message: "ticket checked in",
+	})
+}
+
+// csvAttendeeWriter streams the attendees as a CSV file, the next page cursor is sent in the X-Next-Cursor header.
+type csvAttendeeWriter struct {
+	c       *gin.Context
+	eventID int
+	csv     *csv.Writer
+	rows    int
+}
+
+func (w *csvAttendeeWriter) Begin(nextCursor string) error {
+	header := w.c.Writer.Header()
+	header.Set("Content-Type", "text/csv; charset=utf-8")
+	header.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="event-%d-attendees.csv"`, w.eventID))
+	if nextCursor != "" {
+		header.Set("X-Next-Cursor", nextCursor)
+	}
+	w.c.Status(http.StatusOK)
+	w.c.Writer.WriteHeaderNow()
+	w.csv = csv.NewWriter(w.c.Writer)
+	return w.csv.Write([]string{"ticket_id", "token", "booking_id", "user_id", "email", "booked_at", "checked_in_at"})
+}
+
+func (w *csvAttendeeWriter) Write(attendee model.Attendee) error {
+	checkedInAt := ""
+	if attendee.CheckedInAt != nil {
+		checkedInAt = attendee.CheckedInAt.Format(time.RFC3339)
+	}
+	err := w.csv.Write([]string{
+		strconv.Itoa(attendee.TicketID),
+		attendee.Token,
+		strconv.Itoa(attendee.BookingID),
+		strconv.Itoa(attendee.UserID),
+		attendee.Email,
+		attendee.BookedAt.Format(time.RFC3339),
+		checkedInAt,
+	})
+	if err != nil {
+		return err
+	}
+	w.rows++
+	if w.rows%500 == 0 {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *csvAttendeeWriter) End() error {
+	return w.flush()
+}
+
+func (w *csvAttendeeWriter) flush() error {
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		return err
+	}
+	w.c.Writer.Flush()
+	return nil
+}
+
+// jsonAttendeeWriter streams the attendees inside the usual response envelope.
+type jsonAttendeeWriter struct {
+	c    *gin.Context
+	rows int
+}
+
+func (w *jsonAttendeeWriter) Begin(nextCursor string) error {
+	w.c.Writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if nextCursor != "" {
+		w.c.Writer.Header().Set("X-Next-Cursor", nextCursor)
+	}
+	w.c.Status(http.StatusOK)
+	w.c.Writer.WriteHeaderNow()
+
+	cursor, err := json.Marshal(nextCursor)
+	if err != nil {
+		return err
+	}
+	prefix := `{"success":true,"message":"attendees retrieved",`
+	if nextCursor != "" {
+		prefix += `"next_cursor":` + string(cursor) + `,`
+	}
+	_, err = io.WriteString(w.c.Writer, prefix+`"data":[`)
+	return err
+}
+
+func (w *jsonAttendeeWriter) Write(attendee model.Attendee) error {
+	b, err := json.Marshal(attendee)
+	if err != nil {
+		return err
+	}
+	if w.rows > 0 {
+		if _, err := io.WriteString(w.c.Writer, ","); err != nil {
+			return err
+		}
+	}
+	if _, err := w.c.Writer.Write(b); err != nil {
+		return err
+	}
+	w.rows++
+	if w.rows%500 == 0 {
+		w.c.Writer.Flush()
+	}
+	return nil
+}
+
+func (w *jsonAttendeeWriter) End() error {
+	_, err := io.WriteString(w.c.Writer, "]}")
+	w.c.Writer.Flush()
+	return err
+}
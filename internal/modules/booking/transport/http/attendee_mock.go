@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attendee.go
+//
+// Generated by this command:
+//
+//	mockgen -source=attendee.go -destination=attendee_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttendeeHandler is a mock of AttendeeHandler interface.
+type MockAttendeeHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendeeHandlerMockRecorder
+}
+
+// MockAttendeeHandlerMockRecorder is the mock recorder for MockAttendeeHandler.
+type MockAttendeeHandlerMockRecorder struct {
+	mock *MockAttendeeHandler
+}
+
+// NewMockAttendeeHandler creates a new mock instance.
+func NewMockAttendeeHandler(ctrl *gomock.Controller) *MockAttendeeHandler {
+	mock := &MockAttendeeHandler{ctrl: ctrl}
+	mock.recorder = &MockAttendeeHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendeeHandler) EXPECT() *MockAttendeeHandlerMockRecorder {
+	return m.recorder
+}
+
+// CheckIn mocks base method.
+func (m *MockAttendeeHandler) CheckIn(ctx context.Context, params model.CheckInRequest) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, params)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockAttendeeHandlerMockRecorder) CheckIn(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockAttendeeHandler)(nil).CheckIn), ctx, params)
+}
+
+// ExportAttendees mocks base method.
+func (m *MockAttendeeHandler) ExportAttendees(ctx context.Context, query model.AttendeeQuery, writer model.AttendeeWriter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAttendees", ctx, query, writer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportAttendees indicates an expected call of ExportAttendees.
+func (mr *MockAttendeeHandlerMockRecorder) ExportAttendees(ctx, query, writer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAttendees", reflect.TypeOf((*MockAttendeeHandler)(nil).ExportAttendees), ctx, query, writer)
+}
@@ -0,0 +1,145 @@
+package transporthttp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	_errors "booking-event/internal/common/errors"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestAttendeeHttpHandler_ExportAttendees(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	bookedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	checkedInAt := time.Date(2024, 6, 1, 18, 30, 0, 0, time.UTC)
+	attendees := []model.Attendee{
+		{TicketID: 1, Token: "token1", BookingID: 10, UserID: 100, Email: "first@example.com", BookedAt: bookedAt, CheckedInAt: &checkedInAt},
+		{TicketID: 2, Token: "token2", BookingID: 11, UserID: 101, Email: "second@example.com", BookedAt: bookedAt},
+	}
+	streamAttendees := func(nextCursor string) func(ctx context.Context, query model.AttendeeQuery, writer model.AttendeeWriter) error {
+		return func(ctx context.Context, query model.AttendeeQuery, writer model.AttendeeWriter) error {
+			if err := writer.Begin(nextCursor); err != nil {
+				return err
+			}
+			for _, attendee := range attendees {
+				if err := writer.Write(attendee); err != nil {
+					return err
+				}
+			}
+			return writer.End()
+		}
+	}
+
+	tests := []struct {
+		name                string
+		url                 string
+		role                string
+		mockAttendeeService func(ctrl *gomock.Controller) *MockAttendeeHandler
+		expectedStatus      int
+		expectedContentType string
+		expectedNextCursor  string
+		checkBody           func(t *testing.T, body []byte)
+	}{
+		{
+			name: "CSV export",
+			url:  "/events/1/attendees",
+			mockAttendeeService: func(ctrl *gomock.Controller) *MockAttendeeHandler {
+				mock := NewMockAttendeeHandler(ctrl)
+				mock.EXPECT().ExportAttendees(gomock.Any(), model.AttendeeQuery{EventID: 1, ExecutorID: 1}, gomock.Any()).DoAndReturn(streamAttendees(""))
+				return mock
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			checkBody: func(t *testing.T, body []byte) {
+				assert.Equal(t, "ticket_id,token,booking_id,user_id,email,booked_at,checked_in_at\n"+
+					"1,token1,10,100,first@example.com,2024-05-01T10:00:00Z,2024-06-01T18:30:00Z\n"+
+					"2,token2,11,101,second@example.com,2024-05-01T10:00:00Z,\n", string(body))
+			},
+		},
+		{
+			name: "JSON page export by an admin",
+			url:  "/events/1/attendees?format=json&checked_in=false&limit=2",
+			role: model.UserRoleAdmin,
+			mockAttendeeService: func(ctrl *gomock.Controller) *MockAttendeeHandler {
+				mock := NewMockAttendeeHandler(ctrl)
+				mock.EXPECT().ExportAttendees(gomock.Any(), model.AttendeeQuery{
+					EventID:         1,
+					CheckedIn:       util.ToPtr(false),
+					Format:          model.AttendeeExportFormatJSON,
+					Pagination:      commonmodel.Pagination{Limit: 2},
+					ExecutorID:      1,
+					ExecutorIsAdmin: true,
+				}, gomock.Any()).DoAndReturn(streamAttendees("next"))
+				return mock
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json; charset=utf-8",
+			expectedNextCursor:  "next",
+			checkBody: func(t *testing.T, body []byte) {
+				var response struct {
+					commonmodel.Response
+					Data []model.Attendee `json:"data"`
+				}
+				assert.NoError(t, json.Unmarshal(body, &response))
+				assert.True(t, response.Success)
+				assert.Equal(t, "next", response.NextCursor)
+				assert.Equal(t, attendees, response.Data)
+			},
+		},
+		{
+			name: "Not the event creator",
+			url:  "/events/1/attendees",
+			mockAttendeeService: func(ctrl *gomock.Controller) *MockAttendeeHandler {
+				mock := NewMockAttendeeHandler(ctrl)
+				mock.EXPECT().ExportAttendees(gomock.Any(), gomock.Any(), gomock.Any()).Return(_errors.ErrForbidden)
+				return mock
+			},
+			expectedStatus:      http.StatusForbidden,
+			expectedContentType: "application/json; charset=utf-8",
+		},
+		{
+			name: "Invalid format",
+			url:  "/events/1/attendees?format=xml",
+			mockAttendeeService: func(ctrl *gomock.Controller) *MockAttendeeHandler {
+				return NewMockAttendeeHandler(ctrl)
+			},
+			expectedStatus:      http.StatusBadRequest,
+			expectedContentType: "application/json; charset=utf-8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAttendeeService := tt.mockAttendeeService(ctrl)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, tt.url, nil)
+			c.Params = gin.Params{{Key: "event_id", Value: "1"}}
+			c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), util.Principal{Type: util.PrincipalTypeUser, UserID: 1, Role: tt.role}))
+
+			handler := NewAttendeeHandler(mockAttendeeService)
+			handler.(*AttendeeHttpHandler).ExportAttendees(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedContentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, tt.expectedNextCursor, w.Header().Get("X-Next-Cursor"))
+			if tt.checkBody != nil {
+				tt.checkBody(t, w.Body.Bytes())
+			}
+		})
+	}
+}
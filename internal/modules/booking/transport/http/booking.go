@@ -3,6 +3,7 @@ package transporthttp
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -10,6 +11,7 @@ import (
 	"booking-event/internal/common/handler"
 	commonmodel "booking-event/internal/common/model"
 	"booking-event/internal/common/util"
+	"booking-event/internal/middleware"
 	"booking-event/internal/modules/booking/model"
 )
 
@@ -18,6 +20,7 @@ type BookingHandler interface {
 	ConfirmBooking(ctx context.Context, userID int, bookingID int) error
 	CancelBooking(ctx context.Context, id int, executorID int) error
 	GetBookingByID(ctx context.Context, id int) (*model.Booking, error)
+	ListBookings(ctx context.Context, query model.BookingQuery) (*commonmodel.Page[model.Booking], error)
 }
 
 type BookingHttpHandler struct {
@@ -29,10 +32,11 @@ func NewBookingHandler(bookingService BookingHandler) handler.HttpHandler {
 }
 
 func (h *BookingHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
-	router.POST("/bookings", h.CreateBooking)
-	router.PUT("/bookings/:booking_id/confirm", h.ConfirmBooking)
-	router.PUT("/bookings/:booking_id/cancel", h.CancelBooking)
-	router.GET("/bookings/:booking_id", h.GetBookingByID)
+	router.GET("/bookings", middleware.RequireScopes(model.PermissionBookingManageOwn), h.ListBookings)
+	router.POST("/bookings", middleware.RequireScopes(model.PermissionBookingManageOwn), h.CreateBooking)
+	router.PUT("/bookings/:booking_id/confirm", middleware.RequireScopes(model.PermissionBookingManageOwn), h.ConfirmBooking)
+	router.PUT("/bookings/:booking_id/cancel", middleware.RequireScopes(model.PermissionBookingManageOwn), h.CancelBooking)
+	router.GET("/bookings/:booking_id", middleware.RequireScopes(model.PermissionBookingManageOwn), h.GetBookingByID)
 }
 
 func (h *BookingHttpHandler) CreateBooking(c *gin.Context) {
@@ -47,6 +51,14 @@ func (h *BookingHttpHandler) CreateBooking(c *gin.Context) {
 	}
 	booking.UserID = util.GetUserIDContext(c.Request.Context())
 	resp, err := h.bookingService.CreateBooking(c.Request.Context(), booking)
+	if errors.Is(err, model.ErrUserNotVerified) {
+		c.JSON(http.StatusForbidden, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, commonmodel.Response{
 			Success: false,
@@ -138,3 +150,40 @@ func (h *BookingHttpHandler) GetBookingByID(c *gin.Context) {
 		Data:    booking,
 	})
 }
+
+func (h *BookingHttpHandler) ListBookings(c *gin.Context) {
+	var query model.BookingQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	query.UserID = util.GetUserIDContext(c.Request.Context())
+	page, err := h.bookingService.ListBookings(c.Request.Context(), query)
+	if errors.Is(err, commonmodel.ErrInvalidCursor) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success:    true,
+		Data:       page.Items,
+		Message:    "bookings retrieved",
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	})
+}
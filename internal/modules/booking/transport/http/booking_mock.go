@@ -10,7 +10,8 @@
 package transporthttp
 
 import (
-	model "booking-event/internal/modules/booking/model"
+	model "booking-event/internal/common/model"
+	model0 "booking-event/internal/modules/booking/model"
 	context "context"
 	reflect "reflect"
 
@@ -69,10 +70,10 @@ func (mr *MockBookingHandlerMockRecorder) ConfirmBooking(ctx, userID, bookingID
 }
 
 // CreateBooking mocks base method.
-func (m *MockBookingHandler) CreateBooking(ctx context.Context, booking model.CreateBookingRequest) (*model.Booking, error) {
+func (m *MockBookingHandler) CreateBooking(ctx context.Context, booking model0.CreateBookingRequest) (*model0.Booking, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateBooking", ctx, booking)
-	ret0, _ := ret[0].(*model.Booking)
+	ret0, _ := ret[0].(*model0.Booking)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -84,10 +85,10 @@ func (mr *MockBookingHandlerMockRecorder) CreateBooking(ctx, booking any) *gomoc
 }
 
 // GetBookingByID mocks base method.
-func (m *MockBookingHandler) GetBookingByID(ctx context.Context, id int) (*model.Booking, error) {
+func (m *MockBookingHandler) GetBookingByID(ctx context.Context, id int) (*model0.Booking, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBookingByID", ctx, id)
-	ret0, _ := ret[0].(*model.Booking)
+	ret0, _ := ret[0].(*model0.Booking)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -97,3 +98,18 @@ func (mr *MockBookingHandlerMockRecorder) GetBookingByID(ctx, id any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByID", reflect.TypeOf((*MockBookingHandler)(nil).GetBookingByID), ctx, id)
 }
+
+// ListBookings mocks base method.
+func (m *MockBookingHandler) ListBookings(ctx context.Context, query model0.BookingQuery) (*model.Page[model0.Booking], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBookings", ctx, query)
+	ret0, _ := ret[0].(*model.Page[model0.Booking])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBookings indicates an expected call of ListBookings.
+func (mr *MockBookingHandlerMockRecorder) ListBookings(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBookings", reflect.TypeOf((*MockBookingHandler)(nil).ListBookings), ctx, query)
+}
@@ -102,6 +102,69 @@ func TestBookingHttpHandler_CreateBooking(t *testing.T) {
 	}
 }
 
+func TestBookingHttpHandler_CreateBookingScopes(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name               string
+		principal          util.Principal
+		mockBookingService func(ctrl *gomock.Controller) *MockBookingHandler
+		expectedStatus     int
+	}{
+		{
+			name:      "User without scopes",
+			principal: util.Principal{Type: util.PrincipalTypeUser, UserID: 1},
+			mockBookingService: func(ctrl *gomock.Controller) *MockBookingHandler {
+				mock := NewMockBookingHandler(ctrl)
+				mock.EXPECT().CreateBooking(gomock.Any(), gomock.Any()).Return(&model.Booking{ID: 1}, nil)
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "API key with the booking scope",
+			principal: util.Principal{Type: util.PrincipalTypeService, UserID: 1, ServiceAccountID: 2, APIKeyID: 3, Permissions: []string{model.PermissionBookingManageOwn}},
+			mockBookingService: func(ctrl *gomock.Controller) *MockBookingHandler {
+				mock := NewMockBookingHandler(ctrl)
+				mock.EXPECT().CreateBooking(gomock.Any(), gomock.Any()).Return(&model.Booking{ID: 1}, nil)
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "API key with the wrong scope",
+			principal: util.Principal{Type: util.PrincipalTypeService, UserID: 1, ServiceAccountID: 2, APIKeyID: 3, Permissions: []string{model.PermissionCheckinScan}},
+			mockBookingService: func(ctrl *gomock.Controller) *MockBookingHandler {
+				return NewMockBookingHandler(ctrl)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			router := gin.New()
+			group := router.Group("/", func(c *gin.Context) {
+				c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), tt.principal))
+			})
+			NewBookingHandler(tt.mockBookingService(ctrl)).RegisterRoutes(group)
+
+			bodyBytes, _ := json.Marshal(model.CreateBookingRequest{EventID: 1, Quantity: 2})
+			req, _ := http.NewRequest(http.MethodPost, "/bookings", bytes.NewBuffer(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
 func TestBookingHttpHandler_ConfirmBooking(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -166,7 +229,7 @@ func TestBookingHttpHandler_ConfirmBooking(t *testing.T) {
 
 			c.Params = gin.Params{{Key: "booking_id", Value: tt.bookingID}}
 			c.Request, _ = http.NewRequest(http.MethodPut, "/bookings/"+tt.bookingID+"/confirm", nil)
-			c.Request = c.Request.WithContext(util.SetUserIDContext(c.Request.Context(), tt.userID))
+			c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), util.Principal{Type: util.PrincipalTypeUser, UserID: tt.userID}))
 
 			handler := NewBookingHandler(mockBookingService)
 			handler.(*BookingHttpHandler).ConfirmBooking(c)
@@ -247,7 +310,7 @@ func TestBookingHttpHandler_CancelBooking(t *testing.T) {
 
 			c.Params = gin.Params{{Key: "booking_id", Value: tt.bookingID}}
 			c.Request, _ = http.NewRequest(http.MethodPut, "/bookings/"+tt.bookingID+"/cancel", nil)
-			c.Request = c.Request.WithContext(util.SetUserIDContext(c.Request.Context(), tt.userID))
+			c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), util.Principal{Type: util.PrincipalTypeUser, UserID: tt.userID}))
 
 			handler := NewBookingHandler(mockBookingService)
 			handler.(*BookingHttpHandler).CancelBooking(c)
@@ -347,3 +410,93 @@ func TestBookingHttpHandler_GetBookingByID(t *testing.T) {
 		})
 	}
 }
+
+func TestBookingHttpHandler_ListBookings(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name               string
+		rawQuery           string
+		mockBookingService func(ctrl *gomock.Controller) *MockBookingHandler
+		expectedStatus     int
+		expectedBody       commonmodel.Response
+	}{
+		{
+			name:     "Successful list bookings",
+			rawQuery: "limit=1&cursor=abc&status=confirmed",
+			mockBookingService: func(ctrl *gomock.Controller) *MockBookingHandler {
+				mock := NewMockBookingHandler(ctrl)
+				mock.EXPECT().ListBookings(gomock.Any(), model.BookingQuery{
+					UserID:     1,
+					Status:     model.BookingStatusConfirmed,
+					Pagination: commonmodel.Pagination{Limit: 1, Cursor: "abc"},
+				}).Return(&commonmodel.Page[model.Booking]{
+					Items:      []model.Booking{{ID: 2, Status: model.BookingStatusConfirmed}},
+					NextCursor: "next",
+					PrevCursor: "prev",
+				}, nil)
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: commonmodel.Response{
+				Success:    true,
+				Data:       []model.Booking{{ID: 2, Status: model.BookingStatusConfirmed}},
+				Message:    "bookings retrieved",
+				NextCursor: "next",
+				PrevCursor: "prev",
+			},
+		},
+		{
+			name:     "Invalid cursor",
+			rawQuery: "cursor=invalid",
+			mockBookingService: func(ctrl *gomock.Controller) *MockBookingHandler {
+				mock := NewMockBookingHandler(ctrl)
+				mock.EXPECT().ListBookings(gomock.Any(), gomock.Any()).Return(nil, commonmodel.ErrInvalidCursor)
+				return mock
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:     "Invalid status",
+			rawQuery: "status=unknown",
+			mockBookingService: func(ctrl *gomock.Controller) *MockBookingHandler {
+				return NewMockBookingHandler(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockBookingService := tt.mockBookingService(ctrl)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			c.Request, _ = http.NewRequest(http.MethodGet, "/bookings?"+tt.rawQuery, nil)
+			c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), util.Principal{Type: util.PrincipalTypeUser, UserID: 1}))
+
+			handler := NewBookingHandler(mockBookingService)
+			handler.(*BookingHttpHandler).ListBookings(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus != http.StatusBadRequest {
+				var response commonmodel.Response
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedBody.Success, response.Success)
+				assert.Equal(t, tt.expectedBody.Message, response.Message)
+				assert.Equal(t, tt.expectedBody.NextCursor, response.NextCursor)
+				assert.Equal(t, tt.expectedBody.PrevCursor, response.PrevCursor)
+				bExpected, _ := json.Marshal(tt.expectedBody.Data)
+				data := []any{}
+				err = json.Unmarshal(bExpected, &data)
+				assert.NoError(t, err)
+				assert.Equal(t, data, response.Data)
+			}
+		})
+	}
+}
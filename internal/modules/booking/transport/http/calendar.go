@@ -0,0 +1,109 @@
+//go:generate mockgen -source=calendar.go -destination=calendar_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/middleware"
+	"booking-event/internal/modules/booking/model"
+)
+
+type CalendarHandler interface {
+	CreateFeed(ctx context.Context, userID int) (*model.CalendarFeedResponse, error)
+	DeleteFeed(ctx context.Context, userID int) error
+	RenderFeed(ctx context.Context, token string) ([]byte, error)
+}
+
+// CalendarHttpHandler lets users create or revoke the private feed of their bookings.
+type CalendarHttpHandler struct {
+	calendarService CalendarHandler
+}
+
+func NewCalendarHandler(calendarService CalendarHandler) handler.HttpHandler {
+	return &CalendarHttpHandler{calendarService: calendarService}
+}
+
+func (h *CalendarHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/calendar-feed", middleware.RequireScopes(model.PermissionCalendarManageOwn), h.CreateFeed)
+	router.DELETE("/calendar-feed", middleware.RequireScopes(model.PermissionCalendarManageOwn), h.DeleteFeed)
+}
+
+// CreateFeed returns the URL of a new feed, the URL of the previous one stops working.
+func (h *CalendarHttpHandler) CreateFeed(c *gin.Context) {
+	feed, err := h.calendarService.CreateFeed(c.Request.Context(), util.GetUserIDContext(c.Request.Context()))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, commonmodel.Response{
+		Success: true,
+		Data:    feed,
+		Message: "calendar feed created",
+	})
+}
+
+func (h *CalendarHttpHandler) DeleteFeed(c *gin.Context) {
+	err := h.calendarService.DeleteFeed(c.Request.Context(), util.GetUserIDContext(c.Request.Context()))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    nil,
+		Message: "calendar feed deleted",
+	})
+}
+
+// CalendarFeedHttpHandler serves the feeds to the calendar apps, which cannot authenticate: the token of the URL
+// is the credential.
+type CalendarFeedHttpHandler struct {
+	calendarService CalendarHandler
+}
+
+func NewCalendarFeedHandler(calendarService CalendarHandler) handler.HttpHandler {
+	return &CalendarFeedHttpHandler{calendarService: calendarService}
+}
+
+func (h *CalendarFeedHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/calendar/:feed", h.GetFeed)
+}
+
+func (h *CalendarFeedHttpHandler) GetFeed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("feed"), ".ics")
+	calendar, err := h.calendarService.RenderFeed(c.Request.Context(), token)
+	if errors.Is(err, model.ErrCalendarFeedNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.Header("Cache-Control", "private, no-store")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", calendar)
+}
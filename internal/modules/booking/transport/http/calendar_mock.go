@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: calendar.go
+//
+// Generated by this command:
+//
+//	mockgen -source=calendar.go -destination=calendar_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCalendarHandler is a mock of CalendarHandler interface.
+type MockCalendarHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarHandlerMockRecorder
+}
+
+// MockCalendarHandlerMockRecorder is the mock recorder for MockCalendarHandler.
+type MockCalendarHandlerMockRecorder struct {
+	mock *MockCalendarHandler
+}
+
+// NewMockCalendarHandler creates a new mock instance.
+func NewMockCalendarHandler(ctrl *gomock.Controller) *MockCalendarHandler {
+	mock := &MockCalendarHandler{ctrl: ctrl}
+	mock.recorder = &MockCalendarHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarHandler) EXPECT() *MockCalendarHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateFeed mocks base method.
+func (m *MockCalendarHandler) CreateFeed(ctx context.Context, userID int) (*model.CalendarFeedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeed", ctx, userID)
+	ret0, _ := ret[0].(*model.CalendarFeedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeed indicates an expected call of CreateFeed.
+func (mr *MockCalendarHandlerMockRecorder) CreateFeed(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeed", reflect.TypeOf((*MockCalendarHandler)(nil).CreateFeed), ctx, userID)
+}
+
+// DeleteFeed mocks base method.
+func (m *MockCalendarHandler) DeleteFeed(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeed", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeed indicates an expected call of DeleteFeed.
+func (mr *MockCalendarHandlerMockRecorder) DeleteFeed(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeed", reflect.TypeOf((*MockCalendarHandler)(nil).DeleteFeed), ctx, userID)
+}
+
+// RenderFeed mocks base method.
+func (m *MockCalendarHandler) RenderFeed(ctx context.Context, token string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderFeed", ctx, token)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderFeed indicates an expected call of RenderFeed.
+func (mr *MockCalendarHandlerMockRecorder) RenderFeed(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderFeed", reflect.TypeOf((*MockCalendarHandler)(nil).RenderFeed), ctx, token)
+}
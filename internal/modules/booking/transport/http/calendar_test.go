@@ -0,0 +1,71 @@
+package transporthttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestCalendarFeedHttpHandler_GetFeed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                string
+		mockCalendarService func(ctrl *gomock.Controller) *MockCalendarHandler
+		expectedStatus      int
+		expectedType        string
+	}{
+		{
+			name: "Feed served",
+			mockCalendarService: func(ctrl *gomock.Controller) *MockCalendarHandler {
+				mock := NewMockCalendarHandler(ctrl)
+				mock.EXPECT().RenderFeed(gomock.Any(), "abc").Return([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil)
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "text/calendar; charset=utf-8",
+		},
+		{
+			name: "Unknown token",
+			mockCalendarService: func(ctrl *gomock.Controller) *MockCalendarHandler {
+				mock := NewMockCalendarHandler(ctrl)
+				mock.EXPECT().RenderFeed(gomock.Any(), "abc").Return(nil, model.ErrCalendarFeedNotFound)
+				return mock
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedType:   "application/json; charset=utf-8",
+		},
+		{
+			name: "Service error",
+			mockCalendarService: func(ctrl *gomock.Controller) *MockCalendarHandler {
+				mock := NewMockCalendarHandler(ctrl)
+				mock.EXPECT().RenderFeed(gomock.Any(), "abc").Return(nil, assert.AnError)
+				return mock
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedType:   "application/json; charset=utf-8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			router := gin.New()
+			NewCalendarFeedHandler(tt.mockCalendarService(ctrl)).RegisterRoutes(router.Group("/api/v1"))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/calendar/abc.ics", nil))
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedType, w.Header().Get("Content-Type"))
+		})
+	}
+}
@@ -0,0 +1,173 @@
+//go:generate mockgen -source=category.go -destination=category_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	_errors "booking-event/internal/common/errors"
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/middleware"
+	"booking-event/internal/modules/booking/model"
+)
+
+type CategoryHandler interface {
+	ListCategories(ctx context.Context) ([]model.Category, error)
+	CreateCategory(ctx context.Context, params model.UpsertCategoryRequest) (*model.Category, error)
+	UpdateCategory(ctx context.Context, params model.UpsertCategoryRequest) (*model.Category, error)
+	DeleteCategory(ctx context.Context, id int) error
+}
+
+// CategoryHttpHandler exposes the category taxonomy to every user.
+type CategoryHttpHandler struct {
+	categoryService CategoryHandler
+}
+
+func NewCategoryHandler(categoryService CategoryHandler) handler.HttpHandler {
+	return &CategoryHttpHandler{categoryService: categoryService}
+}
+
+func (h *CategoryHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/categories", middleware.RequireScopes(model.PermissionEventRead), h.ListCategories)
+}
+
+func (h *CategoryHttpHandler) ListCategories(c *gin.Context) {
+	categories, err := h.categoryService.ListCategories(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    categories,
+		Message: "categories retrieved",
+	})
+}
+
+// AdminCategoryHttpHandler lets admins manage the category taxonomy.
+type AdminCategoryHttpHandler struct {
+	*CategoryHttpHandler
+}
+
+func NewAdminCategoryHandler(categoryService CategoryHandler) handler.HttpHandler {
+	return &AdminCategoryHttpHandler{CategoryHttpHandler: &CategoryHttpHandler{categoryService: categoryService}}
+}
+
+func (h *AdminCategoryHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/categories", h.ListCategories)
+	router.POST("/categories", h.CreateCategory)
+	router.PUT("/categories/:category_id", h.UpdateCategory)
+	router.DELETE("/categories/:category_id", h.DeleteCategory)
+}
+
+func (h *AdminCategoryHttpHandler) CreateCategory(c *gin.Context) {
+	var request model.UpsertCategoryRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	category, err := h.categoryService.CreateCategory(c.Request.Context(), request)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    category,
+		Message: "category created",
+	})
+}
+
+func (h *AdminCategoryHttpHandler) UpdateCategory(c *gin.Context) {
+	var request model.UpsertCategoryRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	request.ID, err = strconv.Atoi(c.Param("category_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	category, err := h.categoryService.UpdateCategory(c.Request.Context(), request)
+	if errors.Is(err, _errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    category,
+		Message: "category updated",
+	})
+}
+
+func (h *AdminCategoryHttpHandler) DeleteCategory(c *gin.Context) {
+	categoryID, err := strconv.Atoi(c.Param("category_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	err = h.categoryService.DeleteCategory(c.Request.Context(), categoryID)
+	if errors.Is(err, _errors.ErrNotFound) {
+		c.JSON(http.StatusNotFound, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "category deleted",
+	})
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: category.go
+//
+// Generated by this command:
+//
+//	mockgen -source=category.go -destination=category_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCategoryHandler is a mock of CategoryHandler interface.
+type MockCategoryHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryHandlerMockRecorder
+}
+
+// MockCategoryHandlerMockRecorder is the mock recorder for MockCategoryHandler.
+type MockCategoryHandlerMockRecorder struct {
+	mock *MockCategoryHandler
+}
+
+// NewMockCategoryHandler creates a new mock instance.
+func NewMockCategoryHandler(ctrl *gomock.Controller) *MockCategoryHandler {
+	mock := &MockCategoryHandler{ctrl: ctrl}
+	mock.recorder = &MockCategoryHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryHandler) EXPECT() *MockCategoryHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateCategory mocks base method.
+func (m *MockCategoryHandler) CreateCategory(ctx context.Context, params model.UpsertCategoryRequest) (*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, params)
+	ret0, _ := ret[0].(*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockCategoryHandlerMockRecorder) CreateCategory(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCategoryHandler)(nil).CreateCategory), ctx, params)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockCategoryHandler) DeleteCategory(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockCategoryHandlerMockRecorder) DeleteCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryHandler)(nil).DeleteCategory), ctx, id)
+}
+
+// ListCategories mocks base method.
+func (m *MockCategoryHandler) ListCategories(ctx context.Context) ([]model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx)
+	ret0, _ := ret[0].([]model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *MockCategoryHandlerMockRecorder) ListCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockCategoryHandler)(nil).ListCategories), ctx)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockCategoryHandler) UpdateCategory(ctx context.Context, params model.UpsertCategoryRequest) (*model.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, params)
+	ret0, _ := ret[0].(*model.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockCategoryHandlerMockRecorder) UpdateCategory(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCategoryHandler)(nil).UpdateCategory), ctx, params)
+}
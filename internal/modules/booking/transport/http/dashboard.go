@@ -0,0 +1,136 @@
+//go:generate mockgen -source=dashboard.go -destination=dashboard_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/middleware"
+	"booking-event/internal/modules/booking/model"
+)
+
+type DashboardHandler interface {
+	ListEventSalesStats(ctx context.Context, query model.SalesStatsQuery) ([]model.EventSalesStats, error)
+	GetEventSalesStats(ctx context.Context, eventID int, executorID int) (*model.EventSalesStats, error)
+	GetSalesTimeSeries(ctx context.Context, query model.SalesTimeSeriesQuery) ([]model.SalesDataPoint, error)
+}
+
+// DashboardHttpHandler serves the sales dashboard of the organizers, scoped to the events they created.
+type DashboardHttpHandler struct {
+	dashboardService DashboardHandler
+}
+
+func NewDashboardHandler(dashboardService DashboardHandler) handler.HttpHandler {
+	return &DashboardHttpHandler{dashboardService: dashboardService}
+}
+
+func (h *DashboardHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/dashboard/events", middleware.RequirePermissions(model.PermissionEventManageOwn), h.ListEventSalesStats)
+	router.GET("/dashboard/events/:event_id", middleware.RequirePermissions(model.PermissionEventManageOwn), h.GetEventSalesStats)
+	router.GET("/dashboard/events/:event_id/sales", middleware.RequirePermissions(model.PermissionEventManageOwn), h.GetSalesTimeSeries)
+}
+
+func (h *DashboardHttpHandler) ListEventSalesStats(c *gin.Context) {
+	var query model.SalesStatsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	query.CreatorID = util.GetUserIDContext(c.Request.Context())
+	stats, err := h.dashboardService.ListEventSalesStats(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    stats,
+		Message: "sales stats retrieved",
+	})
+}
+
+func (h *DashboardHttpHandler) GetEventSalesStats(c *gin.Context) {
+	eventID, err := strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	stats, err := h.dashboardService.GetEventSalesStats(c.Request.Context(), eventID, util.GetUserIDContext(c.Request.Context()))
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    stats,
+		Message: "sales stats retrieved",
+	})
+}
+
+func (h *DashboardHttpHandler) GetSalesTimeSeries(c *gin.Context) {
+	var query model.SalesTimeSeriesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	query.EventID, err = strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && !query.From.Before(query.To) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: "from must be before to",
+		})
+		return
+	}
+	query.ExecutorID = util.GetUserIDContext(c.Request.Context())
+
+	points, err := h.dashboardService.GetSalesTimeSeries(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    points,
+		Message: "sales time series retrieved",
+	})
+}
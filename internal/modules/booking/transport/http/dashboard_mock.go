@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dashboard.go
+//
+// Generated by this command:
+//
+//	mockgen -source=dashboard.go -destination=dashboard_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDashboardHandler is a mock of DashboardHandler interface.
+type MockDashboardHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockDashboardHandlerMockRecorder
+}
+
+// MockDashboardHandlerMockRecorder is the mock recorder for MockDashboardHandler.
+type MockDashboardHandlerMockRecorder struct {
+	mock *MockDashboardHandler
+}
+
+// NewMockDashboardHandler creates a new mock instance.
+func NewMockDashboardHandler(ctrl *gomock.Controller) *MockDashboardHandler {
+	mock := &MockDashboardHandler{ctrl: ctrl}
+	mock.recorder = &MockDashboardHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDashboardHandler) EXPECT() *MockDashboardHandlerMockRecorder {
+	return m.recorder
+}
+
+// GetEventSalesStats mocks base method.
+func (m *MockDashboardHandler) GetEventSalesStats(ctx context.Context, eventID, executorID int) (*model.EventSalesStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventSalesStats", ctx, eventID, executorID)
+	ret0, _ := ret[0].(*model.EventSalesStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventSalesStats indicates an expected call of GetEventSalesStats.
+func (mr *MockDashboardHandlerMockRecorder) GetEventSalesStats(ctx, eventID, executorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSalesStats", reflect.TypeOf((*MockDashboardHandler)(nil).GetEventSalesStats), ctx, eventID, executorID)
+}
+
+// GetSalesTimeSeries mocks base method.
+func (m *MockDashboardHandler) GetSalesTimeSeries(ctx context.Context, query model.SalesTimeSeriesQuery) ([]model.SalesDataPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSalesTimeSeries", ctx, query)
+	ret0, _ := ret[0].([]model.SalesDataPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSalesTimeSeries indicates an expected call of GetSalesTimeSeries.
+func (mr *MockDashboardHandlerMockRecorder) GetSalesTimeSeries(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalesTimeSeries", reflect.TypeOf((*MockDashboardHandler)(nil).GetSalesTimeSeries), ctx, query)
+}
+
+// ListEventSalesStats mocks base method.
+func (m *MockDashboardHandler) ListEventSalesStats(ctx context.Context, query model.SalesStatsQuery) ([]model.EventSalesStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventSalesStats", ctx, query)
+	ret0, _ := ret[0].([]model.EventSalesStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventSalesStats indicates an expected call of ListEventSalesStats.
+func (mr *MockDashboardHandlerMockRecorder) ListEventSalesStats(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSalesStats", reflect.TypeOf((*MockDashboardHandler)(nil).ListEventSalesStats), ctx, query)
+}
@@ -13,14 +13,18 @@ import (
 	"booking-event/internal/common/handler"
 	commonmodel "booking-event/internal/common/model"
 	"booking-event/internal/common/util"
+	"booking-event/internal/middleware"
 	"booking-event/internal/modules/booking/model"
 )
 
 type EventHandler interface {
 	RetrieveEventDetail(ctx context.Context, eventID int) (*model.Event, error)
-	QueryEvents(ctx context.Context, query model.EventQuery) ([]model.Event, error)
-	CreateEvent(ctx context.Context, params model.CreateEventRequest) error
+	QueryEvents(ctx context.Context, query model.EventQuery) (*commonmodel.Page[model.Event], error)
+	CreateEvent(ctx context.Context, params model.CreateEventRequest) (*model.Event, error)
 	UpdateEvent(ctx context.Context, params model.UpdateEventRequest) error
+	UploadEventImage(ctx context.Context, params model.UploadEventImageRequest) (*model.EventImage, error)
+	DeleteEventImage(ctx context.Context, params model.DeleteEventImageRequest) error
+	DuplicateEvent(ctx context.Context, params model.DuplicateEventRequest) (*model.Event, error)
 }
 
 type EventHttpHandler struct {
@@ -83,7 +87,15 @@ func (h *EventHttpHandler) QueryEvents(c *gin.Context) {
 		})
 		return
 	}
-	events, err := h.eventService.QueryEvents(c.Request.Context(), query)
+	page, err := h.eventService.QueryEvents(c.Request.Context(), query)
+	if errors.Is(err, commonmodel.ErrInvalidCursor) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, commonmodel.Response{
 			Success: false,
@@ -93,9 +105,11 @@ func (h *EventHttpHandler) QueryEvents(c *gin.Context) {
 		return
 	}
 	c.JSON(http.StatusOK, commonmodel.Response{
-		Success: true,
-		Data:    events,
-		Message: "events retrieved",
+		Success:    true,
+		Data:       page.Items,
+		Message:    "events retrieved",
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
 	})
 }
 
@@ -112,7 +126,15 @@ func (h *EventHttpHandler) CreateEvent(c *gin.Context) {
 
 	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
 
-	err := h.eventService.CreateEvent(c.Request.Context(), request)
+	event, err := h.eventService.CreateEvent(c.Request.Context(), request)
+	if errors.Is(err, model.ErrUnknownCategory) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, commonmodel.Response{
 			Success: false,
@@ -123,6 +145,7 @@ func (h *EventHttpHandler) CreateEvent(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, commonmodel.Response{
 		Success: true,
+		Data:    event,
 		Message: "Event created successfully",
 	})
 }
@@ -151,7 +174,7 @@ func (h *EventHttpHandler) UpdateEvent(c *gin.Context) {
 
 	err = h.eventService.UpdateEvent(c.Request.Context(), request)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+		c.JSON(statusFromError(err), commonmodel.Response{
 			Success: false,
 			Data:    nil,
 			Message: err.Error(),
@@ -164,9 +187,159 @@ func (h *EventHttpHandler) UpdateEvent(c *gin.Context) {
 	})
 }
 
+func (h *EventHttpHandler) UploadEventImage(c *gin.Context) {
+	var request model.RetrieveEventDetailRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+
+	image, err := h.eventService.UploadEventImage(c.Request.Context(), model.UploadEventImageRequest{
+		EventID:     request.EventID,
+		ExecutorID:  util.GetUserIDContext(c.Request.Context()),
+		FileName:    fileHeader.Filename,
+		ContentType: fileHeader.Header.Get("Content-Type"),
+		Size:        fileHeader.Size,
+		Content:     file,
+	})
+	if errors.Is(err, model.ErrUnsupportedImageType) || errors.Is(err, model.ErrImageTooLarge) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    image,
+		Message: "image uploaded",
+	})
+}
+
+func (h *EventHttpHandler) DeleteEventImage(c *gin.Context) {
+	var request model.DeleteEventImageRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
+
+	if err := h.eventService.DeleteEventImage(c.Request.Context(), request); err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "image deleted",
+	})
+}
+
+func (h *EventHttpHandler) DuplicateEvent(c *gin.Context) {
+	var request model.DuplicateEventRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	request.EventID, err = strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
+
+	event, err := h.eventService.DuplicateEvent(c.Request.Context(), request)
+	if errors.Is(err, model.ErrUnknownCategory) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    event,
+		Message: "Event duplicated successfully",
+	})
+}
+
 func (h *EventHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
-	router.GET("/events/:event_id", h.RetrieveEventDetail)
-	router.POST("/search/events", h.QueryEvents)
-	router.POST("/events", h.CreateEvent)
-	router.PUT("/events/:event_id", h.UpdateEvent)
+	router.GET("/events/:event_id", middleware.RequireScopes(model.PermissionEventRead), h.RetrieveEventDetail)
+	router.POST("/search/events", middleware.RequireScopes(model.PermissionEventRead), h.QueryEvents)
+	router.POST("/events", middleware.RequirePermissions(model.PermissionEventCreate), h.CreateEvent)
+	router.PUT("/events/:event_id", middleware.RequirePermissions(model.PermissionEventManageOwn), h.UpdateEvent)
+	router.POST("/events/:event_id/images", middleware.RequirePermissions(model.PermissionEventManageOwn), h.UploadEventImage)
+	router.DELETE("/events/:event_id/images/:image_id", middleware.RequirePermissions(model.PermissionEventManageOwn), h.DeleteEventImage)
+	router.POST("/events/:event_id/duplicate", middleware.RequirePermissions(model.PermissionEventCreate), h.DuplicateEvent)
+}
+
+// statusFromError maps the common errors to their http status.
+func statusFromError(err error) int {
+	switch {
+	case errors.Is(err, _errors.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, _errors.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, model.ErrInvalidReviewTransition), errors.Is(err, model.ErrEventNotApproved):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
 }
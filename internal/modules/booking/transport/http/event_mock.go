@@ -10,7 +10,8 @@
 package transporthttp
 
 import (
-	model "booking-event/internal/modules/booking/model"
+	model "booking-event/internal/common/model"
+	model0 "booking-event/internal/modules/booking/model"
 	context "context"
 	reflect "reflect"
 
@@ -41,11 +42,12 @@ func (m *MockEventHandler) EXPECT() *MockEventHandlerMockRecorder {
 }
 
 // CreateEvent mocks base method.
-func (m *MockEventHandler) CreateEvent(ctx context.Context, params model.CreateEventRequest) error {
+func (m *MockEventHandler) CreateEvent(ctx context.Context, params model0.CreateEventRequest) (*model0.Event, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateEvent", ctx, params)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*model0.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateEvent indicates an expected call of CreateEvent.
@@ -54,11 +56,40 @@ func (mr *MockEventHandlerMockRecorder) CreateEvent(ctx, params any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockEventHandler)(nil).CreateEvent), ctx, params)
 }
 
+// DeleteEventImage mocks base method.
+func (m *MockEventHandler) DeleteEventImage(ctx context.Context, params model0.DeleteEventImageRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventImage", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventImage indicates an expected call of DeleteEventImage.
+func (mr *MockEventHandlerMockRecorder) DeleteEventImage(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventImage", reflect.TypeOf((*MockEventHandler)(nil).DeleteEventImage), ctx, params)
+}
+
+// DuplicateEvent mocks base method.
+func (m *MockEventHandler) DuplicateEvent(ctx context.Context, params model0.DuplicateEventRequest) (*model0.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DuplicateEvent", ctx, params)
+	ret0, _ := ret[0].(*model0.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DuplicateEvent indicates an expected call of DuplicateEvent.
+func (mr *MockEventHandlerMockRecorder) DuplicateEvent(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DuplicateEvent", reflect.TypeOf((*MockEventHandler)(nil).DuplicateEvent), ctx, params)
+}
+
 // QueryEvents mocks base method.
-func (m *MockEventHandler) QueryEvents(ctx context.Context, query model.EventQuery) ([]model.Event, error) {
+func (m *MockEventHandler) QueryEvents(ctx context.Context, query model0.EventQuery) (*model.Page[model0.Event], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "QueryEvents", ctx, query)
-	ret0, _ := ret[0].([]model.Event)
+	ret0, _ := ret[0].(*model.Page[model0.Event])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -70,10 +101,10 @@ func (mr *MockEventHandlerMockRecorder) QueryEvents(ctx, query any) *gomock.Call
 }
 
 // RetrieveEventDetail mocks base method.
-func (m *MockEventHandler) RetrieveEventDetail(ctx context.Context, eventID int) (*model.Event, error) {
+func (m *MockEventHandler) RetrieveEventDetail(ctx context.Context, eventID int) (*model0.Event, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RetrieveEventDetail", ctx, eventID)
-	ret0, _ := ret[0].(*model.Event)
+	ret0, _ := ret[0].(*model0.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -85,7 +116,7 @@ func (mr *MockEventHandlerMockRecorder) RetrieveEventDetail(ctx, eventID any) *g
 }
 
 // UpdateEvent mocks base method.
-func (m *MockEventHandler) UpdateEvent(ctx context.Context, params model.UpdateEventRequest) error {
+func (m *MockEventHandler) UpdateEvent(ctx context.Context, params model0.UpdateEventRequest) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateEvent", ctx, params)
 	ret0, _ := ret[0].(error)
@@ -97,3 +128,18 @@ func (mr *MockEventHandlerMockRecorder) UpdateEvent(ctx, params any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockEventHandler)(nil).UpdateEvent), ctx, params)
 }
+
+// UploadEventImage mocks base method.
+func (m *MockEventHandler) UploadEventImage(ctx context.Context, params model0.UploadEventImageRequest) (*model0.EventImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadEventImage", ctx, params)
+	ret0, _ := ret[0].(*model0.EventImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadEventImage indicates an expected call of UploadEventImage.
+func (mr *MockEventHandlerMockRecorder) UploadEventImage(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadEventImage", reflect.TypeOf((*MockEventHandler)(nil).UploadEventImage), ctx, params)
+}
@@ -3,6 +3,7 @@ package transporthttp
 import (
 	"bytes"
 	"encoding/json"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	_errors "booking-event/internal/common/errors"
 	commonmodel "booking-event/internal/common/model"
 	"booking-event/internal/common/util"
 	"booking-event/internal/modules/booking/model"
@@ -117,7 +119,7 @@ func TestEventHttpHandler_QueryEvents(t *testing.T) {
 			body: model.EventQuery{Pagination: commonmodel.Pagination{Page: 1, Limit: 10}},
 			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
 				mock := NewMockEventHandler(ctrl)
-				mock.EXPECT().QueryEvents(gomock.Any(), gomock.Any()).Return([]model.Event{{ID: 1, Name: "Event 1"}, {ID: 2, Name: "Event 2"}}, nil)
+				mock.EXPECT().QueryEvents(gomock.Any(), gomock.Any()).Return(&commonmodel.Page[model.Event]{Items: []model.Event{{ID: 1, Name: "Event 1"}, {ID: 2, Name: "Event 2"}}}, nil)
 				return mock
 			},
 			expectedStatus: http.StatusOK,
@@ -142,6 +144,48 @@ func TestEventHttpHandler_QueryEvents(t *testing.T) {
 				Message: assert.AnError.Error(),
 			},
 		},
+		{
+			name: "Successful geo search on the equator",
+			body: model.EventQuery{Latitude: util.ToPtr(0.0), Longitude: util.ToPtr(106.7009), RadiusKm: 20, Pagination: commonmodel.Pagination{Page: 1, Limit: 10}},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().QueryEvents(gomock.Any(), gomock.Any()).Return(&commonmodel.Page[model.Event]{Items: []model.Event{{ID: 1, Name: "Event 1", DistanceKm: util.ToPtr(1.5)}}, NextCursor: "next"}, nil)
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: commonmodel.Response{
+				Success:    true,
+				Data:       []model.Event{{ID: 1, Name: "Event 1", DistanceKm: util.ToPtr(1.5)}},
+				Message:    "events retrieved",
+				NextCursor: "next",
+			},
+		},
+		{
+			name: "Invalid cursor",
+			body: model.EventQuery{Pagination: commonmodel.Pagination{Limit: 10, Cursor: "invalid"}},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().QueryEvents(gomock.Any(), gomock.Any()).Return(nil, commonmodel.ErrInvalidCursor)
+				return mock
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Geo search without radius",
+			body: model.EventQuery{Latitude: util.ToPtr(10.7769), Longitude: util.ToPtr(106.7009), Pagination: commonmodel.Pagination{Page: 1, Limit: 10}},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				return NewMockEventHandler(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Geo search with invalid latitude",
+			body: model.EventQuery{Latitude: util.ToPtr(91.0), Longitude: util.ToPtr(106.7009), RadiusKm: 20, Pagination: commonmodel.Pagination{Page: 1, Limit: 10}},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				return NewMockEventHandler(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -163,12 +207,17 @@ func TestEventHttpHandler_QueryEvents(t *testing.T) {
 			handler.(*EventHttpHandler).QueryEvents(c)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusBadRequest {
+				return
+			}
 
 			var response commonmodel.Response
 			err := json.Unmarshal(w.Body.Bytes(), &response)
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expectedBody.Success, response.Success)
 			assert.Equal(t, tt.expectedBody.Message, response.Message)
+			assert.Equal(t, tt.expectedBody.NextCursor, response.NextCursor)
+			assert.Equal(t, tt.expectedBody.PrevCursor, response.PrevCursor)
 			if tt.expectedBody.Data != nil {
 				bExpected, _ := json.Marshal(tt.expectedBody.Data)
 				data := []any{}
@@ -192,24 +241,35 @@ func TestEventHttpHandler_CreateEvent(t *testing.T) {
 	}{
 		{
 			name: "Successful event creation",
-			body: model.CreateEventRequest{Name: "New Event", AvailableSeats: 100, StartAt: time.Now().Add(time.Hour * 24), Location: "HCM", Category: model.EventCategoryMusic, Price: 100, ExecutorID: 1},
+			body: model.CreateEventRequest{Name: "New Event", AvailableSeats: 100, StartAt: time.Now().Add(time.Hour * 24), Location: "HCM", Categories: []model.EventCategory{"music"}, Price: 100, ExecutorID: 1},
 			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
 				mock := NewMockEventHandler(ctrl)
-				mock.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Return(nil)
+				mock.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Return(&model.Event{ID: 1, Name: "New Event"}, nil)
 				return mock
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: commonmodel.Response{
 				Success: true,
+				Data:    map[string]any{"id": float64(1), "name": "New Event"},
 				Message: "Event created successfully",
 			},
 		},
+		{
+			name: "Unknown category",
+			body: model.CreateEventRequest{Name: "New Event", AvailableSeats: 100, StartAt: time.Now().Add(time.Hour * 24), Location: "HCM", Categories: []model.EventCategory{"unknown"}, Price: 100, ExecutorID: 1},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Return(nil, model.ErrUnknownCategory)
+				return mock
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name: "Failed event creation",
-			body: model.CreateEventRequest{Name: "New Event", AvailableSeats: 100, StartAt: time.Now().Add(time.Hour * 24), Location: "HCM", Category: model.EventCategoryMusic, Price: 100, ExecutorID: 1},
+			body: model.CreateEventRequest{Name: "New Event", AvailableSeats: 100, StartAt: time.Now().Add(time.Hour * 24), Location: "HCM", Categories: []model.EventCategory{"music"}, Price: 100, ExecutorID: 1},
 			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
 				mock := NewMockEventHandler(ctrl)
-				mock.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Return(assert.AnError)
+				mock.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
 				return mock
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -240,7 +300,7 @@ func TestEventHttpHandler_CreateEvent(t *testing.T) {
 			bodyBytes, _ := json.Marshal(tt.body)
 			c.Request, _ = http.NewRequest(http.MethodPost, "/events", bytes.NewBuffer(bodyBytes))
 			c.Request.Header.Set("Content-Type", "application/json")
-			c.Request = c.Request.WithContext(util.SetUserIDContext(c.Request.Context(), 1))
+			c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), util.Principal{Type: util.PrincipalTypeUser, UserID: 1}))
 
 			handler := NewEventHandler(mockEventService)
 			handler.(*EventHttpHandler).CreateEvent(c)
@@ -251,7 +311,13 @@ func TestEventHttpHandler_CreateEvent(t *testing.T) {
 			err := json.Unmarshal(w.Body.Bytes(), &response)
 			assert.NoError(t, err)
 			if tt.expectedStatus == http.StatusOK {
-				assert.Equal(t, tt.expectedBody, response)
+				assert.Equal(t, tt.expectedBody.Success, response.Success)
+				assert.Equal(t, tt.expectedBody.Message, response.Message)
+				data, ok := response.Data.(map[string]any)
+				assert.True(t, ok)
+				for key, value := range tt.expectedBody.Data.(map[string]any) {
+					assert.Equal(t, value, data[key])
+				}
 			}
 		})
 	}
@@ -299,6 +365,50 @@ func TestEventHttpHandler_UpdateEvent(t *testing.T) {
 				Message: assert.AnError.Error(),
 			},
 		},
+		{
+			name:    "Event not approved",
+			eventID: "1",
+			body:    model.UpdateEventRequest{Status: model.EventStatusActive},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().UpdateEvent(gomock.Any(), gomock.Any()).Return(model.ErrEventNotApproved)
+				return mock
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: commonmodel.Response{
+				Success: false,
+				Data:    nil,
+				Message: model.ErrEventNotApproved.Error(),
+			},
+		},
+		{
+			name:    "Reset of the reminder schedule",
+			eventID: "1",
+			body:    model.UpdateEventRequest{ResetReminderMinutes: true},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().UpdateEvent(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, params model.UpdateEventRequest) error {
+					assert.True(t, params.ResetReminderMinutes)
+					assert.Nil(t, params.ReminderMinutes)
+					return nil
+				})
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: commonmodel.Response{
+				Success: true,
+				Message: "Event updated successfully",
+			},
+		},
+		{
+			name:    "Reset along with a reminder schedule",
+			eventID: "1",
+			body:    model.UpdateEventRequest{ReminderMinutes: &[]int{30}, ResetReminderMinutes: true},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				return NewMockEventHandler(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:    "Invalid event ID",
 			eventID: "invalid",
@@ -323,7 +433,7 @@ func TestEventHttpHandler_UpdateEvent(t *testing.T) {
 			c.Request, _ = http.NewRequest(http.MethodPut, "/events/"+tt.eventID, bytes.NewBuffer(bodyBytes))
 			c.Request.Header.Set("Content-Type", "application/json")
 			c.Params = gin.Params{{Key: "event_id", Value: tt.eventID}}
-			c.Request = c.Request.WithContext(util.SetUserIDContext(c.Request.Context(), 1))
+			c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), util.Principal{Type: util.PrincipalTypeUser, UserID: 1}))
 
 			handler := NewEventHandler(mockEventService)
 			handler.(*EventHttpHandler).UpdateEvent(c)
@@ -339,3 +449,171 @@ func TestEventHttpHandler_UpdateEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestEventHttpHandler_UploadEventImage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name             string
+		eventID          string
+		withFile         bool
+		mockEventService func(ctrl *gomock.Controller) *MockEventHandler
+		expectedStatus   int
+	}{
+		{
+			name:     "Successful upload",
+			eventID:  "1",
+			withFile: true,
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().UploadEventImage(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, params model.UploadEventImageRequest) (*model.EventImage, error) {
+					assert.Equal(t, 1, params.EventID)
+					assert.Equal(t, 1, params.ExecutorID)
+					assert.Equal(t, "cover.png", params.FileName)
+					return &model.EventImage{ID: 1, EventID: 1, URL: "http://localhost/media/events/1/cover.png"}, nil
+				})
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Unsupported image type",
+			eventID:  "1",
+			withFile: true,
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().UploadEventImage(gomock.Any(), gomock.Any()).Return(nil, model.ErrUnsupportedImageType)
+				return mock
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:     "Not the event creator",
+			eventID:  "1",
+			withFile: true,
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().UploadEventImage(gomock.Any(), gomock.Any()).Return(nil, _errors.ErrForbidden)
+				return mock
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:    "Missing file",
+			eventID: "1",
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				return NewMockEventHandler(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockEventService := tt.mockEventService(ctrl)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			if tt.withFile {
+				part, err := writer.CreateFormFile("image", "cover.png")
+				assert.NoError(t, err)
+				_, err = part.Write([]byte("\x89PNG\r\n\x1a\n"))
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, writer.Close())
+
+			c.Request, _ = http.NewRequest(http.MethodPost, "/events/"+tt.eventID+"/images", body)
+			c.Request.Header.Set("Content-Type", writer.FormDataContentType())
+			c.Params = gin.Params{{Key: "event_id", Value: tt.eventID}}
+			c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), util.Principal{Type: util.PrincipalTypeUser, UserID: 1}))
+
+			handler := NewEventHandler(mockEventService)
+			handler.(*EventHttpHandler).UploadEventImage(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestEventHttpHandler_DuplicateEvent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	startAt := time.Date(2026, 12, 1, 19, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		eventID          string
+		body             model.DuplicateEventRequest
+		mockEventService func(ctrl *gomock.Controller) *MockEventHandler
+		expectedStatus   int
+	}{
+		{
+			name:    "Successful duplication",
+			eventID: "1",
+			body:    model.DuplicateEventRequest{StartAt: startAt},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().DuplicateEvent(gomock.Any(), model.DuplicateEventRequest{EventID: 1, StartAt: startAt, ExecutorID: 1}).
+					Return(&model.Event{ID: 2, StartAt: startAt, Status: model.EventStatusInactive, ReviewStatus: model.EventReviewStatusDraft}, nil)
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Missing start time",
+			eventID: "1",
+			body:    model.DuplicateEventRequest{Name: "Copy"},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				return NewMockEventHandler(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Not the event creator",
+			eventID: "1",
+			body:    model.DuplicateEventRequest{StartAt: startAt},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().DuplicateEvent(gomock.Any(), gomock.Any()).Return(nil, _errors.ErrForbidden)
+				return mock
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:    "Category no longer exists",
+			eventID: "1",
+			body:    model.DuplicateEventRequest{StartAt: startAt},
+			mockEventService: func(ctrl *gomock.Controller) *MockEventHandler {
+				mock := NewMockEventHandler(ctrl)
+				mock.EXPECT().DuplicateEvent(gomock.Any(), gomock.Any()).Return(nil, model.ErrUnknownCategory)
+				return mock
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/events/"+tt.eventID+"/duplicate", bytes.NewBuffer(bodyBytes))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{{Key: "event_id", Value: tt.eventID}}
+			c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), util.Principal{Type: util.PrincipalTypeUser, UserID: 1}))
+
+			handler := NewEventHandler(tt.mockEventService(ctrl))
+			handler.(*EventHttpHandler).DuplicateEvent(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+//go:generate mockgen -source=review.go -destination=review_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/middleware"
+	"booking-event/internal/modules/booking/model"
+)
+
+type EventReviewHandler interface {
+	SubmitEvent(ctx context.Context, params model.ReviewEventRequest) (*model.EventReview, error)
+	ApproveEvent(ctx context.Context, params model.ReviewEventRequest) (*model.EventReview, error)
+	RejectEvent(ctx context.Context, params model.ReviewEventRequest) (*model.EventReview, error)
+	ListEventsForReview(ctx context.Context, query model.EventReviewQuery) (*commonmodel.Page[model.Event], error)
+	GetEventReviews(ctx context.Context, eventID int, executorID int) ([]model.EventReview, error)
+	ListEventReviews(ctx context.Context, eventID int) ([]model.EventReview, error)
+}
+
+// EventReviewHttpHandler lets organizers submit their events for review.
+type EventReviewHttpHandler struct {
+	reviewService EventReviewHandler
+}
+
+func NewEventReviewHandler(reviewService EventReviewHandler) handler.HttpHandler {
+	return &EventReviewHttpHandler{reviewService: reviewService}
+}
+
+func (h *EventReviewHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/events/:event_id/submit", middleware.RequirePermissions(model.PermissionEventManageOwn), h.SubmitEvent)
+	router.GET("/events/:event_id/reviews", middleware.RequirePermissions(model.PermissionEventManageOwn), h.GetEventReviews)
+}
+
+func (h *EventReviewHttpHandler) SubmitEvent(c *gin.Context) {
+	eventID, err := strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	review, err := h.reviewService.SubmitEvent(c.Request.Context(), model.ReviewEventRequest{
+		EventID:    eventID,
+		ExecutorID: util.GetUserIDContext(c.Request.Context()),
+	})
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    review,
+		Message: "event submitted for review",
+	})
+}
+
+func (h *EventReviewHttpHandler) GetEventReviews(c *gin.Context) {
+	eventID, err := strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	reviews, err := h.reviewService.GetEventReviews(c.Request.Context(), eventID, util.GetUserIDContext(c.Request.Context()))
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    reviews,
+		Message: "event reviews retrieved",
+	})
+}
+
+// AdminEventReviewHttpHandler lets admins moderate the submitted events.
+type AdminEventReviewHttpHandler struct {
+	reviewService EventReviewHandler
+}
+
+func NewAdminEventReviewHandler(reviewService EventReviewHandler) handler.HttpHandler {
+	return &AdminEventReviewHttpHandler{reviewService: reviewService}
+}
+
+func (h *AdminEventReviewHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/events", h.ListEventsForReview)
+	router.GET("/events/:event_id/reviews", h.ListEventReviews)
+	router.POST("/events/:event_id/approve", h.ApproveEvent)
+	router.POST("/events/:event_id/reject", h.RejectEvent)
+}
+
+func (h *AdminEventReviewHttpHandler) ListEventsForReview(c *gin.Context) {
+	var query model.EventReviewQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	page, err := h.reviewService.ListEventsForReview(c.Request.Context(), query)
+	if errors.Is(err, commonmodel.ErrInvalidCursor) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success:    true,
+		Data:       page.Items,
+		Message:    "events retrieved",
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	})
+}
+
+func (h *AdminEventReviewHttpHandler) ListEventReviews(c *gin.Context) {
+	eventID, err := strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	reviews, err := h.reviewService.ListEventReviews(c.Request.Context(), eventID)
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    reviews,
+		Message: "event reviews retrieved",
+	})
+}
+
+func (h *AdminEventReviewHttpHandler) ApproveEvent(c *gin.Context) {
+	h.reviewEvent(c, h.reviewService.ApproveEvent, "event approved")
+}
+
+func (h *AdminEventReviewHttpHandler) RejectEvent(c *gin.Context) {
+	h.reviewEvent(c, h.reviewService.RejectEvent, "event rejected")
+}
+
+func (h *AdminEventReviewHttpHandler) reviewEvent(
+	c *gin.Context,
+	review func(ctx context.Context, params model.ReviewEventRequest) (*model.EventReview, error),
+	message string,
+) {
+	var request model.ReviewEventRequest
+	// the reason is optional when approving, so an empty body is allowed
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.JSON(http.StatusBadRequest, commonmodel.Response{
+				Success: false,
+				Data:    nil,
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+	var err error
+	request.EventID, err = strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
+
+	result, err := review(c.Request.Context(), request)
+	if errors.Is(err, model.ErrRejectReasonRequired) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    result,
+		Message: message,
+	})
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review.go
+//
+// Generated by this command:
+//
+//	mockgen -source=review.go -destination=review_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/common/model"
+	model0 "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventReviewHandler is a mock of EventReviewHandler interface.
+type MockEventReviewHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventReviewHandlerMockRecorder
+}
+
+// MockEventReviewHandlerMockRecorder is the mock recorder for MockEventReviewHandler.
+type MockEventReviewHandlerMockRecorder struct {
+	mock *MockEventReviewHandler
+}
+
+// NewMockEventReviewHandler creates a new mock instance.
+func NewMockEventReviewHandler(ctrl *gomock.Controller) *MockEventReviewHandler {
+	mock := &MockEventReviewHandler{ctrl: ctrl}
+	mock.recorder = &MockEventReviewHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventReviewHandler) EXPECT() *MockEventReviewHandlerMockRecorder {
+	return m.recorder
+}
+
+// ApproveEvent mocks base method.
+func (m *MockEventReviewHandler) ApproveEvent(ctx context.Context, params model0.ReviewEventRequest) (*model0.EventReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveEvent", ctx, params)
+	ret0, _ := ret[0].(*model0.EventReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveEvent indicates an expected call of ApproveEvent.
+func (mr *MockEventReviewHandlerMockRecorder) ApproveEvent(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveEvent", reflect.TypeOf((*MockEventReviewHandler)(nil).ApproveEvent), ctx, params)
+}
+
+// GetEventReviews mocks base method.
+func (m *MockEventReviewHandler) GetEventReviews(ctx context.Context, eventID, executorID int) ([]model0.EventReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventReviews", ctx, eventID, executorID)
+	ret0, _ := ret[0].([]model0.EventReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventReviews indicates an expected call of GetEventReviews.
+func (mr *MockEventReviewHandlerMockRecorder) GetEventReviews(ctx, eventID, executorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventReviews", reflect.TypeOf((*MockEventReviewHandler)(nil).GetEventReviews), ctx, eventID, executorID)
+}
+
+// ListEventReviews mocks base method.
+func (m *MockEventReviewHandler) ListEventReviews(ctx context.Context, eventID int) ([]model0.EventReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventReviews", ctx, eventID)
+	ret0, _ := ret[0].([]model0.EventReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventReviews indicates an expected call of ListEventReviews.
+func (mr *MockEventReviewHandlerMockRecorder) ListEventReviews(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventReviews", reflect.TypeOf((*MockEventReviewHandler)(nil).ListEventReviews), ctx, eventID)
+}
+
+// ListEventsForReview mocks base method.
+func (m *MockEventReviewHandler) ListEventsForReview(ctx context.Context, query model0.EventReviewQuery) (*model.Page[model0.Event], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventsForReview", ctx, query)
+	ret0, _ := ret[0].(*model.Page[model0.Event])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventsForReview indicates an expected call of ListEventsForReview.
+func (mr *MockEventReviewHandlerMockRecorder) ListEventsForReview(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventsForReview", reflect.TypeOf((*MockEventReviewHandler)(nil).ListEventsForReview), ctx, query)
+}
+
+// RejectEvent mocks base method.
+func (m *MockEventReviewHandler) RejectEvent(ctx context.Context, params model0.ReviewEventRequest) (*model0.EventReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectEvent", ctx, params)
+	ret0, _ := ret[0].(*model0.EventReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectEvent indicates an expected call of RejectEvent.
+func (mr *MockEventReviewHandlerMockRecorder) RejectEvent(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectEvent", reflect.TypeOf((*MockEventReviewHandler)(nil).RejectEvent), ctx, params)
+}
+
+// SubmitEvent mocks base method.
+func (m *MockEventReviewHandler) SubmitEvent(ctx context.Context, params model0.ReviewEventRequest) (*model0.EventReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitEvent", ctx, params)
+	ret0, _ := ret[0].(*model0.EventReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitEvent indicates an expected call of SubmitEvent.
+func (mr *MockEventReviewHandlerMockRecorder) SubmitEvent(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitEvent", reflect.TypeOf((*MockEventReviewHandler)(nil).SubmitEvent), ctx, params)
+}
@@ -0,0 +1,197 @@
+//go:generate mockgen -source=template.go -destination=template_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/middleware"
+	"booking-event/internal/modules/booking/model"
+)
+
+type EventTemplateHandler interface {
+	SaveEventTemplate(ctx context.Context, params model.SaveEventTemplateRequest) (*model.EventTemplate, error)
+	ListEventTemplates(ctx context.Context, executorID int) ([]model.EventTemplate, error)
+	GetEventTemplate(ctx context.Context, params model.EventTemplateRequest) (*model.EventTemplate, error)
+	DeleteEventTemplate(ctx context.Context, params model.EventTemplateRequest) error
+	CreateEventFromTemplate(ctx context.Context, params model.CreateEventFromTemplateRequest) (*model.Event, error)
+}
+
+// EventTemplateHttpHandler lets organizers save events as templates and create new events from them.
+type EventTemplateHttpHandler struct {
+	templateService EventTemplateHandler
+}
+
+func NewEventTemplateHandler(templateService EventTemplateHandler) handler.HttpHandler {
+	return &EventTemplateHttpHandler{templateService: templateService}
+}
+
+func (h *EventTemplateHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/events/:event_id/template", middleware.RequirePermissions(model.PermissionEventManageOwn), h.SaveEventTemplate)
+	router.GET("/event-templates", middleware.RequirePermissions(model.PermissionEventCreate), h.ListEventTemplates)
+	router.GET("/event-templates/:template_id", middleware.RequirePermissions(model.PermissionEventCreate), h.GetEventTemplate)
+	router.DELETE("/event-templates/:template_id", middleware.RequirePermissions(model.PermissionEventCreate), h.DeleteEventTemplate)
+	router.POST("/event-templates/:template_id/events", middleware.RequirePermissions(model.PermissionEventCreate), h.CreateEventFromTemplate)
+}
+
+func (h *EventTemplateHttpHandler) SaveEventTemplate(c *gin.Context) {
+	var request model.SaveEventTemplateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	request.EventID, err = strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
+
+	template, err := h.templateService.SaveEventTemplate(c.Request.Context(), request)
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    template,
+		Message: "template saved",
+	})
+}
+
+func (h *EventTemplateHttpHandler) ListEventTemplates(c *gin.Context) {
+	templates, err := h.templateService.ListEventTemplates(c.Request.Context(), util.GetUserIDContext(c.Request.Context()))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    templates,
+		Message: "templates retrieved",
+	})
+}
+
+func (h *EventTemplateHttpHandler) GetEventTemplate(c *gin.Context) {
+	var request model.EventTemplateRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
+
+	template, err := h.templateService.GetEventTemplate(c.Request.Context(), request)
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    template,
+		Message: "template retrieved",
+	})
+}
+
+func (h *EventTemplateHttpHandler) DeleteEventTemplate(c *gin.Context) {
+	var request model.EventTemplateRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
+
+	if err := h.templateService.DeleteEventTemplate(c.Request.Context(), request); err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "template deleted",
+	})
+}
+
+func (h *EventTemplateHttpHandler) CreateEventFromTemplate(c *gin.Context) {
+	var request model.CreateEventFromTemplateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	request.TemplateID, err = strconv.Atoi(c.Param("template_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID = util.GetUserIDContext(c.Request.Context())
+
+	event, err := h.templateService.CreateEventFromTemplate(c.Request.Context(), request)
+	if errors.Is(err, model.ErrUnknownCategory) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(statusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    event,
+		Message: "Event created successfully",
+	})
+}
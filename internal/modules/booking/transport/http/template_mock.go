@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: template.go
+//
+// Generated by this command:
+//
+//	mockgen -source=template.go -destination=template_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventTemplateHandler is a mock of EventTemplateHandler interface.
+type MockEventTemplateHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventTemplateHandlerMockRecorder
+}
+
+// MockEventTemplateHandlerMockRecorder is the mock recorder for MockEventTemplateHandler.
+type MockEventTemplateHandlerMockRecorder struct {
+	mock *MockEventTemplateHandler
+}
+
+// NewMockEventTemplateHandler creates a new mock instance.
+func NewMockEventTemplateHandler(ctrl *gomock.Controller) *MockEventTemplateHandler {
+	mock := &MockEventTemplateHandler{ctrl: ctrl}
+	mock.recorder = &MockEventTemplateHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventTemplateHandler) EXPECT() *MockEventTemplateHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateEventFromTemplate mocks base method.
+func (m *MockEventTemplateHandler) CreateEventFromTemplate(ctx context.Context, params model.CreateEventFromTemplateRequest) (*model.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventFromTemplate", ctx, params)
+	ret0, _ := ret[0].(*model.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventFromTemplate indicates an expected call of CreateEventFromTemplate.
+func (mr *MockEventTemplateHandlerMockRecorder) CreateEventFromTemplate(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventFromTemplate", reflect.TypeOf((*MockEventTemplateHandler)(nil).CreateEventFromTemplate), ctx, params)
+}
+
+// DeleteEventTemplate mocks base method.
+func (m *MockEventTemplateHandler) DeleteEventTemplate(ctx context.Context, params model.EventTemplateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventTemplate", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventTemplate indicates an expected call of DeleteEventTemplate.
+func (mr *MockEventTemplateHandlerMockRecorder) DeleteEventTemplate(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventTemplate", reflect.TypeOf((*MockEventTemplateHandler)(nil).DeleteEventTemplate), ctx, params)
+}
+
+// GetEventTemplate mocks base method.
+func (m *MockEventTemplateHandler) GetEventTemplate(ctx context.Context, params model.EventTemplateRequest) (*model.EventTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventTemplate", ctx, params)
+	ret0, _ := ret[0].(*model.EventTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventTemplate indicates an expected call of GetEventTemplate.
+func (mr *MockEventTemplateHandlerMockRecorder) GetEventTemplate(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventTemplate", reflect.TypeOf((*MockEventTemplateHandler)(nil).GetEventTemplate), ctx, params)
+}
+
+// ListEventTemplates mocks base method.
+func (m *MockEventTemplateHandler) ListEventTemplates(ctx context.Context, executorID int) ([]model.EventTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventTemplates", ctx, executorID)
+	ret0, _ := ret[0].([]model.EventTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventTemplates indicates an expected call of ListEventTemplates.
+func (mr *MockEventTemplateHandlerMockRecorder) ListEventTemplates(ctx, executorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventTemplates", reflect.TypeOf((*MockEventTemplateHandler)(nil).ListEventTemplates), ctx, executorID)
+}
+
+// SaveEventTemplate mocks base method.
+func (m *MockEventTemplateHandler) SaveEventTemplate(ctx context.Context, params model.SaveEventTemplateRequest) (*model.EventTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveEventTemplate", ctx, params)
+	ret0, _ := ret[0].(*model.EventTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveEventTemplate indicates an expected call of SaveEventTemplate.
+func (mr *MockEventTemplateHandlerMockRecorder) SaveEventTemplate(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEventTemplate", reflect.TypeOf((*MockEventTemplateHandler)(nil).SaveEventTemplate), ctx, params)
+}
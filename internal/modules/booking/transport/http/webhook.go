@@ -0,0 +1,279 @@
+//go:generate mockgen -source=webhook.go -destination=webhook_mock.go -package=transporthttp
+package transporthttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"booking-event/internal/common/handler"
+	commonmodel "booking-event/internal/common/model"
+	"booking-event/internal/common/util"
+	"booking-event/internal/middleware"
+	"booking-event/internal/modules/booking/model"
+)
+
+type WebhookHandler interface {
+	CreateSubscription(ctx context.Context, params model.CreateWebhookSubscriptionRequest) (*model.CreatedWebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, executorID int, serviceAccountID int) ([]model.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, params model.UpdateWebhookSubscriptionRequest) (*model.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, params model.WebhookSubscriptionRequest) error
+	ListDeliveries(ctx context.Context, query model.WebhookDeliveryQuery) (*commonmodel.Page[model.WebhookDelivery], error)
+	ListDeliveryAttempts(ctx context.Context, params model.WebhookDeliveryRequest) ([]model.WebhookDeliveryAttempt, error)
+	ReplayDelivery(ctx context.Context, params model.WebhookDeliveryRequest) error
+}
+
+// WebhookHttpHandler lets organizers and their API clients subscribe to the changes of their events and inspect
+// or replay the deliveries.
+type WebhookHttpHandler struct {
+	webhookService WebhookHandler
+}
+
+func NewWebhookHandler(webhookService WebhookHandler) handler.HttpHandler {
+	return &WebhookHttpHandler{webhookService: webhookService}
+}
+
+func (h *WebhookHttpHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.POST("/webhooks", middleware.RequirePermissions(model.PermissionWebhookManage), h.CreateSubscription)
+	router.GET("/webhooks", middleware.RequirePermissions(model.PermissionWebhookManage), h.ListSubscriptions)
+	router.PATCH("/webhooks/:webhook_id", middleware.RequirePermissions(model.PermissionWebhookManage), h.UpdateSubscription)
+	router.DELETE("/webhooks/:webhook_id", middleware.RequirePermissions(model.PermissionWebhookManage), h.DeleteSubscription)
+	router.GET("/webhooks/:webhook_id/deliveries", middleware.RequirePermissions(model.PermissionWebhookManage), h.ListDeliveries)
+	router.GET("/webhooks/:webhook_id/deliveries/:delivery_id/attempts", middleware.RequirePermissions(model.PermissionWebhookManage), h.ListDeliveryAttempts)
+	router.POST("/webhooks/:webhook_id/deliveries/:delivery_id/replay", middleware.RequirePermissions(model.PermissionWebhookManage), h.ReplayDelivery)
+}
+
+// webhookStatusFromError maps the webhook errors, the others are mapped like those of the events.
+func webhookStatusFromError(err error) int {
+	switch {
+	case errors.Is(err, model.ErrInsecureWebhookURL), errors.Is(err, model.ErrUnreachableWebhookURL):
+		return http.StatusBadRequest
+	case errors.Is(err, model.ErrWebhookSubscriptionDisabled), errors.Is(err, model.ErrWebhookDeliveryPending):
+		return http.StatusConflict
+	default:
+		return statusFromError(err)
+	}
+}
+
+// webhookExecutor returns the user calling and, with an API key, its service account, which only manages its own
+// subscriptions.
+func webhookExecutor(c *gin.Context) (int, int) {
+	principal, _ := util.GetPrincipalContext(c.Request.Context())
+	return principal.UserID, principal.ServiceAccountID
+}
+
+func (h *WebhookHttpHandler) CreateSubscription(c *gin.Context) {
+	var request model.CreateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	// a subscription created with an API key belongs to its service account
+	request.ExecutorID, request.ServiceAccountID = webhookExecutor(c)
+
+	subscription, err := h.webhookService.CreateSubscription(c.Request.Context(), request)
+	if err != nil {
+		c.JSON(webhookStatusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    subscription,
+		Message: "webhook created",
+	})
+}
+
+func (h *WebhookHttpHandler) ListSubscriptions(c *gin.Context) {
+	executorID, serviceAccountID := webhookExecutor(c)
+	subscriptions, err := h.webhookService.ListSubscriptions(c.Request.Context(), executorID, serviceAccountID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    subscriptions,
+		Message: "webhooks retrieved",
+	})
+}
+
+func (h *WebhookHttpHandler) UpdateSubscription(c *gin.Context) {
+	var request model.UpdateWebhookSubscriptionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	request.SubscriptionID, err = strconv.Atoi(c.Param("webhook_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID, request.ServiceAccountID = webhookExecutor(c)
+
+	subscription, err := h.webhookService.UpdateSubscription(c.Request.Context(), request)
+	if err != nil {
+		c.JSON(webhookStatusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    subscription,
+		Message: "webhook updated",
+	})
+}
+
+func (h *WebhookHttpHandler) DeleteSubscription(c *gin.Context) {
+	var request model.WebhookSubscriptionRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID, request.ServiceAccountID = webhookExecutor(c)
+
+	if err := h.webhookService.DeleteSubscription(c.Request.Context(), request); err != nil {
+		c.JSON(webhookStatusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Message: "webhook deleted",
+	})
+}
+
+func (h *WebhookHttpHandler) ListDeliveries(c *gin.Context) {
+	var query model.WebhookDeliveryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	var err error
+	query.SubscriptionID, err = strconv.Atoi(c.Param("webhook_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	query.ExecutorID, query.ServiceAccountID = webhookExecutor(c)
+
+	page, err := h.webhookService.ListDeliveries(c.Request.Context(), query)
+	if errors.Is(err, commonmodel.ErrInvalidCursor) {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(webhookStatusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success:    true,
+		Data:       page.Items,
+		Message:    "deliveries retrieved",
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	})
+}
+
+func (h *WebhookHttpHandler) ListDeliveryAttempts(c *gin.Context) {
+	var request model.WebhookDeliveryRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID, request.ServiceAccountID = webhookExecutor(c)
+
+	attempts, err := h.webhookService.ListDeliveryAttempts(c.Request.Context(), request)
+	if err != nil {
+		c.JSON(webhookStatusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, commonmodel.Response{
+		Success: true,
+		Data:    attempts,
+		Message: "attempts retrieved",
+	})
+}
+
+func (h *WebhookHttpHandler) ReplayDelivery(c *gin.Context) {
+	var request model.WebhookDeliveryRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		c.JSON(http.StatusBadRequest, commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	request.ExecutorID, request.ServiceAccountID = webhookExecutor(c)
+
+	if err := h.webhookService.ReplayDelivery(c.Request.Context(), request); err != nil {
+		c.JSON(webhookStatusFromError(err), commonmodel.Response{
+			Success: false,
+			Data:    nil,
+			Message: err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusAccepted, commonmodel.Response{
+		Success: true,
+		Message: "delivery replayed",
+	})
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook.go -destination=webhook_mock.go -package=transporthttp
+//
+
+// Package transporthttp is a generated GoMock package.
+package transporthttp
+
+import (
+	model "booking-event/internal/common/model"
+	model0 "booking-event/internal/modules/booking/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookHandler is a mock of WebhookHandler interface.
+type MockWebhookHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookHandlerMockRecorder
+}
+
+// MockWebhookHandlerMockRecorder is the mock recorder for MockWebhookHandler.
+type MockWebhookHandlerMockRecorder struct {
+	mock *MockWebhookHandler
+}
+
+// NewMockWebhookHandler creates a new mock instance.
+func NewMockWebhookHandler(ctrl *gomock.Controller) *MockWebhookHandler {
+	mock := &MockWebhookHandler{ctrl: ctrl}
+	mock.recorder = &MockWebhookHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookHandler) EXPECT() *MockWebhookHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method.
+func (m *MockWebhookHandler) CreateSubscription(ctx context.Context, params model0.CreateWebhookSubscriptionRequest) (*model0.CreatedWebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, params)
+	ret0, _ := ret[0].(*model0.CreatedWebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockWebhookHandlerMockRecorder) CreateSubscription(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookHandler)(nil).CreateSubscription), ctx, params)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockWebhookHandler) DeleteSubscription(ctx context.Context, params model0.WebhookSubscriptionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockWebhookHandlerMockRecorder) DeleteSubscription(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookHandler)(nil).DeleteSubscription), ctx, params)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookHandler) ListDeliveries(ctx context.Context, query model0.WebhookDeliveryQuery) (*model.Page[model0.WebhookDelivery], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, query)
+	ret0, _ := ret[0].(*model.Page[model0.WebhookDelivery])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookHandlerMockRecorder) ListDeliveries(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookHandler)(nil).ListDeliveries), ctx, query)
+}
+
+// ListDeliveryAttempts mocks base method.
+func (m *MockWebhookHandler) ListDeliveryAttempts(ctx context.Context, params model0.WebhookDeliveryRequest) ([]model0.WebhookDeliveryAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveryAttempts", ctx, params)
+	ret0, _ := ret[0].([]model0.WebhookDeliveryAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveryAttempts indicates an expected call of ListDeliveryAttempts.
+func (mr *MockWebhookHandlerMockRecorder) ListDeliveryAttempts(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveryAttempts", reflect.TypeOf((*MockWebhookHandler)(nil).ListDeliveryAttempts), ctx, params)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockWebhookHandler) ListSubscriptions(ctx context.Context, executorID, serviceAccountID int) ([]model0.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx, executorID, serviceAccountID)
+	ret0, _ := ret[0].([]model0.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockWebhookHandlerMockRecorder) ListSubscriptions(ctx, executorID, serviceAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockWebhookHandler)(nil).ListSubscriptions), ctx, executorID, serviceAccountID)
+}
+
+// ReplayDelivery mocks base method.
+func (m *MockWebhookHandler) ReplayDelivery(ctx context.Context, params model0.WebhookDeliveryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDelivery", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplayDelivery indicates an expected call of ReplayDelivery.
+func (mr *MockWebhookHandlerMockRecorder) ReplayDelivery(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDelivery", reflect.TypeOf((*MockWebhookHandler)(nil).ReplayDelivery), ctx, params)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockWebhookHandler) UpdateSubscription(ctx context.Context, params model0.UpdateWebhookSubscriptionRequest) (*model0.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, params)
+	ret0, _ := ret[0].(*model0.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockWebhookHandlerMockRecorder) UpdateSubscription(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockWebhookHandler)(nil).UpdateSubscription), ctx, params)
+}
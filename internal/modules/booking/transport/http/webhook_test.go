@@ -0,0 +1,153 @@
+package transporthttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	_errors "booking-event/internal/common/errors"
+	"booking-event/internal/common/util"
+	"booking-event/internal/modules/booking/model"
+)
+
+func TestWebhookHttpHandler_CreateSubscription(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name               string
+		body               string
+		principal          util.Principal
+		mockWebhookService func(ctrl *gomock.Controller) *MockWebhookHandler
+		expectedStatus     int
+	}{
+		{
+			name:      "Subscription of an API client",
+			body:      `{"url":"https://partner.example.com/hooks","event_types":["booking.confirmed","checkin.scanned"]}`,
+			principal: util.Principal{Type: util.PrincipalTypeService, UserID: 5, ServiceAccountID: 3},
+			mockWebhookService: func(ctrl *gomock.Controller) *MockWebhookHandler {
+				mock := NewMockWebhookHandler(ctrl)
+				mock.EXPECT().CreateSubscription(gomock.Any(), model.CreateWebhookSubscriptionRequest{
+					URL:              "https://partner.example.com/hooks",
+					EventTypes:       []model.WebhookEventType{model.WebhookEventBookingConfirmed, model.WebhookEventCheckinScanned},
+					ServiceAccountID: 3,
+					ExecutorID:       5,
+				}).Return(&model.CreatedWebhookSubscription{Secret: "whsec_test"}, nil)
+				return mock
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "Unknown event type",
+			body:      `{"url":"https://partner.example.com/hooks","event_types":["booking.deleted"]}`,
+			principal: util.Principal{Type: util.PrincipalTypeUser, UserID: 5},
+			mockWebhookService: func(ctrl *gomock.Controller) *MockWebhookHandler {
+				return NewMockWebhookHandler(ctrl)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "Insecure endpoint",
+			body:      `{"url":"http://partner.example.com/hooks","event_types":["booking.created"]}`,
+			principal: util.Principal{Type: util.PrincipalTypeUser, UserID: 5},
+			mockWebhookService: func(ctrl *gomock.Controller) *MockWebhookHandler {
+				mock := NewMockWebhookHandler(ctrl)
+				mock.EXPECT().CreateSubscription(gomock.Any(), gomock.Any()).Return(nil, model.ErrInsecureWebhookURL)
+				return mock
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), tt.principal))
+
+			handler := NewWebhookHandler(tt.mockWebhookService(ctrl))
+			handler.(*WebhookHttpHandler).CreateSubscription(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestWebhookHttpHandler_ReplayDelivery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name               string
+		principal          util.Principal
+		mockWebhookService func(ctrl *gomock.Controller) *MockWebhookHandler
+		expectedStatus     int
+	}{
+		{
+			name:      "Delivery replayed",
+			principal: util.Principal{Type: util.PrincipalTypeUser, UserID: 5},
+			mockWebhookService: func(ctrl *gomock.Controller) *MockWebhookHandler {
+				mock := NewMockWebhookHandler(ctrl)
+				mock.EXPECT().ReplayDelivery(gomock.Any(), model.WebhookDeliveryRequest{SubscriptionID: 4, DeliveryID: 9, ExecutorID: 5}).Return(nil)
+				return mock
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:      "Delivery replayed by an API client",
+			principal: util.Principal{Type: util.PrincipalTypeService, UserID: 5, ServiceAccountID: 3},
+			mockWebhookService: func(ctrl *gomock.Controller) *MockWebhookHandler {
+				mock := NewMockWebhookHandler(ctrl)
+				mock.EXPECT().ReplayDelivery(gomock.Any(), model.WebhookDeliveryRequest{SubscriptionID: 4, DeliveryID: 9, ServiceAccountID: 3, ExecutorID: 5}).Return(nil)
+				return mock
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:      "Delivery still pending",
+			principal: util.Principal{Type: util.PrincipalTypeUser, UserID: 5},
+			mockWebhookService: func(ctrl *gomock.Controller) *MockWebhookHandler {
+				mock := NewMockWebhookHandler(ctrl)
+				mock.EXPECT().ReplayDelivery(gomock.Any(), gomock.Any()).Return(model.ErrWebhookDeliveryPending)
+				return mock
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:      "Subscription of another organizer",
+			principal: util.Principal{Type: util.PrincipalTypeUser, UserID: 5},
+			mockWebhookService: func(ctrl *gomock.Controller) *MockWebhookHandler {
+				mock := NewMockWebhookHandler(ctrl)
+				mock.EXPECT().ReplayDelivery(gomock.Any(), gomock.Any()).Return(_errors.ErrForbidden)
+				return mock
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/webhooks/4/deliveries/9/replay", nil)
+			c.Params = gin.Params{{Key: "webhook_id", Value: "4"}, {Key: "delivery_id", Value: "9"}}
+			c.Request = c.Request.WithContext(util.SetPrincipalContext(c.Request.Context(), tt.principal))
+
+			handler := NewWebhookHandler(tt.mockWebhookService(ctrl))
+			handler.(*WebhookHttpHandler).ReplayDelivery(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_events_latitude_longitude;
+
+ALTER TABLE events DROP COLUMN longitude;
+ALTER TABLE events DROP COLUMN latitude;
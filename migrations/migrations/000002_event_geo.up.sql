@@ -0,0 +1,4 @@
+ALTER TABLE events ADD COLUMN latitude DOUBLE PRECISION;
+ALTER TABLE events ADD COLUMN longitude DOUBLE PRECISION;
+
+CREATE INDEX idx_events_latitude_longitude ON events (latitude, longitude);
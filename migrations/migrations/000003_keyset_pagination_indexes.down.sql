@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_bookings_user_id_created_at_id;
+DROP INDEX IF EXISTS idx_events_updated_at_id;
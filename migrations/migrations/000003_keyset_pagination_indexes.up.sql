@@ -0,0 +1,2 @@
+CREATE INDEX idx_events_updated_at_id ON events (updated_at DESC, id DESC);
+CREATE INDEX idx_bookings_user_id_created_at_id ON bookings (user_id, created_at DESC, id DESC);
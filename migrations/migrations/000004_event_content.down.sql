@@ -0,0 +1,16 @@
+ALTER TABLE users DROP COLUMN birthdate;
+
+DROP TABLE event_images;
+
+DROP INDEX IF EXISTS idx_events_tags;
+ALTER TABLE events DROP COLUMN min_age;
+ALTER TABLE events DROP COLUMN tags;
+ALTER TABLE events DROP COLUMN description;
+ALTER TABLE events ADD COLUMN category VARCHAR(100) NOT NULL DEFAULT 'music';
+
+UPDATE events e SET category = c.slug
+FROM (SELECT ec.event_id, MIN(c.slug) AS slug FROM event_categories ec JOIN categories c ON c.id = ec.category_id GROUP BY ec.event_id) c
+WHERE c.event_id = e.id;
+
+DROP TABLE event_categories;
+DROP TABLE categories;
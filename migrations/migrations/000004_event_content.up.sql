@@ -0,0 +1,40 @@
+CREATE TABLE categories (
+    id SERIAL PRIMARY KEY,
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE event_categories (
+    event_id INTEGER NOT NULL,
+    category_id INTEGER NOT NULL,
+    PRIMARY KEY (event_id, category_id),
+    CONSTRAINT fk_event_categories_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    CONSTRAINT fk_event_categories_category FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+INSERT INTO categories (slug, name) VALUES ('music', 'Music');
+INSERT INTO categories (slug, name) SELECT DISTINCT category, INITCAP(category) FROM events ON CONFLICT (slug) DO NOTHING;
+INSERT INTO event_categories (event_id, category_id) SELECT e.id, c.id FROM events e JOIN categories c ON c.slug = e.category;
+
+ALTER TABLE events DROP COLUMN category;
+ALTER TABLE events ADD COLUMN description TEXT NOT NULL DEFAULT '';
+ALTER TABLE events ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE events ADD COLUMN min_age INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_events_tags ON events USING GIN (tags);
+
+CREATE TABLE event_images (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL,
+    storage_key VARCHAR(255) NOT NULL,
+    url VARCHAR(1024) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_event_images_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE
+);
+
+ALTER TABLE users ADD COLUMN birthdate DATE;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS event_review_history;
+
+DROP INDEX IF EXISTS idx_events_review_status;
+
+ALTER TABLE events DROP COLUMN IF EXISTS review_status;
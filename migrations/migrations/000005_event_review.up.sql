@@ -0,0 +1,19 @@
+ALTER TABLE events ADD COLUMN review_status VARCHAR(50) NOT NULL DEFAULT 'draft';
+
+-- events created before moderation existed have already been published
+UPDATE events SET review_status = 'approved';
+
+CREATE INDEX idx_events_review_status ON events (review_status, updated_at DESC, id DESC);
+
+CREATE TABLE event_review_history (
+    id SERIAL PRIMARY KEY,
+    event_id INTEGER NOT NULL,
+    from_status VARCHAR(50) NOT NULL,
+    to_status VARCHAR(50) NOT NULL,
+    actor_id INTEGER NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_event_review_history_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_event_review_history_event_id ON event_review_history (event_id, id);
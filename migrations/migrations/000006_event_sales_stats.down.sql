@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS event_sales_hourly;
+
+DROP TABLE IF EXISTS event_sales_stats;
+
+DROP INDEX IF EXISTS idx_event_tokens_updated_at;
+DROP INDEX IF EXISTS idx_bookings_updated_at;
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS confirmed_at;
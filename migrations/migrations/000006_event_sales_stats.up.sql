@@ -0,0 +1,30 @@
+ALTER TABLE bookings ADD COLUMN confirmed_at TIMESTAMP;
+UPDATE bookings SET confirmed_at = updated_at WHERE status IN ('confirmed', 'paid');
+
+-- the sales stats refresh looks up the events touched since its last run
+CREATE INDEX idx_bookings_updated_at ON bookings (updated_at);
+CREATE INDEX idx_event_tokens_updated_at ON event_tokens (updated_at);
+
+CREATE TABLE event_sales_stats (
+    event_id INTEGER PRIMARY KEY,
+    tickets_sold INTEGER NOT NULL DEFAULT 0,
+    tickets_locked INTEGER NOT NULL DEFAULT 0,
+    tickets_cancelled INTEGER NOT NULL DEFAULT 0,
+    tickets_refunded INTEGER NOT NULL DEFAULT 0,
+    bookings_total INTEGER NOT NULL DEFAULT 0,
+    bookings_confirmed INTEGER NOT NULL DEFAULT 0,
+    gross_revenue BIGINT NOT NULL DEFAULT 0,
+    net_revenue BIGINT NOT NULL DEFAULT 0,
+    currency VARCHAR(3) NOT NULL,
+    refreshed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_event_sales_stats_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE
+);
+
+CREATE TABLE event_sales_hourly (
+    event_id INTEGER NOT NULL,
+    bucket TIMESTAMP NOT NULL,
+    tickets_sold INTEGER NOT NULL,
+    gross_revenue BIGINT NOT NULL,
+    PRIMARY KEY (event_id, bucket),
+    CONSTRAINT fk_event_sales_hourly_event FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE
+);
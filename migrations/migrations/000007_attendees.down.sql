@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_booking_items_token;
+DROP INDEX IF EXISTS idx_event_tokens_event_id_status;
+
+ALTER TABLE event_tokens DROP COLUMN IF EXISTS checked_in_at;
@@ -0,0 +1,4 @@
+ALTER TABLE event_tokens ADD COLUMN checked_in_at TIMESTAMP;
+
+CREATE INDEX idx_event_tokens_event_id_status ON event_tokens (event_id, status, id);
+CREATE INDEX idx_booking_items_token ON booking_items (token);
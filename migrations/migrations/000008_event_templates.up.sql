@@ -0,0 +1,11 @@
+CREATE TABLE event_templates (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    content JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_event_templates_creator FOREIGN KEY (creator_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_event_templates_creator_id ON event_templates (creator_id, name);
@@ -0,0 +1,6 @@
+ALTER TABLE users ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active';
+ALTER TABLE users ADD COLUMN verified_at TIMESTAMP;
+
+UPDATE users SET verified_at = created_at;
+
+ALTER TABLE users ALTER COLUMN status SET DEFAULT 'unverified';
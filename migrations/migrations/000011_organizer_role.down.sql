@@ -0,0 +1,2 @@
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_role;
+UPDATE users SET role = 'user' WHERE role = 'organizer';
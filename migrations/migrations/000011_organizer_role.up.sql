@@ -0,0 +1,7 @@
+UPDATE users SET role = LOWER(role);
+
+-- the users who already created events keep being able to manage them
+UPDATE users SET role = 'organizer'
+WHERE role = 'user' AND EXISTS (SELECT 1 FROM events WHERE events.creator_id = users.id);
+
+ALTER TABLE users ADD CONSTRAINT chk_users_role CHECK (role IN ('admin', 'organizer', 'user'));
@@ -0,0 +1,13 @@
+CREATE TABLE login_audit (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER,
+    email VARCHAR(255) NOT NULL,
+    ip VARCHAR(64) NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    outcome VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_login_audit_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_login_audit_user_id ON login_audit (user_id, created_at);
+CREATE INDEX idx_login_audit_ip ON login_audit (ip, created_at);
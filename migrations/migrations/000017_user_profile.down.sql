@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_users_created_at_id;
+
+UPDATE users SET status = 'active' WHERE status = 'suspended';
+
+ALTER TABLE users DROP COLUMN IF EXISTS pending_email;
+ALTER TABLE users DROP COLUMN IF EXISTS marketing_consent_at;
+ALTER TABLE users DROP COLUMN IF EXISTS marketing_consent;
+ALTER TABLE users DROP COLUMN IF EXISTS timezone;
+ALTER TABLE users DROP COLUMN IF EXISTS locale;
+ALTER TABLE users DROP COLUMN IF EXISTS phone;
+ALTER TABLE users DROP COLUMN IF EXISTS display_name;
@@ -0,0 +1,8 @@
+-- bumped when a change of the event is sent to the attendees, the SEQUENCE of their calendar entries
+ALTER TABLE events ADD COLUMN sequence INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE calendar_feeds (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
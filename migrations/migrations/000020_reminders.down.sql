@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS booking_reminders;
+ALTER TABLE events DROP COLUMN IF EXISTS reminder_minutes;
@@ -0,0 +1,13 @@
+-- the minutes before the start of the event the reminders are sent, NULL follows booking.reminder_schedule
+ALTER TABLE events ADD COLUMN reminder_minutes INTEGER[];
+
+-- the reminders scheduled for the bookings, by the id of their task so they can be deleted from the queue
+CREATE TABLE booking_reminders (
+    task_id VARCHAR(255) PRIMARY KEY,
+    booking_id INTEGER NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    remind_at TIMESTAMP NOT NULL,
+    canceled_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_booking_reminders_scheduled ON booking_reminders (booking_id) WHERE canceled_at IS NULL;
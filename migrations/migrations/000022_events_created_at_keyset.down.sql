@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_events_review_status;
+DROP INDEX IF EXISTS idx_events_created_at_id;
+CREATE INDEX idx_events_review_status ON events (review_status, updated_at DESC, id DESC);
+CREATE INDEX idx_events_updated_at_id ON events (updated_at DESC, id DESC);
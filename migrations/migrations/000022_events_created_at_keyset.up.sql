@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_events_updated_at_id;
+DROP INDEX IF EXISTS idx_events_review_status;
+CREATE INDEX idx_events_created_at_id ON events (created_at DESC, id DESC);
+CREATE INDEX idx_events_review_status ON events (review_status, created_at DESC, id DESC);
@@ -0,0 +1,3 @@
+-- the price of a ticket is kept with its booking, the revenue no longer follows the current price of the event
+ALTER TABLE bookings ADD COLUMN unit_price BIGINT NOT NULL DEFAULT 0;
+UPDATE bookings b SET unit_price = e.price FROM events e WHERE e.id = b.event_id;